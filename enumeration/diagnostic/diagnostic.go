@@ -1,11 +1,23 @@
 package diagnostic
 
 import (
+	"context"
+	"errors"
+	"strings"
+
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/resource"
 )
 
+const (
+	CodeAccessDenied            = "ACCESS_DENIED"
+	CodeUnsupportedResourceType = "UNSUPPORTED_RESOURCE_TYPE"
+	CodeEnumerationFailed       = "ENUMERATION_FAILED"
+	CodeTimeout                 = "TIMEOUT"
+	CodeUnknownError            = "UNKNOWN_ERROR"
+)
+
 type Diagnostic interface {
 	Code() string
 	Message() string
@@ -15,13 +27,22 @@ type Diagnostic interface {
 
 type diagnosticImpl struct {
 	alert alerter.Alert
+	// key is the key the alert was sent with, either a resource type or TYPE.ID
+	key string
 }
 
 func (d *diagnosticImpl) Code() string {
-	if _, ok := d.alert.(*alerts.RemoteAccessDeniedAlert); ok {
-		return "ACCESS_DENIED"
+	switch d.alert.(type) {
+	case *alerts.RemoteAccessDeniedAlert:
+		return CodeAccessDenied
+	case *alerter.UnsupportedResourcetypeAlert:
+		return CodeUnsupportedResourceType
+	case *alerts.EnumerationFailedAlert:
+		return CodeEnumerationFailed
+	case *alerts.EnumerationTimeoutAlert:
+		return CodeTimeout
 	}
-	return "UNKNOWN_ERROR"
+	return CodeUnknownError
 }
 
 func (d *diagnosticImpl) Message() string {
@@ -29,10 +50,10 @@ func (d *diagnosticImpl) Message() string {
 }
 
 func (d *diagnosticImpl) ResourceType() string {
-	ty := ""
 	if d.Resource() != nil {
-		ty = d.Resource().ResourceType()
+		return d.Resource().ResourceType()
 	}
+	ty, _, _ := strings.Cut(d.key, ".")
 	return ty
 }
 
@@ -44,11 +65,43 @@ type Diagnostics []Diagnostic
 
 func FromAlerts(alertMap alerter.Alerts) Diagnostics {
 	var results Diagnostics
-	for _, v := range alertMap {
+	for k, v := range alertMap {
 		for _, alert := range v {
-			diag := &diagnosticImpl{alert}
+			diag := &diagnosticImpl{alert, k}
 			results = append(results, diag)
 		}
 	}
 	return results
 }
+
+type errorDiagnostic struct {
+	err error
+	res *resource.Resource
+}
+
+// FromError returns a diagnostic for an error that arose while handling a resource
+func FromError(err error, res *resource.Resource) Diagnostic {
+	return &errorDiagnostic{err, res}
+}
+
+func (d *errorDiagnostic) Code() string {
+	if errors.Is(d.err, context.DeadlineExceeded) {
+		return CodeTimeout
+	}
+	return CodeUnknownError
+}
+
+func (d *errorDiagnostic) Message() string {
+	return d.err.Error()
+}
+
+func (d *errorDiagnostic) ResourceType() string {
+	if d.res == nil {
+		return ""
+	}
+	return d.res.ResourceType()
+}
+
+func (d *errorDiagnostic) Resource() *resource.Resource {
+	return d.res
+}
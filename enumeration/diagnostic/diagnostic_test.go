@@ -0,0 +1,52 @@
+package diagnostic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromAlerts(t *testing.T) {
+	accessDenied := alerts.NewRemoteAccessDeniedAlert("aws+tf", remoteerr.NewResourceListingError(errors.New("denied"), "aws_iam_user"), alerts.EnumerationPhase)
+
+	diagnostics := FromAlerts(alerter.Alerts{
+		"aws_iam_user":         []alerter.Alert{accessDenied},
+		"aws_unknown":          []alerter.Alert{alerter.NewUnsupportedResourcetypeAlert("aws_unknown")},
+		"aws_s3_bucket.bucket": []alerter.Alert{&alerter.FakeAlert{Msg: "something happened"}},
+		"aws_sqs_queue":        []alerter.Alert{alerts.NewEnumerationFailedAlert("aws_sqs_queue", errors.New("unexpected"))},
+		"aws_vpc":              []alerter.Alert{alerts.NewEnumerationTimeoutAlert("aws_vpc")},
+	})
+	assert.Len(t, diagnostics, 5)
+
+	codes := make(map[string]string)
+	for _, d := range diagnostics {
+		codes[d.ResourceType()] = d.Code()
+	}
+	assert.Equal(t, map[string]string{
+		"aws_iam_user":  CodeAccessDenied,
+		"aws_unknown":   CodeUnsupportedResourceType,
+		"aws_sqs_queue": CodeEnumerationFailed,
+		"aws_vpc":       CodeTimeout,
+		"aws_s3_bucket": CodeUnknownError,
+	}, codes)
+}
+
+func TestFromError(t *testing.T) {
+	res := &resource.Resource{Id: "bucket", Type: "aws_s3_bucket"}
+	d := FromError(errors.New("read failed"), res)
+
+	assert.Equal(t, CodeUnknownError, d.Code())
+	assert.Equal(t, "read failed", d.Message())
+	assert.Equal(t, "aws_s3_bucket", d.ResourceType())
+	assert.Same(t, res, d.Resource())
+
+	d = FromError(fmt.Errorf("unable to read aws_s3_bucket.bucket: %w", context.DeadlineExceeded), res)
+	assert.Equal(t, CodeTimeout, d.Code())
+}
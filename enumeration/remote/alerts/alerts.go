@@ -112,3 +112,51 @@ func SendEnumerationAlert(provider string, alerter alerter.AlerterInterface, lis
 func SendDetailsFetchingAlert(provider string, alerter alerter.AlerterInterface, listError *remoteerror.ResourceScanningError) {
 	sendRemoteAccessDeniedAlert(provider, alerter, listError, DetailsFetchingPhase)
 }
+
+// EnumerationFailedAlert is sent in best effort mode when a resource type could not be listed, resources
+// of that type are then left out of the analysis as they are neither known to be unmanaged nor missing
+type EnumerationFailedAlert struct {
+	message string
+}
+
+func NewEnumerationFailedAlert(resourceType string, err error) *EnumerationFailedAlert {
+	return &EnumerationFailedAlert{
+		message: fmt.Sprintf("An error occurred listing %s, it is left out of the results: %s", resourceType, err),
+	}
+}
+
+func (e *EnumerationFailedAlert) Message() string {
+	return e.message
+}
+
+func (e *EnumerationFailedAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+func (e *EnumerationFailedAlert) Resource() *resource.Resource {
+	return nil
+}
+
+// EnumerationTimeoutAlert is sent when listing a resource type did not complete before its timeout,
+// resources of that type are left out of the analysis like in best effort mode
+type EnumerationTimeoutAlert struct {
+	message string
+}
+
+func NewEnumerationTimeoutAlert(resourceType string) *EnumerationTimeoutAlert {
+	return &EnumerationTimeoutAlert{
+		message: fmt.Sprintf("Listing %s timed out, it is left out of the results", resourceType),
+	}
+}
+
+func (e *EnumerationTimeoutAlert) Message() string {
+	return e.message
+}
+
+func (e *EnumerationTimeoutAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+func (e *EnumerationTimeoutAlert) Resource() *resource.Resource {
+	return nil
+}
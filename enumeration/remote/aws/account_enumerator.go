@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// AccountEnumerator wraps an enumerator and sets the account every enumerated resource comes from,
+// so resources from a multi-account scan can be told apart.
+type AccountEnumerator struct {
+	common.Enumerator
+	accountId string
+}
+
+func NewAccountEnumerator(enumerator common.Enumerator, accountId string) *AccountEnumerator {
+	return &AccountEnumerator{
+		Enumerator: enumerator,
+		accountId:  accountId,
+	}
+}
+
+func (e *AccountEnumerator) AccountId() string {
+	return e.accountId
+}
+
+func (e *AccountEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.Enumerator.Enumerate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range resources {
+		if res == nil {
+			continue
+		}
+		if res.Account == "" {
+			res.Account = e.accountId
+		}
+	}
+
+	return resources, nil
+}
+
+// accountLibrary registers enumerators of a given account in the remote library
+type accountLibrary struct {
+	library   common.EnumeratorLibrary
+	accountId string
+}
+
+func (l accountLibrary) AddEnumerator(enumerator common.Enumerator) {
+	l.library.AddEnumerator(NewAccountEnumerator(enumerator, l.accountId))
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+)
+
+const assumeRoleSessionName = "driftctl"
+
+type awsAccount struct {
+	id      string
+	session *session.Session
+}
+
+// ResolveAccounts returns the accounts to enumerate along with the session to use for each of them.
+// The account of the current session is used when neither accounts nor organizational unit are given,
+// otherwise the given role is assumed in every selected account.
+func (p *AWSTerraformProvider) ResolveAccounts(opts common.RemoteOptions) ([]awsAccount, error) {
+	if !opts.IsAWSMultiAccount() {
+		return []awsAccount{{id: p.accountId, session: p.session}}, nil
+	}
+
+	if opts.AWSAssumeRoleName == "" {
+		return nil, errors.New("a role name to assume is required to scan multiple AWS accounts")
+	}
+	p.assumeRoleName = opts.AWSAssumeRoleName
+	p.assumeRoleExternalId = opts.AWSAssumeRoleExternalID
+
+	ids := make([]string, 0, len(opts.AWSAccounts))
+	ids = append(ids, opts.AWSAccounts...)
+	if opts.AWSOrganizationalUnit != "" {
+		ouAccounts, err := p.listOrganizationalUnitAccounts(opts.AWSOrganizationalUnit)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, ouAccounts...)
+	}
+
+	accounts := make([]awsAccount, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, exist := seen[id]; exist {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		sess, err := p.assumeRoleSession(id, opts.AWSAssumeRoleName, opts.AWSAssumeRoleExternalID)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, awsAccount{id: id, session: sess})
+	}
+
+	return accounts, nil
+}
+
+// assumeRoleSession returns a session using credentials of the given role in the given account.
+// The role is assumed right away to fail early when it cannot be.
+func (p *AWSTerraformProvider) assumeRoleSession(accountId, roleName, externalId string) (*session.Session, error) {
+	roleArn := p.roleArn(accountId, roleName)
+	creds := stscreds.NewCredentials(p.session, roleArn, func(provider *stscreds.AssumeRoleProvider) {
+		provider.RoleSessionName = assumeRoleSessionName
+		if externalId != "" {
+			provider.ExternalID = aws.String(externalId)
+		}
+	})
+	sess := p.session.Copy(&aws.Config{Credentials: creds})
+
+	identity, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		logrus.Debug(err)
+		return nil, errors.Errorf("unable to assume role %s in AWS account %s", roleArn, accountId)
+	}
+	if aws.StringValue(identity.Account) != accountId {
+		return nil, errors.Errorf("assumed role %s does not belong to AWS account %s", roleArn, accountId)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"account": accountId,
+		"role":    roleArn,
+	}).Debug("Assumed role in AWS account")
+
+	return sess, nil
+}
+
+func (p *AWSTerraformProvider) roleArn(accountId, roleName string) string {
+	partition := p.partition
+	if partition == "" {
+		partition = "aws"
+	}
+	return arn.ARN{
+		Partition: partition,
+		Service:   "iam",
+		AccountID: accountId,
+		Resource:  "role/" + roleName,
+	}.String()
+}
+
+// listOrganizationalUnitAccounts returns the active accounts of an organizational unit and of its children
+func (p *AWSTerraformProvider) listOrganizationalUnitAccounts(organizationalUnit string) ([]string, error) {
+	client := organizations.New(p.session)
+
+	var accounts []string
+	parents := []string{organizationalUnit}
+	for len(parents) > 0 {
+		parent := parents[0]
+		parents = parents[1:]
+
+		err := client.ListAccountsForParentPages(&organizations.ListAccountsForParentInput{
+			ParentId: aws.String(parent),
+		}, func(out *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+			for _, account := range out.Accounts {
+				if aws.StringValue(account.Status) != organizations.AccountStatusActive {
+					continue
+				}
+				accounts = append(accounts, aws.StringValue(account.Id))
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list accounts of organizational unit %s", parent)
+		}
+
+		err = client.ListOrganizationalUnitsForParentPages(&organizations.ListOrganizationalUnitsForParentInput{
+			ParentId: aws.String(parent),
+		}, func(out *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+			for _, ou := range out.OrganizationalUnits {
+				parents = append(parents, aws.StringValue(ou.Id))
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list children of organizational unit %s", parent)
+		}
+	}
+
+	return accounts, nil
+}
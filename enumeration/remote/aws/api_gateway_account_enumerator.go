@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayAccountEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayAccountResourceType
 }
 
-func (e *ApiGatewayAccountEnumerator) Enumerate() ([]*resource.Resource, error) {
-	account, err := e.repository.GetAccount()
+func (e *ApiGatewayAccountEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	account, err := e.repository.GetAccount(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayApiKeyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayApiKeyResourceType
 }
 
-func (e *ApiGatewayApiKeyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	keys, err := e.repository.ListAllApiKeys()
+func (e *ApiGatewayApiKeyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	keys, err := e.repository.ListAllApiKeys(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayRequestValidatorEnumerator) SupportedType() resource.Resource
 	return aws.AwsApiGatewayRequestValidatorResourceType
 }
 
-func (e *ApiGatewayRequestValidatorEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayRequestValidatorEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayRestApiResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *ApiGatewayRequestValidatorEnumerator) Enumerate() ([]*resource.Resource
 
 	for _, api := range apis {
 		a := api
-		requestValidators, err := e.repository.ListAllRestApiRequestValidators(*a.Id)
+		requestValidators, err := e.repository.ListAllRestApiRequestValidators(ctx, *a.Id)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
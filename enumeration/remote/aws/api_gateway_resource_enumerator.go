@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayResourceEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayResourceResourceType
 }
 
-func (e *ApiGatewayResourceEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayResourceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayRestApiResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *ApiGatewayResourceEnumerator) Enumerate() ([]*resource.Resource, error)
 
 	for _, api := range apis {
 		a := api
-		resources, err := e.repository.ListAllRestApiResources(*a.Id)
+		resources, err := e.repository.ListAllRestApiResources(ctx, *a.Id)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
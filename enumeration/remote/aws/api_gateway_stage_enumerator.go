@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -25,8 +27,8 @@ func (e *ApiGatewayStageEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayStageResourceType
 }
 
-func (e *ApiGatewayStageEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayStageEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayRestApiResourceType)
 	}
@@ -35,7 +37,7 @@ func (e *ApiGatewayStageEnumerator) Enumerate() ([]*resource.Resource, error) {
 
 	for _, api := range apis {
 		a := api
-		stages, err := e.repository.ListAllRestApiStages(*a.Id)
+		stages, err := e.repository.ListAllRestApiStages(ctx, *a.Id)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
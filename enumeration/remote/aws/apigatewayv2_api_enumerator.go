@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayV2ApiEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayV2ApiResourceType
 }
 
-func (e *ApiGatewayV2ApiEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2ApiEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -26,8 +28,8 @@ func (e *ApiGatewayV2DomainNameEnumerator) SupportedType() resource.ResourceType
 	return aws.AwsApiGatewayV2DomainNameResourceType
 }
 
-func (e *ApiGatewayV2DomainNameEnumerator) Enumerate() ([]*resource.Resource, error) {
-	domainNames, err := e.repository.ListAllDomainNames()
+func (e *ApiGatewayV2DomainNameEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	domainNames, err := e.repository.ListAllDomainNames(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayV2IntegrationEnumerator) SupportedType() resource.ResourceTyp
 	return aws.AwsApiGatewayV2IntegrationResourceType
 }
 
-func (e *ApiGatewayV2IntegrationEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2IntegrationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2ApiResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *ApiGatewayV2IntegrationEnumerator) Enumerate() ([]*resource.Resource, e
 
 	for _, a := range apis {
 		api := a
-		integrations, err := e.repository.ListAllApiIntegrations(*api.ApiId)
+		integrations, err := e.repository.ListAllApiIntegrations(ctx, *api.ApiId)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
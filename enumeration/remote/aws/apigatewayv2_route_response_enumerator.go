@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayV2RouteResponseEnumerator) SupportedType() resource.ResourceT
 	return aws.AwsApiGatewayV2RouteResponseResourceType
 }
 
-func (e *ApiGatewayV2RouteResponseEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2RouteResponseEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2ApiResourceType)
 	}
@@ -32,13 +34,13 @@ func (e *ApiGatewayV2RouteResponseEnumerator) Enumerate() ([]*resource.Resource,
 	var results []*resource.Resource
 	for _, api := range apis {
 		a := api
-		routes, err := e.repository.ListAllApiRoutes(a.ApiId)
+		routes, err := e.repository.ListAllApiRoutes(ctx, a.ApiId)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2RouteResourceType)
 		}
 		for _, route := range routes {
 			r := route
-			responses, err := e.repository.ListAllApiRouteResponses(*a.ApiId, *r.RouteId)
+			responses, err := e.repository.ListAllApiRouteResponses(ctx, *a.ApiId, *r.RouteId)
 			if err != nil {
 				return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 			}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayV2VpcLinkEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayV2VpcLinkResourceType
 }
 
-func (e *ApiGatewayV2VpcLinkEnumerator) Enumerate() ([]*resource.Resource, error) {
-	vpcLinks, err := e.repository.ListAllVpcLinks()
+func (e *ApiGatewayV2VpcLinkEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	vpcLinks, err := e.repository.ListAllVpcLinks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
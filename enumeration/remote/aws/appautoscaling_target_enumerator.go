@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -24,11 +26,11 @@ func (e *AppAutoscalingTargetEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsAppAutoscalingTargetResourceType
 }
 
-func (e *AppAutoscalingTargetEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AppAutoscalingTargetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 	targets := make([]*applicationautoscaling.ScalableTarget, 0)
 
-	for _, ns := range e.repository.ServiceNamespaceValues() {
-		results, err := e.repository.DescribeScalableTargets(ns)
+	for _, ns := range e.repository.ServiceNamespaceValues(ctx) {
+		results, err := e.repository.DescribeScalableTargets(ctx, ns)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type AutoscalingGroupEnumerator struct {
+	repository repository.AutoScalingRepository
+	factory    resource.ResourceFactory
+}
+
+func NewAutoscalingGroupEnumerator(repo repository.AutoScalingRepository, factory resource.ResourceFactory) *AutoscalingGroupEnumerator {
+	return &AutoscalingGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *AutoscalingGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsAutoscalingGroupResourceType
+}
+
+func (e *AutoscalingGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	groups, err := e.repository.DescribeAutoScalingGroups(ctx, nil)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(groups))
+
+	for _, group := range groups {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*group.AutoScalingGroupName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -28,8 +29,8 @@ func (e *CloudformationStackEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsCloudformationStackResourceType
 }
 
-func (e *CloudformationStackEnumerator) Enumerate() ([]*resource.Resource, error) {
-	stacks, err := e.repository.ListAllStacks()
+func (e *CloudformationStackEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	stacks, err := e.repository.ListAllStacks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+const defaultEventBusName = "default"
+
+type CloudwatchEventRuleEnumerator struct {
+	repository repository.EventBridgeRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchEventRuleEnumerator(repo repository.EventBridgeRepository, factory resource.ResourceFactory) *CloudwatchEventRuleEnumerator {
+	return &CloudwatchEventRuleEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchEventRuleEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchEventRuleResourceType
+}
+
+func (e *CloudwatchEventRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	eventBuses, err := e.repository.ListAllEventBuses(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchEventBusResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, eventBus := range eventBuses {
+		rules, err := e.repository.ListAllRules(ctx, *eventBus.Name)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, rule := range rules {
+			// Rules created by AWS services on our behalf cannot be managed
+			if rule.ManagedBy != nil {
+				continue
+			}
+			// Attributes changed by hand during incidents are listed to be compared with the state
+			attrs := map[string]interface{}{
+				"name":           *rule.Name,
+				"event_bus_name": *eventBus.Name,
+				"is_enabled":     rule.State != nil && *rule.State == "ENABLED",
+			}
+			if rule.ScheduleExpression != nil {
+				attrs["schedule_expression"] = *rule.ScheduleExpression
+			}
+			if rule.EventPattern != nil {
+				attrs["event_pattern"] = *rule.EventPattern
+			}
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					eventRuleId(*eventBus.Name, *rule.Name),
+					attrs,
+				),
+			)
+		}
+	}
+
+	return results, err
+}
+
+// eventRuleId returns the ID terraform gives to rules, rules of the default event bus are only identified by their name
+func eventRuleId(eventBusName, ruleName string) string {
+	if eventBusName == defaultEventBusName {
+		return ruleName
+	}
+	return fmt.Sprintf("%s/%s", eventBusName, ruleName)
+}
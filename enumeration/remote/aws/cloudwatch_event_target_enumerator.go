@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchEventTargetEnumerator struct {
+	repository repository.EventBridgeRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchEventTargetEnumerator(repo repository.EventBridgeRepository, factory resource.ResourceFactory) *CloudwatchEventTargetEnumerator {
+	return &CloudwatchEventTargetEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchEventTargetEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchEventTargetResourceType
+}
+
+func (e *CloudwatchEventTargetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	eventBuses, err := e.repository.ListAllEventBuses(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchEventBusResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, eventBus := range eventBuses {
+		rules, err := e.repository.ListAllRules(ctx, *eventBus.Name)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchEventRuleResourceType)
+		}
+
+		for _, rule := range rules {
+			if rule.ManagedBy != nil {
+				continue
+			}
+
+			targets, err := e.repository.ListAllTargets(ctx, *eventBus.Name, *rule.Name)
+			if err != nil {
+				return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+			}
+
+			for _, target := range targets {
+				results = append(
+					results,
+					e.factory.CreateAbstractResource(
+						string(e.SupportedType()),
+						// Terraform IDs targets from the ID of their rule, e.g. bus/rule-target
+						fmt.Sprintf("%s-%s", eventRuleId(*eventBus.Name, *rule.Name), *target.Id),
+						map[string]interface{}{
+							"event_bus_name": *eventBus.Name,
+							"rule":           *rule.Name,
+							"target_id":      *target.Id,
+							"arn":            *target.Arn,
+						},
+					),
+				)
+			}
+		}
+	}
+
+	return results, err
+}
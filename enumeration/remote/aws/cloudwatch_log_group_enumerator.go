@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchLogGroupEnumerator struct {
+	repository repository.CloudwatchLogsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchLogGroupEnumerator(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) *CloudwatchLogGroupEnumerator {
+	return &CloudwatchLogGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchLogGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchLogGroupResourceType
+}
+
+func (e *CloudwatchLogGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	logGroups, err := e.repository.ListAllLogGroups(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(logGroups))
+
+	for _, logGroup := range logGroups {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*logGroup.LogGroupName,
+				map[string]interface{}{
+					"name": *logGroup.LogGroupName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchMetricAlarmEnumerator struct {
+	repository repository.CloudwatchRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchMetricAlarmEnumerator(repo repository.CloudwatchRepository, factory resource.ResourceFactory) *CloudwatchMetricAlarmEnumerator {
+	return &CloudwatchMetricAlarmEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchMetricAlarmEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchMetricAlarmResourceType
+}
+
+func (e *CloudwatchMetricAlarmEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	alarms, err := e.repository.ListAllMetricAlarms(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(alarms))
+
+	for _, alarm := range alarms {
+		// Attributes changed by hand during incidents are listed to be compared with the state
+		attrs := map[string]interface{}{
+			"alarm_name":          *alarm.AlarmName,
+			"comparison_operator": awssdk.StringValue(alarm.ComparisonOperator),
+			"evaluation_periods":  float64(awssdk.Int64Value(alarm.EvaluationPeriods)),
+			"actions_enabled":     awssdk.BoolValue(alarm.ActionsEnabled),
+		}
+		if alarm.Threshold != nil {
+			attrs["threshold"] = *alarm.Threshold
+		}
+		if alarm.Period != nil {
+			attrs["period"] = float64(*alarm.Period)
+		}
+		if alarm.DatapointsToAlarm != nil {
+			attrs["datapoints_to_alarm"] = float64(*alarm.DatapointsToAlarm)
+		}
+		if alarm.TreatMissingData != nil {
+			attrs["treat_missing_data"] = *alarm.TreatMissingData
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*alarm.AlarmName,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,9 @@
 package aws
 
 import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource/aws"
@@ -24,8 +27,8 @@ func (e *DefaultVPCEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsDefaultVpcResourceType
 }
 
-func (e *DefaultVPCEnumerator) Enumerate() ([]*resource.Resource, error) {
-	_, defaultVPCs, err := e.repo.ListAllVPCs()
+func (e *DefaultVPCEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	_, defaultVPCs, err := e.repo.ListAllVPCs(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -38,7 +41,9 @@ func (e *DefaultVPCEnumerator) Enumerate() ([]*resource.Resource, error) {
 			e.factory.CreateAbstractResource(
 				string(e.SupportedType()),
 				*item.VpcId,
-				map[string]interface{}{},
+				map[string]interface{}{
+					"dhcp_options_id": awssdk.StringValue(item.DhcpOptionsId),
+				},
 			),
 		)
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2DefaultSubnetEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsDefaultSubnetResourceType
 }
 
-func (e *EC2DefaultSubnetEnumerator) Enumerate() ([]*resource.Resource, error) {
-	_, defaultSubnets, err := e.repository.ListAllSubnets()
+func (e *EC2DefaultSubnetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	_, defaultSubnets, err := e.repository.ListAllSubnets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EC2FlowLogEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2FlowLogEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2FlowLogEnumerator {
+	return &EC2FlowLogEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2FlowLogEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsFlowLogResourceType
+}
+
+func (e *EC2FlowLogEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	flowLogs, err := e.repository.ListAllFlowLogs(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(flowLogs))
+
+	for _, flowLog := range flowLogs {
+		attrs := map[string]interface{}{}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*flowLog.FlowLogId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
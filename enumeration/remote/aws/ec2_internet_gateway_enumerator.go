@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2InternetGatewayEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsInternetGatewayResourceType
 }
 
-func (e *EC2InternetGatewayEnumerator) Enumerate() ([]*resource.Resource, error) {
-	internetGateways, err := e.repository.ListAllInternetGateways()
+func (e *EC2InternetGatewayEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	internetGateways, err := e.repository.ListAllInternetGateways(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
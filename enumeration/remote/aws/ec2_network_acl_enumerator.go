@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2NetworkACLEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsNetworkACLResourceType
 }
 
-func (e *EC2NetworkACLEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.ListAllNetworkACLs()
+func (e *EC2NetworkACLEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.ListAllNetworkACLs(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
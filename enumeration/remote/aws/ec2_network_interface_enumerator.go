@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// NetworkInterfaceManagedByAttribute is the attribute used to tag network interfaces created by another
+// service or along with an instance, it is not an attribute of aws_network_interface
+const NetworkInterfaceManagedByAttribute = "eni_managed_by"
+
+type EC2NetworkInterfaceEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2NetworkInterfaceEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2NetworkInterfaceEnumerator {
+	return &EC2NetworkInterfaceEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2NetworkInterfaceEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsNetworkInterfaceResourceType
+}
+
+func (e *EC2NetworkInterfaceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	networkInterfaces, err := e.repository.ListAllNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(networkInterfaces))
+
+	for _, networkInterface := range networkInterfaces {
+		attrs := map[string]interface{}{
+			"subnet_id": awssdk.StringValue(networkInterface.SubnetId),
+		}
+		if managedBy := networkInterfaceManager(networkInterface); managedBy != "" {
+			attrs[NetworkInterfaceManagedByAttribute] = managedBy
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*networkInterface.NetworkInterfaceId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
+
+// Return the service or the instance that created the network interface, e.g. natGateway, amazon-elb or
+// instance, or an empty string for standalone network interfaces
+func networkInterfaceManager(networkInterface *ec2.NetworkInterface) string {
+	switch interfaceType := awssdk.StringValue(networkInterface.InterfaceType); interfaceType {
+	case ec2.NetworkInterfaceTypeInterface, ec2.NetworkInterfaceTypeEfa, ec2.NetworkInterfaceTypeTrunk, "":
+	default:
+		return interfaceType
+	}
+	if awssdk.BoolValue(networkInterface.RequesterManaged) {
+		return awssdk.StringValue(networkInterface.RequesterId)
+	}
+	if attachment := networkInterface.Attachment; attachment != nil && attachment.InstanceId != nil && awssdk.Int64Value(attachment.DeviceIndex) == 0 {
+		return "instance"
+	}
+	return ""
+}
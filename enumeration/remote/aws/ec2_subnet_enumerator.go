@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2SubnetEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsSubnetResourceType
 }
 
-func (e *EC2SubnetEnumerator) Enumerate() ([]*resource.Resource, error) {
-	subnets, _, err := e.repository.ListAllSubnets()
+func (e *EC2SubnetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	subnets, _, err := e.repository.ListAllSubnets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EC2TransitGatewayEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2TransitGatewayEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2TransitGatewayEnumerator {
+	return &EC2TransitGatewayEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2TransitGatewayEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEc2TransitGatewayResourceType
+}
+
+func (e *EC2TransitGatewayEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	gateways, err := e.repository.ListAllTransitGateways(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(gateways))
+
+	for _, gateway := range gateways {
+		state := awssdk.StringValue(gateway.State)
+		if state == ec2.TransitGatewayStateDeleting || state == ec2.TransitGatewayStateDeleted {
+			continue
+		}
+
+		attrs := map[string]interface{}{}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*gateway.TransitGatewayId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
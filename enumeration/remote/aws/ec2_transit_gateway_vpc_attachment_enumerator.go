@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EC2TransitGatewayVpcAttachmentEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2TransitGatewayVpcAttachmentEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2TransitGatewayVpcAttachmentEnumerator {
+	return &EC2TransitGatewayVpcAttachmentEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2TransitGatewayVpcAttachmentEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEc2TransitGatewayVpcAttachmentResourceType
+}
+
+func (e *EC2TransitGatewayVpcAttachmentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	attachments, err := e.repository.ListAllTransitGatewayVpcAttachments(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		state := awssdk.StringValue(attachment.State)
+		if state == ec2.TransitGatewayAttachmentStateDeleting || state == ec2.TransitGatewayAttachmentStateDeleted {
+			continue
+		}
+
+		attrs := map[string]interface{}{
+			"transit_gateway_id": awssdk.StringValue(attachment.TransitGatewayId),
+			"vpc_id":             awssdk.StringValue(attachment.VpcId),
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*attachment.TransitGatewayAttachmentId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ECRRepositoryEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsEcrRepositoryResourceType
 }
 
-func (e *ECRRepositoryEnumerator) Enumerate() ([]*resource.Resource, error) {
-	repos, err := e.repository.ListAllRepositories()
+func (e *ECRRepositoryEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	repos, err := e.repository.ListAllRepositories(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type ECSClusterCapacityProvidersEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSClusterCapacityProvidersEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSClusterCapacityProvidersEnumerator {
+	return &ECSClusterCapacityProvidersEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSClusterCapacityProvidersEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsClusterCapacityProvidersResourceType
+}
+
+// Enumerate returns the capacity providers of the clusters having some, they are identified by the cluster name
+func (e *ECSClusterCapacityProvidersEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEcsClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		if isInactiveECSCluster(cluster) {
+			continue
+		}
+		if len(cluster.CapacityProviders) == 0 && len(cluster.DefaultCapacityProviderStrategy) == 0 {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.ClusterName,
+				map[string]interface{}{
+					"cluster_name": *cluster.ClusterName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
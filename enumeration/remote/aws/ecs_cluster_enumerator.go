@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// ecsClusterStatusInactive is the status of deleted clusters, they are still described for a while
+const ecsClusterStatusInactive = "INACTIVE"
+
+type ECSClusterEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSClusterEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSClusterEnumerator {
+	return &ECSClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSClusterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsClusterResourceType
+}
+
+func (e *ECSClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		if isInactiveECSCluster(cluster) {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.ClusterArn,
+				map[string]interface{}{
+					"name": *cluster.ClusterName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
+
+func isInactiveECSCluster(cluster *ecs.Cluster) bool {
+	return cluster.Status != nil && *cluster.Status == ecsClusterStatusInactive
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// ecsServiceStatusInactive is the status of deleted services, they are still described for a while
+const ecsServiceStatusInactive = "INACTIVE"
+
+type ECSServiceEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSServiceEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSServiceEnumerator {
+	return &ECSServiceEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSServiceEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsServiceResourceType
+}
+
+func (e *ECSServiceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEcsClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		if isInactiveECSCluster(cluster) {
+			continue
+		}
+		services, err := e.repository.ListAllServices(ctx, *cluster.ClusterArn)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, service := range services {
+			if service.Status != nil && *service.Status == ecsServiceStatusInactive {
+				continue
+			}
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*service.ServiceArn,
+					map[string]interface{}{
+						"name":    *service.ServiceName,
+						"cluster": *cluster.ClusterArn,
+					},
+				),
+			)
+		}
+	}
+
+	return results, err
+}
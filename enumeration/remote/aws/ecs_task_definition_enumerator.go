@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type ECSTaskDefinitionEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSTaskDefinitionEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSTaskDefinitionEnumerator {
+	return &ECSTaskDefinitionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSTaskDefinitionEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsTaskDefinitionResourceType
+}
+
+// Enumerate returns the latest active revision of each task definition family, Terraform
+// identifies a task definition by its family and registers a new revision on each change.
+func (e *ECSTaskDefinitionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	arns, err := e.repository.ListAllActiveTaskDefinitions(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	type revision struct {
+		arn      string
+		revision int
+	}
+	var families []string
+	latest := make(map[string]revision)
+	for _, taskDefinitionArn := range arns {
+		family, rev, err := parseTaskDefinitionArn(taskDefinitionArn)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"arn": taskDefinitionArn,
+			}).Debugf("Unable to parse task definition ARN: %s", err)
+			continue
+		}
+		current, exists := latest[family]
+		if !exists {
+			families = append(families, family)
+		}
+		if !exists || rev > current.revision {
+			latest[family] = revision{arn: taskDefinitionArn, revision: rev}
+		}
+	}
+
+	results := make([]*resource.Resource, 0, len(families))
+
+	for _, family := range families {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				family,
+				map[string]interface{}{
+					"arn": latest[family].arn,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
+
+// parseTaskDefinitionArn returns the family and the revision of a task definition
+// from its ARN, e.g. arn:aws:ecs:us-east-1:123456789012:task-definition/web:3
+func parseTaskDefinitionArn(taskDefinitionArn string) (string, int, error) {
+	parsed, err := arn.Parse(taskDefinitionArn)
+	if err != nil {
+		return "", 0, err
+	}
+	familyRevision := strings.TrimPrefix(parsed.Resource, "task-definition/")
+	separator := strings.LastIndex(familyRevision, ":")
+	if separator < 0 {
+		return "", 0, errors.Errorf("missing revision in %s", parsed.Resource)
+	}
+	rev, err := strconv.Atoi(familyRevision[separator+1:])
+	if err != nil {
+		return "", 0, err
+	}
+	return familyRevision[:separator], rev, nil
+}
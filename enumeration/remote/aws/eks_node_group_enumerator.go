@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// EksNodeGroupLaunchTemplateAttribute is the attribute used to tag node groups with the name of the
+// launch template EKS created for them, it is not an attribute of aws_eks_node_group
+const EksNodeGroupLaunchTemplateAttribute = "eks_launch_template_name"
+
+// EksNodeGroupAutoscalingGroupsAttribute is the attribute used to tag node groups with the names of the
+// autoscaling groups EKS created for them, it is not an attribute of aws_eks_node_group
+const EksNodeGroupAutoscalingGroupsAttribute = "eks_autoscaling_group_names"
+
+type EKSNodeGroupEnumerator struct {
+	repository            repository.EKSRepository
+	autoscalingRepository repository.AutoScalingRepository
+	factory               resource.ResourceFactory
+}
+
+func NewEKSNodeGroupEnumerator(repo repository.EKSRepository, autoscalingRepo repository.AutoScalingRepository, factory resource.ResourceFactory) *EKSNodeGroupEnumerator {
+	return &EKSNodeGroupEnumerator{
+		repository:            repo,
+		autoscalingRepository: autoscalingRepo,
+		factory:               factory,
+	}
+}
+
+func (e *EKSNodeGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEksNodeGroupResourceType
+}
+
+func (e *EKSNodeGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEksClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		nodeGroups, err := e.repository.ListAllNodeGroups(ctx, *cluster.Name)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, nodeGroup := range nodeGroups {
+			attrs := map[string]interface{}{
+				"cluster_name":    *cluster.Name,
+				"node_group_name": *nodeGroup.NodegroupName,
+			}
+			groups := autoscalingGroupNames(nodeGroup)
+			if len(groups) > 0 {
+				names := make([]interface{}, 0, len(groups))
+				for _, group := range groups {
+					names = append(names, group)
+				}
+				attrs[EksNodeGroupAutoscalingGroupsAttribute] = names
+			}
+			name, err := e.launchTemplateName(ctx, groups)
+			if err != nil {
+				return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsAutoscalingGroupResourceType)
+			}
+			if name != "" {
+				attrs[EksNodeGroupLaunchTemplateAttribute] = name
+			}
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					fmt.Sprintf("%s:%s", *cluster.Name, *nodeGroup.NodegroupName),
+					attrs,
+				),
+			)
+		}
+	}
+
+	return results, err
+}
+
+// autoscalingGroupNames returns the names of the autoscaling groups EKS created for a managed node group
+func autoscalingGroupNames(nodeGroup *eks.Nodegroup) []string {
+	if nodeGroup.Resources == nil {
+		return nil
+	}
+	names := make([]string, 0, len(nodeGroup.Resources.AutoScalingGroups))
+	for _, group := range nodeGroup.Resources.AutoScalingGroups {
+		if group.Name != nil {
+			names = append(names, *group.Name)
+		}
+	}
+	return names
+}
+
+// launchTemplateName returns the name of the launch template EKS created for a managed node group, it is
+// the one used by the autoscaling groups of the node group
+func (e *EKSNodeGroupEnumerator) launchTemplateName(ctx context.Context, names []string) (string, error) {
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	groups, err := e.autoscalingRepository.DescribeAutoScalingGroups(ctx, names)
+	if err != nil {
+		return "", err
+	}
+	for _, group := range groups {
+		template := group.LaunchTemplate
+		if template == nil && group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil {
+			template = group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+		}
+		if template != nil && template.LaunchTemplateName != nil {
+			return *template.LaunchTemplateName, nil
+		}
+	}
+	return "", nil
+}
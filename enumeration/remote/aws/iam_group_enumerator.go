@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *IamGroupEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsIamGroupResourceType
 }
 
-func (e *IamGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	groups, err := e.repository.ListAllGroups()
+func (e *IamGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	groups, err := e.repository.ListAllGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsIamGroupResourceType)
 	}
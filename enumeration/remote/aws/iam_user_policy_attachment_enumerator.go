@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -25,14 +26,14 @@ func (e *IamUserPolicyAttachmentEnumerator) SupportedType() resource.ResourceTyp
 	return resourceaws.AwsIamUserPolicyAttachmentResourceType
 }
 
-func (e *IamUserPolicyAttachmentEnumerator) Enumerate() ([]*resource.Resource, error) {
-	users, err := e.repository.ListAllUsers()
+func (e *IamUserPolicyAttachmentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	users, err := e.repository.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsIamUserResourceType)
 	}
 
 	results := make([]*resource.Resource, 0)
-	policyAttachments, err := e.repository.ListAllUserPolicyAttachments(users)
+	policyAttachments, err := e.repository.ListAllUserPolicyAttachments(ctx, users)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
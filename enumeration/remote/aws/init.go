@@ -1,13 +1,18 @@
 package aws
 
 import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform/providers"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	client "github.com/snyk/driftctl/enumeration/remote/aws/client"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
+	tf "github.com/snyk/driftctl/enumeration/remote/terraform"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
 	"github.com/snyk/driftctl/enumeration/terraform"
 )
 
@@ -16,108 +21,101 @@ import (
  * Required to use Scanner
  */
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, opts common.RemoteOptions) error {
 
-	provider, err := NewAWSTerraformProvider(version, progress, configDir)
+	// A provider already initialized in the library is reused, its plugin keeps running between scans
+	provider, isInitialized := providerLibrary.Provider(terraform.AWS).(*AWSTerraformProvider)
+	if !isInitialized {
+		var err error
+		provider, err = NewAWSTerraformProvider(version, progress, configDir)
+		if err != nil {
+			return err
+		}
+	}
+	provider.maxRetries = opts.GetMaxRetries()
+	provider.SetConcurrency(opts.GetConcurrency())
+	err := provider.CheckCredentialsExist()
 	if err != nil {
 		return err
 	}
-	err = provider.CheckCredentialsExist()
+	regions, err := provider.ResolveRegions(opts.AWSRegions)
 	if err != nil {
 		return err
 	}
-	err = provider.Init()
+	accounts, err := provider.ResolveAccounts(opts)
 	if err != nil {
 		return err
 	}
+	// Resources of other accounts are read by Terraform providers assuming the role in those accounts
+	if opts.IsAWSMultiAccount() {
+		aliases := make([]string, 0, len(accounts)*len(regions))
+		for _, account := range accounts {
+			for _, region := range regions {
+				aliases = append(aliases, ProviderAlias(account.id, region))
+			}
+		}
+		provider.Config.Aliases = aliases
+	}
+	if !isInitialized {
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
+		providerLibrary.AddProvider(terraform.AWS, provider)
+	}
 
-	repositoryCache := cache.New(100)
-
-	s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(provider.session), repositoryCache)
-	s3ControlRepository := repository.NewS3ControlRepository(client.NewAWSClientFactory(provider.session), repositoryCache)
-	ec2repository := repository.NewEC2Repository(provider.session, repositoryCache)
-	elbv2Repository := repository.NewELBV2Repository(provider.session, repositoryCache)
-	route53repository := repository.NewRoute53Repository(provider.session, repositoryCache)
-	lambdaRepository := repository.NewLambdaRepository(provider.session, repositoryCache)
-	rdsRepository := repository.NewRDSRepository(provider.session, repositoryCache)
-	sqsRepository := repository.NewSQSRepository(provider.session, repositoryCache)
-	snsRepository := repository.NewSNSRepository(provider.session, repositoryCache)
-	cloudfrontRepository := repository.NewCloudfrontRepository(provider.session, repositoryCache)
-	dynamoDBRepository := repository.NewDynamoDBRepository(provider.session, repositoryCache)
-	ecrRepository := repository.NewECRRepository(provider.session, repositoryCache)
-	kmsRepository := repository.NewKMSRepository(provider.session, repositoryCache)
-	iamRepository := repository.NewIAMRepository(provider.session, repositoryCache)
-	cloudformationRepository := repository.NewCloudformationRepository(provider.session, repositoryCache)
-	cloudtrailRepository := repository.NewCloudtrailRepository(provider.session, repositoryCache)
-	apigatewayRepository := repository.NewApiGatewayRepository(provider.session, repositoryCache)
-	appAutoScalingRepository := repository.NewAppAutoScalingRepository(provider.session, repositoryCache)
-	apigatewayv2Repository := repository.NewApiGatewayV2Repository(provider.session, repositoryCache)
-	autoscalingRepository := repository.NewAutoScalingRepository(provider.session, repositoryCache)
-	elbRepository := repository.NewELBRepository(provider.session, repositoryCache)
-	elasticacheRepository := repository.NewElastiCacheRepository(provider.session, repositoryCache)
-
-	providerLibrary.AddProvider(terraform.AWS, provider)
-
-	remoteLibrary.AddEnumerator(NewS3BucketEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddEnumerator(NewS3BucketInventoryEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddEnumerator(NewS3BucketNotificationEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddEnumerator(NewS3BucketMetricsEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddEnumerator(NewS3BucketPolicyEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddEnumerator(NewS3BucketAnalyticEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddEnumerator(NewS3BucketPublicAccessBlockEnumerator(s3Repository, factory, provider.Config, alerter))
-	remoteLibrary.AddEnumerator(NewS3AccountPublicAccessBlockEnumerator(s3ControlRepository, factory, provider.accountId, alerter))
-
-	remoteLibrary.AddEnumerator(NewEC2EbsVolumeEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2EbsSnapshotEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2EipEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2AmiEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2KeyPairEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2EipAssociationEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2InstanceEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2InternetGatewayEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewVPCEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewDefaultVPCEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2RouteTableEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2DefaultRouteTableEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2RouteTableAssociationEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2SubnetEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2DefaultSubnetEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewVPCSecurityGroupEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewVPCDefaultSecurityGroupEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2NatGatewayEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2NetworkACLEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2NetworkACLRuleEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2DefaultNetworkACLEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2RouteEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewVPCSecurityGroupRuleEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewLaunchTemplateEnumerator(ec2repository, factory))
-	remoteLibrary.AddEnumerator(NewEC2EbsEncryptionByDefaultEnumerator(ec2repository, factory))
-
-	remoteLibrary.AddEnumerator(NewKMSKeyEnumerator(kmsRepository, factory))
-	remoteLibrary.AddEnumerator(NewKMSAliasEnumerator(kmsRepository, factory))
+	limiter := throttle.NewLimiter(opts.RateLimits)
 
-	remoteLibrary.AddEnumerator(NewRoute53HealthCheckEnumerator(route53repository, factory))
-	remoteLibrary.AddEnumerator(NewRoute53ZoneEnumerator(route53repository, factory))
-	remoteLibrary.AddEnumerator(NewRoute53RecordEnumerator(route53repository, factory))
+	for _, account := range accounts {
+		accountSession := throttledSession(account.session, limiter)
 
-	remoteLibrary.AddEnumerator(NewCloudfrontDistributionEnumerator(cloudfrontRepository, factory))
+		var library common.EnumeratorLibrary = remoteLibrary
+		// Resources are only tagged with their account when several accounts are requested,
+		// a single account scan keeps matching resources the way it always did
+		if opts.IsAWSMultiAccount() {
+			library = accountLibrary{remoteLibrary, account.id}
+		}
 
-	remoteLibrary.AddEnumerator(NewRDSDBInstanceEnumerator(rdsRepository, factory))
-	remoteLibrary.AddEnumerator(NewRDSDBSubnetGroupEnumerator(rdsRepository, factory))
+		// S3 buckets are listed globally and then filtered by region, so the repository and its cache are shared
+		// between regions to avoid listing buckets and retrieving their location more than once
+		s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(accountSession), cache.New(100))
 
-	remoteLibrary.AddEnumerator(NewSQSQueueEnumerator(sqsRepository, factory))
-	remoteLibrary.AddEnumerator(NewSQSQueuePolicyEnumerator(sqsRepository, factory))
+		for i, region := range regions {
+			sess := regionalSession(accountSession, region)
+			repositoryCache := cache.New(100)
 
-	remoteLibrary.AddEnumerator(NewSNSTopicEnumerator(snsRepository, factory))
-	remoteLibrary.AddEnumerator(NewSNSTopicPolicyEnumerator(snsRepository, factory))
-	remoteLibrary.AddEnumerator(NewSNSTopicSubscriptionEnumerator(snsRepository, factory, alerter))
+			// Global services are only enumerated once per account, using the first region
+			if i == 0 {
+				globalLibrary := common.WithCache(library, opts.Cache, "aws/"+account.id+"/global", factory)
+				initGlobalEnumerators(sess, repositoryCache, globalLibrary, factory, account.id, alerter)
+			}
 
-	remoteLibrary.AddEnumerator(NewDynamoDBTableEnumerator(dynamoDBRepository, factory))
+			providerConfig := provider.Config
+			providerConfig.DefaultAlias = region
 
-	remoteLibrary.AddEnumerator(NewIamPolicyEnumerator(iamRepository, factory))
+			regionalLibrary := common.WithCache(NewRegionalLibrary(library, region, regions, provider.Config.DefaultAlias), opts.Cache, "aws/"+account.id+"/"+region, factory)
+			initRegionalEnumerators(sess, repositoryCache, s3Repository, regionalLibrary, factory, providerConfig, provider.Schema(), alerter)
+		}
+	}
 
-	remoteLibrary.AddEnumerator(NewLambdaFunctionEnumerator(lambdaRepository, factory))
-	remoteLibrary.AddEnumerator(NewLambdaEventSourceMappingEnumerator(lambdaRepository, factory))
+	return nil
+}
+
+func initGlobalEnumerators(sess *session.Session, repositoryCache cache.Cache, remoteLibrary common.EnumeratorLibrary, factory resource.ResourceFactory, accountId string, alerter alerter.AlerterInterface) {
+	s3ControlRepository := repository.NewS3ControlRepository(client.NewAWSClientFactory(sess), repositoryCache)
+	route53repository := repository.NewRoute53Repository(sess, repositoryCache)
+	cloudfrontRepository := repository.NewCloudfrontRepository(sess, repositoryCache)
+	iamRepository := repository.NewIAMRepository(sess, repositoryCache)
+
+	remoteLibrary.AddEnumerator(NewS3AccountPublicAccessBlockEnumerator(s3ControlRepository, factory, accountId, alerter))
+
+	remoteLibrary.AddEnumerator(NewRoute53HealthCheckEnumerator(route53repository, factory))
+	remoteLibrary.AddEnumerator(NewRoute53ZoneEnumerator(route53repository, factory))
+	remoteLibrary.AddEnumerator(NewRoute53RecordEnumerator(route53repository, factory))
+
+	remoteLibrary.AddEnumerator(NewCloudfrontDistributionEnumerator(cloudfrontRepository, factory))
+
+	remoteLibrary.AddEnumerator(NewIamPolicyEnumerator(iamRepository, factory))
 
 	remoteLibrary.AddEnumerator(NewIamUserEnumerator(iamRepository, factory))
 	remoteLibrary.AddEnumerator(NewIamUserPolicyEnumerator(iamRepository, factory))
@@ -129,62 +127,169 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	remoteLibrary.AddEnumerator(NewIamGroupPolicyEnumerator(iamRepository, factory))
 	remoteLibrary.AddEnumerator(NewIamGroupEnumerator(iamRepository, factory))
 	remoteLibrary.AddEnumerator(NewIamGroupPolicyAttachmentEnumerator(iamRepository, factory))
+}
 
-	remoteLibrary.AddEnumerator(NewECRRepositoryEnumerator(ecrRepository, factory))
-	remoteLibrary.AddEnumerator(NewECRRepositoryPolicyEnumerator(ecrRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewRDSClusterEnumerator(rdsRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewCloudformationStackEnumerator(cloudformationRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewCloudtrailEnumerator(cloudtrailRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewApiGatewayRestApiEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayAccountEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayApiKeyEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayAuthorizerEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayStageEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayResourceEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayDomainNameEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayVpcLinkEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayRequestValidatorEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayRestApiPolicyEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayBasePathMappingEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayMethodEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayModelEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayMethodResponseEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayGatewayResponseEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayMethodSettingsEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayIntegrationEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayIntegrationResponseEnumerator(apigatewayRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewApiGatewayV2ApiEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2RouteEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2DeploymentEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2VpcLinkEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2AuthorizerEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2IntegrationEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2ModelEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2StageEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2RouteResponseEnumerator(apigatewayv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2MappingEnumerator(apigatewayv2Repository, apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2DomainNameEnumerator(apigatewayRepository, factory))
-	remoteLibrary.AddEnumerator(NewApiGatewayV2IntegrationResponseEnumerator(apigatewayv2Repository, factory))
-
-	remoteLibrary.AddEnumerator(NewAppAutoscalingTargetEnumerator(appAutoScalingRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewAppAutoscalingPolicyEnumerator(appAutoScalingRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewAppAutoscalingScheduledActionEnumerator(appAutoScalingRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewLaunchConfigurationEnumerator(autoscalingRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewLoadBalancerEnumerator(elbv2Repository, factory))
-	remoteLibrary.AddEnumerator(NewLoadBalancerListenerEnumerator(elbv2Repository, factory))
-
-	remoteLibrary.AddEnumerator(NewClassicLoadBalancerEnumerator(elbRepository, factory))
-
-	remoteLibrary.AddEnumerator(NewElastiCacheClusterEnumerator(elasticacheRepository, factory))
-
-	return nil
+func initRegionalEnumerators(sess *session.Session, repositoryCache cache.Cache, s3Repository repository.S3Repository, regionalLibrary common.EnumeratorLibrary, factory resource.ResourceFactory, providerConfig tf.TerraformProviderConfig, providerSchema map[string]providers.Schema, alerter alerter.AlerterInterface) {
+	ec2repository := repository.NewEC2Repository(sess, repositoryCache)
+	elbv2Repository := repository.NewELBV2Repository(sess, repositoryCache)
+	lambdaRepository := repository.NewLambdaRepository(sess, repositoryCache)
+	rdsRepository := repository.NewRDSRepository(sess, repositoryCache)
+	sqsRepository := repository.NewSQSRepository(sess, repositoryCache)
+	snsRepository := repository.NewSNSRepository(sess, repositoryCache)
+	dynamoDBRepository := repository.NewDynamoDBRepository(sess, repositoryCache)
+	ecrRepository := repository.NewECRRepository(sess, repositoryCache)
+	kmsRepository := repository.NewKMSRepository(sess, repositoryCache)
+	cloudformationRepository := repository.NewCloudformationRepository(sess, repositoryCache)
+	cloudtrailRepository := repository.NewCloudtrailRepository(sess, repositoryCache)
+	apigatewayRepository := repository.NewApiGatewayRepository(sess, repositoryCache)
+	appAutoScalingRepository := repository.NewAppAutoScalingRepository(sess, repositoryCache)
+	apigatewayv2Repository := repository.NewApiGatewayV2Repository(sess, repositoryCache)
+	autoscalingRepository := repository.NewAutoScalingRepository(sess, repositoryCache)
+	elbRepository := repository.NewELBRepository(sess, repositoryCache)
+	elasticacheRepository := repository.NewElastiCacheRepository(sess, repositoryCache)
+	ecsRepository := repository.NewECSRepository(sess, repositoryCache)
+	eksRepository := repository.NewEKSRepository(sess, repositoryCache)
+	secretsManagerRepository := repository.NewSecretsManagerRepository(sess, repositoryCache)
+	ssmRepository := repository.NewSSMRepository(sess, repositoryCache)
+	cloudwatchRepository := repository.NewCloudwatchRepository(sess, repositoryCache)
+	cloudwatchLogsRepository := repository.NewCloudwatchLogsRepository(sess, repositoryCache)
+	eventBridgeRepository := repository.NewEventBridgeRepository(sess, repositoryCache)
+
+	regionalLibrary.AddEnumerator(NewS3BucketEnumerator(s3Repository, factory, providerConfig, alerter))
+	regionalLibrary.AddEnumerator(NewS3BucketInventoryEnumerator(s3Repository, factory, providerConfig, alerter))
+	regionalLibrary.AddEnumerator(NewS3BucketNotificationEnumerator(s3Repository, factory, providerConfig, alerter))
+	regionalLibrary.AddEnumerator(NewS3BucketMetricsEnumerator(s3Repository, factory, providerConfig, alerter))
+	regionalLibrary.AddEnumerator(NewS3BucketPolicyEnumerator(s3Repository, factory, providerConfig, alerter))
+	regionalLibrary.AddEnumerator(NewS3BucketAnalyticEnumerator(s3Repository, factory, providerConfig, alerter))
+	regionalLibrary.AddEnumerator(NewS3BucketPublicAccessBlockEnumerator(s3Repository, factory, providerConfig, alerter))
+
+	regionalLibrary.AddEnumerator(NewEC2EbsVolumeEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2EbsSnapshotEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2EipEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2AmiEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2KeyPairEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2EipAssociationEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2InstanceEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2InternetGatewayEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewVPCEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewDefaultVPCEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2RouteTableEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2DefaultRouteTableEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2RouteTableAssociationEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2SubnetEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2DefaultSubnetEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewVPCSecurityGroupEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewVPCDefaultSecurityGroupEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2NatGatewayEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2NetworkACLEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2NetworkACLRuleEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2DefaultNetworkACLEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2RouteEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewVPCSecurityGroupRuleEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewLaunchTemplateEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2EbsEncryptionByDefaultEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewVPCEndpointEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewVPCPeeringConnectionEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2TransitGatewayEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2TransitGatewayVpcAttachmentEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2FlowLogEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewEC2NetworkInterfaceEnumerator(ec2repository, factory))
+	regionalLibrary.AddEnumerator(NewVPCDhcpOptionsEnumerator(ec2repository, factory))
+
+	regionalLibrary.AddEnumerator(NewKMSKeyEnumerator(kmsRepository, factory))
+	regionalLibrary.AddEnumerator(NewKMSAliasEnumerator(kmsRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewRDSDBInstanceEnumerator(rdsRepository, factory))
+	regionalLibrary.AddEnumerator(NewRDSDBSubnetGroupEnumerator(rdsRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewSQSQueueEnumerator(sqsRepository, factory))
+	regionalLibrary.AddEnumerator(NewSQSQueuePolicyEnumerator(sqsRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewSNSTopicEnumerator(snsRepository, factory))
+	regionalLibrary.AddEnumerator(NewSNSTopicPolicyEnumerator(snsRepository, factory))
+	regionalLibrary.AddEnumerator(NewSNSTopicSubscriptionEnumerator(snsRepository, factory, alerter))
+
+	regionalLibrary.AddEnumerator(NewDynamoDBTableEnumerator(dynamoDBRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewLambdaFunctionEnumerator(lambdaRepository, factory))
+	regionalLibrary.AddEnumerator(NewLambdaEventSourceMappingEnumerator(lambdaRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewECRRepositoryEnumerator(ecrRepository, factory))
+	regionalLibrary.AddEnumerator(NewECRRepositoryPolicyEnumerator(ecrRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewRDSClusterEnumerator(rdsRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewCloudformationStackEnumerator(cloudformationRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewCloudtrailEnumerator(cloudtrailRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewApiGatewayRestApiEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayAccountEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayApiKeyEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayAuthorizerEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayStageEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayResourceEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayDomainNameEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayVpcLinkEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayRequestValidatorEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayRestApiPolicyEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayBasePathMappingEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayMethodEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayModelEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayMethodResponseEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayGatewayResponseEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayMethodSettingsEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayIntegrationEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayIntegrationResponseEnumerator(apigatewayRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewApiGatewayV2ApiEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2RouteEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2DeploymentEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2VpcLinkEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2AuthorizerEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2IntegrationEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2ModelEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2StageEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2RouteResponseEnumerator(apigatewayv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2MappingEnumerator(apigatewayv2Repository, apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2DomainNameEnumerator(apigatewayRepository, factory))
+	regionalLibrary.AddEnumerator(NewApiGatewayV2IntegrationResponseEnumerator(apigatewayv2Repository, factory))
+
+	regionalLibrary.AddEnumerator(NewAppAutoscalingTargetEnumerator(appAutoScalingRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewAppAutoscalingPolicyEnumerator(appAutoScalingRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewAppAutoscalingScheduledActionEnumerator(appAutoScalingRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewLaunchConfigurationEnumerator(autoscalingRepository, factory))
+	regionalLibrary.AddEnumerator(NewAutoscalingGroupEnumerator(autoscalingRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewLoadBalancerEnumerator(elbv2Repository, factory))
+	regionalLibrary.AddEnumerator(NewLoadBalancerListenerEnumerator(elbv2Repository, factory))
+
+	regionalLibrary.AddEnumerator(NewClassicLoadBalancerEnumerator(elbRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewElastiCacheClusterEnumerator(elasticacheRepository, factory))
+
+	regionalLibrary.AddEnumerator(NewECSClusterEnumerator(ecsRepository, factory))
+	regionalLibrary.AddEnumerator(NewECSServiceEnumerator(ecsRepository, factory))
+	regionalLibrary.AddEnumerator(NewECSTaskDefinitionEnumerator(ecsRepository, factory))
+	// Older versions of the provider only know the capacity providers of a cluster through its attributes
+	if _, supported := providerSchema[aws.AwsEcsClusterCapacityProvidersResourceType]; supported {
+		regionalLibrary.AddEnumerator(NewECSClusterCapacityProvidersEnumerator(ecsRepository, factory))
+	}
+	regionalLibrary.AddEnumerator(NewEKSClusterEnumerator(eksRepository, factory))
+	regionalLibrary.AddEnumerator(NewEKSNodeGroupEnumerator(eksRepository, autoscalingRepository, factory))
+	regionalLibrary.AddEnumerator(NewEKSFargateProfileEnumerator(eksRepository, factory))
+	regionalLibrary.AddEnumerator(NewEKSAddonEnumerator(eksRepository, factory))
+	regionalLibrary.AddEnumerator(NewSecretsManagerSecretEnumerator(secretsManagerRepository, factory))
+	regionalLibrary.AddEnumerator(NewSecretsManagerSecretRotationEnumerator(secretsManagerRepository, factory))
+	regionalLibrary.AddEnumerator(NewSecretsManagerSecretPolicyEnumerator(secretsManagerRepository, factory))
+	regionalLibrary.AddEnumerator(NewSSMParameterEnumerator(ssmRepository, factory))
+	regionalLibrary.AddEnumerator(NewCloudwatchLogGroupEnumerator(cloudwatchLogsRepository, factory))
+	regionalLibrary.AddEnumerator(NewCloudwatchMetricAlarmEnumerator(cloudwatchRepository, factory))
+	regionalLibrary.AddEnumerator(NewCloudwatchDashboardEnumerator(cloudwatchRepository, factory))
+	regionalLibrary.AddEnumerator(NewCloudwatchEventBusEnumerator(eventBridgeRepository, factory))
+	regionalLibrary.AddEnumerator(NewCloudwatchEventRuleEnumerator(eventBridgeRepository, factory))
+	regionalLibrary.AddEnumerator(NewCloudwatchEventTargetEnumerator(eventBridgeRepository, factory))
 }
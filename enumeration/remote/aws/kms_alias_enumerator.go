@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *KMSAliasEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsKmsAliasResourceType
 }
 
-func (e *KMSAliasEnumerator) Enumerate() ([]*resource.Resource, error) {
-	aliases, err := e.repository.ListAllAliases()
+func (e *KMSAliasEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	aliases, err := e.repository.ListAllAliases(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *LaunchConfigurationEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsLaunchConfigurationResourceType
 }
 
-func (e *LaunchConfigurationEnumerator) Enumerate() ([]*resource.Resource, error) {
-	configs, err := e.repository.DescribeLaunchConfigurations()
+func (e *LaunchConfigurationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	configs, err := e.repository.DescribeLaunchConfigurations(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
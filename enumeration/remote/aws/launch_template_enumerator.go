@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *LaunchTemplateEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsLaunchTemplateResourceType
 }
 
-func (e *LaunchTemplateEnumerator) Enumerate() ([]*resource.Resource, error) {
-	templates, err := e.repository.DescribeLaunchTemplates()
+func (e *LaunchTemplateEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	templates, err := e.repository.DescribeLaunchTemplates(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -32,12 +34,16 @@ func (e *LaunchTemplateEnumerator) Enumerate() ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0, len(templates))
 
 	for _, tmpl := range templates {
+		attrs := map[string]interface{}{}
+		if tmpl.LaunchTemplateName != nil {
+			attrs["name"] = *tmpl.LaunchTemplateName
+		}
 		results = append(
 			results,
 			e.factory.CreateAbstractResource(
 				string(e.SupportedType()),
 				*tmpl.LaunchTemplateId,
-				map[string]interface{}{},
+				attrs,
 			),
 		)
 	}
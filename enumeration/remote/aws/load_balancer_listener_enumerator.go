@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *LoadBalancerListenerEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsLoadBalancerListenerResourceType
 }
 
-func (e *LoadBalancerListenerEnumerator) Enumerate() ([]*resource.Resource, error) {
-	loadBalancers, err := e.repository.ListAllLoadBalancers()
+func (e *LoadBalancerListenerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	loadBalancers, err := e.repository.ListAllLoadBalancers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsLoadBalancerResourceType)
 	}
@@ -32,7 +34,7 @@ func (e *LoadBalancerListenerEnumerator) Enumerate() ([]*resource.Resource, erro
 	results := make([]*resource.Resource, 0)
 
 	for _, lb := range loadBalancers {
-		listeners, err := e.repository.ListAllLoadBalancerListeners(*lb.LoadBalancerArn)
+		listeners, err := e.repository.ListAllLoadBalancerListeners(ctx, *lb.LoadBalancerArn)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,14 +1,23 @@
 package aws
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/remote/terraform"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 )
 
@@ -21,10 +30,7 @@ type awsConfig struct {
 	Region        string `cty:"region"`
 	MaxRetries    int
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AssumeRole []awsAssumeRoleConfig `cty:"assume_role"`
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
@@ -41,12 +47,25 @@ type awsConfig struct {
 	S3ForcePathStyle        bool
 }
 
+// awsAssumeRoleConfig is the assume_role block of the provider configuration
+type awsAssumeRoleConfig struct {
+	RoleARN     string `cty:"role_arn"`
+	ExternalID  string `cty:"external_id"`
+	SessionName string `cty:"session_name"`
+}
+
 type AWSTerraformProvider struct {
 	*terraform.TerraformProvider
 	session   *session.Session
 	name      string
 	version   string
 	accountId string
+	partition string
+	// maxRetries is the number of times the Terraform provider retries throttled requests when reading resources
+	maxRetries int
+	// assumeRoleName and assumeRoleExternalId are used to read resources of other accounts on multi-account scans
+	assumeRoleName       string
+	assumeRoleExternalId string
 }
 
 func NewAWSTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*AWSTerraformProvider, error) {
@@ -54,8 +73,9 @@ func NewAWSTerraformProvider(version string, progress enumeration.ProgressCounte
 		version = "3.19.0"
 	}
 	p := &AWSTerraformProvider{
-		version: version,
-		name:    "aws",
+		version:    version,
+		name:       "aws",
+		maxRetries: common.DefaultMaxRetries,
 	}
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
 		Key:       p.name,
@@ -77,15 +97,27 @@ func NewAWSTerraformProvider(version string, progress enumeration.ProgressCounte
 		Name:         p.name,
 		DefaultAlias: *p.session.Config.Region,
 		GetProviderConfig: func(alias string) interface{} {
-			return awsConfig{
-				Region: alias,
+			accountId, region := splitProviderAlias(alias)
+			if region == "" {
+				region = *p.session.Config.Region
+			}
+			config := awsConfig{
+				Region: region,
 				// Those two parameters are used to make sure that the credentials are not validated when calling
 				// Configure(). Credentials validation is now handled directly in driftctl
 				SkipCredsValidation:     true,
 				SkipRequestingAccountId: true,
 
-				MaxRetries: 10, // TODO make this configurable
+				MaxRetries: p.maxRetries,
+			}
+			if accountId != "" && p.assumeRoleName != "" {
+				config.AssumeRole = []awsAssumeRoleConfig{{
+					RoleARN:     p.roleArn(accountId, p.assumeRoleName),
+					ExternalID:  p.assumeRoleExternalId,
+					SessionName: assumeRoleSessionName,
+				}}
 			}
+			return config
 		},
 	}, progress)
 	if err != nil {
@@ -125,5 +157,118 @@ func (p *AWSTerraformProvider) CheckCredentialsExist() error {
 	}
 
 	p.accountId = aws.StringValue(identity.Account)
+	if callerArn, err := arn.Parse(aws.StringValue(identity.Arn)); err == nil {
+		p.partition = callerArn.Partition
+	}
 	return nil
 }
+
+// ResolveRegions returns the list of regions to enumerate. The region of the current session is used
+// when no region is given, and AWSAllRegions is expanded to every region enabled for the account.
+// Regions are registered as provider aliases so that a gRPC client is configured for each of them.
+func (p *AWSTerraformProvider) ResolveRegions(regions []string) ([]string, error) {
+	if len(regions) == 0 {
+		return []string{p.Config.DefaultAlias}, nil
+	}
+
+	for _, region := range regions {
+		if region == common.AWSAllRegions {
+			if len(regions) > 1 {
+				return nil, errors.Errorf("region '%s' cannot be used along with other regions", common.AWSAllRegions)
+			}
+			enabledRegions, err := p.listEnabledRegions()
+			if err != nil {
+				return nil, err
+			}
+			regions = enabledRegions
+			break
+		}
+	}
+
+	p.Config.Aliases = regions
+	return regions, nil
+}
+
+func (p *AWSTerraformProvider) listEnabledRegions() ([]string, error) {
+	// Without AllRegions, only regions that are enabled for the account are returned
+	out, err := ec2.New(p.session).DescribeRegions(&ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list enabled AWS regions")
+	}
+	regions := make([]string, 0, len(out.Regions))
+	for _, region := range out.Regions {
+		regions = append(regions, aws.StringValue(region.RegionName))
+	}
+	return regions, nil
+}
+
+// throttledSession returns a copy of the session waiting for the rate limit of each AWS service, a copy is used
+// so that handlers do not pile up when scanning again. Throttled requests are still retried by the session with
+// a jittered delay, the scanner only lists a resource type again once the session gave up.
+func throttledSession(sess *session.Session, limiter *throttle.Limiter) *session.Session {
+	sess = sess.Copy()
+	sess.Handlers.Send.PushFront(func(r *request.Request) {
+		if err := limiter.Wait(r.Context(), r.ClientInfo.ServiceName); err != nil {
+			r.Error = err
+		}
+	})
+	return sess
+}
+
+func regionalSession(sess *session.Session, region string) *session.Session {
+	if region == aws.StringValue(sess.Config.Region) {
+		return sess
+	}
+	return sess.Copy(&aws.Config{Region: aws.String(region)})
+}
+
+// ProviderAlias returns the alias of the Terraform provider reading resources of the given account and region.
+// Aliases are regions on single account scans, resources of other accounts are read by assuming a role.
+// The default region is used when the region is empty, e.g. for resources of global services.
+func ProviderAlias(accountId, region string) string {
+	if accountId == "" {
+		return region
+	}
+	return accountId + "/" + region
+}
+
+// enumerationTags are attributes enumerators tag resources with, they are not attributes of the resources
+var enumerationTags = []string{
+	EksClusterSecurityGroupAttribute,
+	EksNodeGroupLaunchTemplateAttribute,
+	EksNodeGroupAutoscalingGroupsAttribute,
+	NetworkInterfaceManagedByAttribute,
+}
+
+// PrepareReadResourceArgs reads resources with the provider configured for their region, and for their
+// account on multi-account scans, leaving out the attributes they were tagged with while being listed
+func (p *AWSTerraformProvider) PrepareReadResourceArgs(res *resource.Resource, args *tf.ReadResourceArgs) {
+	if res.Region != "" || res.Account != "" {
+		args.Attributes["alias"] = ProviderAlias(res.Account, res.Region)
+	}
+	for _, tag := range enumerationTags {
+		delete(args.Attributes, tag)
+	}
+}
+
+// unreadResourceTypes are built from the metadata listed by their enumerators, the Terraform provider
+// fetches the values of parameters and secrets when reading them
+var unreadResourceTypes = map[resource.ResourceType]struct{}{
+	resourceaws.AwsSsmParameterResourceType:                 {},
+	resourceaws.AwsSecretsManagerSecretResourceType:         {},
+	resourceaws.AwsSecretsManagerSecretRotationResourceType: {},
+	resourceaws.AwsSecretsManagerSecretPolicyResourceType:   {},
+}
+
+// SkipReadResource keeps parameters and secrets as they were listed so that their values are never fetched
+func (p *AWSTerraformProvider) SkipReadResource(ty resource.ResourceType) bool {
+	_, unread := unreadResourceTypes[ty]
+	return unread
+}
+
+func splitProviderAlias(alias string) (accountId, region string) {
+	if i := strings.Index(alias, "/"); i >= 0 {
+		return alias[:i], alias[i+1:]
+	}
+	return "", alias
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *RDSDBInstanceEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsDbInstanceResourceType
 }
 
-func (e *RDSDBInstanceEnumerator) Enumerate() ([]*resource.Resource, error) {
-	instances, err := e.repository.ListAllDBInstances()
+func (e *RDSDBInstanceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	instances, err := e.repository.ListAllDBInstances(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *RDSDBSubnetGroupEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsDbSubnetGroupResourceType
 }
 
-func (e *RDSDBSubnetGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	subnetGroups, err := e.repository.ListAllDBSubnetGroups()
+func (e *RDSDBSubnetGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	subnetGroups, err := e.repository.ListAllDBSubnetGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
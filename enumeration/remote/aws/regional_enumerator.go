@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// RegionalEnumerator wraps an enumerator of a regional service and sets the region every enumerated resource
+// comes from, so resources from a multi-region scan can be told apart.
+type RegionalEnumerator struct {
+	common.Enumerator
+	region string
+}
+
+func NewRegionalEnumerator(enumerator common.Enumerator, region string) *RegionalEnumerator {
+	return &RegionalEnumerator{
+		Enumerator: enumerator,
+		region:     region,
+	}
+}
+
+func (e *RegionalEnumerator) Region() string {
+	return e.region
+}
+
+func (e *RegionalEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.Enumerator.Enumerate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range resources {
+		if res == nil {
+			continue
+		}
+		if res.Region == "" {
+			res.Region = e.region
+		}
+	}
+
+	return resources, nil
+}
+
+// regionalLibrary registers enumerators of a given region in the remote library
+type regionalLibrary struct {
+	library common.EnumeratorLibrary
+	region  string
+}
+
+func (l regionalLibrary) AddEnumerator(enumerator common.Enumerator) {
+	l.library.AddEnumerator(NewRegionalEnumerator(enumerator, l.region))
+}
+
+// NewRegionalLibrary returns a library setting the region of enumerated resources, unless the only scanned
+// region is the default one, so that a default scan keeps matching and serializing resources the way it always did
+func NewRegionalLibrary(library common.EnumeratorLibrary, region string, regions []string, defaultRegion string) common.EnumeratorLibrary {
+	if len(regions) == 1 && regions[0] == defaultRegion {
+		return library
+	}
+	return regionalLibrary{library, region}
+}
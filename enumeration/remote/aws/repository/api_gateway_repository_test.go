@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -35,7 +36,7 @@ func Test_apigatewayRepository_ListAllRestApis(t *testing.T) {
 		{
 			name: "list multiple rest apis",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetRestApisPages",
+				client.On("GetRestApisPagesWithContext", mock.Anything,
 					&apigateway.GetRestApisInput{},
 					mock.MatchedBy(func(callback func(res *apigateway.GetRestApisOutput, lastPage bool) bool) bool {
 						callback(&apigateway.GetRestApisOutput{
@@ -71,7 +72,7 @@ func Test_apigatewayRepository_ListAllRestApis(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRestApis()
+			got, err := r.ListAllRestApis(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -102,7 +103,7 @@ func Test_apigatewayRepository_GetAccount(t *testing.T) {
 		{
 			name: "get a single account",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetAccount", &apigateway.GetAccountInput{}).Return(account, nil).Once()
+				client.On("GetAccountWithContext", mock.Anything, &apigateway.GetAccountInput{}).Return(account, nil).Once()
 
 				store.On("Get", "apigatewayGetAccount").Return(nil).Times(1)
 				store.On("Put", "apigatewayGetAccount", account).Return(false).Times(1)
@@ -126,7 +127,7 @@ func Test_apigatewayRepository_GetAccount(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.GetAccount()
+			got, err := r.GetAccount(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -162,7 +163,7 @@ func Test_apigatewayRepository_ListAllApiKeys(t *testing.T) {
 		{
 			name: "list multiple api keys",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetApiKeysPages",
+				client.On("GetApiKeysPagesWithContext", mock.Anything,
 					&apigateway.GetApiKeysInput{},
 					mock.MatchedBy(func(callback func(res *apigateway.GetApiKeysOutput, lastPage bool) bool) bool {
 						callback(&apigateway.GetApiKeysOutput{
@@ -196,7 +197,7 @@ func Test_apigatewayRepository_ListAllApiKeys(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApiKeys()
+			got, err := r.ListAllApiKeys(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -234,7 +235,7 @@ func Test_apigatewayRepository_ListAllRestApiAuthorizers(t *testing.T) {
 		{
 			name: "list multiple rest api authorizers",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetAuthorizers",
+				client.On("GetAuthorizersWithContext", mock.Anything,
 					&apigateway.GetAuthorizersInput{
 						RestApiId: aws.String("restapi1"),
 					}).Return(&apigateway.GetAuthorizersOutput{Items: apiAuthorizers}, nil).Once()
@@ -261,7 +262,7 @@ func Test_apigatewayRepository_ListAllRestApiAuthorizers(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRestApiAuthorizers(*api.Id)
+			got, err := r.ListAllRestApiAuthorizers(context.Background(), *api.Id)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -299,7 +300,7 @@ func Test_apigatewayRepository_ListAllRestApiStages(t *testing.T) {
 		{
 			name: "list multiple rest api stages",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetStages",
+				client.On("GetStagesWithContext", mock.Anything,
 					&apigateway.GetStagesInput{
 						RestApiId: aws.String("restapi1"),
 					}).Return(&apigateway.GetStagesOutput{Item: apiStages}, nil).Once()
@@ -328,7 +329,7 @@ func Test_apigatewayRepository_ListAllRestApiStages(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRestApiStages(*api.Id)
+			got, err := r.ListAllRestApiStages(context.Background(), *api.Id)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -366,7 +367,7 @@ func Test_apigatewayRepository_ListAllRestApiResources(t *testing.T) {
 		{
 			name: "list multiple rest api resources",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetResourcesPages",
+				client.On("GetResourcesPagesWithContext", mock.Anything,
 					&apigateway.GetResourcesInput{
 						RestApiId: aws.String("restapi1"),
 						Embed:     []*string{aws.String("methods")},
@@ -402,7 +403,7 @@ func Test_apigatewayRepository_ListAllRestApiResources(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRestApiResources(*api.Id)
+			got, err := r.ListAllRestApiResources(context.Background(), *api.Id)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -438,7 +439,7 @@ func Test_apigatewayRepository_ListAllDomainNames(t *testing.T) {
 		{
 			name: "list multiple domain names",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetDomainNamesPages",
+				client.On("GetDomainNamesPagesWithContext", mock.Anything,
 					&apigateway.GetDomainNamesInput{},
 					mock.MatchedBy(func(callback func(res *apigateway.GetDomainNamesOutput, lastPage bool) bool) bool {
 						callback(&apigateway.GetDomainNamesOutput{
@@ -474,7 +475,7 @@ func Test_apigatewayRepository_ListAllDomainNames(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllDomainNames()
+			got, err := r.ListAllDomainNames(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -510,7 +511,7 @@ func Test_apigatewayRepository_ListAllVpcLinks(t *testing.T) {
 		{
 			name: "list multiple vpc links",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetVpcLinksPages",
+				client.On("GetVpcLinksPagesWithContext", mock.Anything,
 					&apigateway.GetVpcLinksInput{},
 					mock.MatchedBy(func(callback func(res *apigateway.GetVpcLinksOutput, lastPage bool) bool) bool {
 						callback(&apigateway.GetVpcLinksOutput{
@@ -544,7 +545,7 @@ func Test_apigatewayRepository_ListAllVpcLinks(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllVpcLinks()
+			got, err := r.ListAllVpcLinks(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -584,7 +585,7 @@ func Test_apigatewayRepository_ListAllRestApiRequestValidators(t *testing.T) {
 		{
 			name: "list multiple rest api request validators",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetRequestValidators",
+				client.On("GetRequestValidatorsWithContext", mock.Anything,
 					&apigateway.GetRequestValidatorsInput{
 						RestApiId: aws.String("restapi1"),
 					}).Return(&apigateway.GetRequestValidatorsOutput{Items: requestValidators}, nil).Once()
@@ -604,7 +605,7 @@ func Test_apigatewayRepository_ListAllRestApiRequestValidators(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetRequestValidators",
+				client.On("GetRequestValidatorsWithContext", mock.Anything,
 					&apigateway.GetRequestValidatorsInput{
 						RestApiId: aws.String("restapi1"),
 					}).Return(nil, remoteError).Once()
@@ -623,7 +624,7 @@ func Test_apigatewayRepository_ListAllRestApiRequestValidators(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRestApiRequestValidators(*api.Id)
+			got, err := r.ListAllRestApiRequestValidators(context.Background(), *api.Id)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -663,7 +664,7 @@ func Test_apigatewayRepository_ListAllDomainNameBasePathMappings(t *testing.T) {
 		{
 			name: "list multiple domain name base path mappings",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetBasePathMappingsPages",
+				client.On("GetBasePathMappingsPagesWithContext", mock.Anything,
 					&apigateway.GetBasePathMappingsInput{
 						DomainName: aws.String("domainName1"),
 					},
@@ -689,7 +690,7 @@ func Test_apigatewayRepository_ListAllDomainNameBasePathMappings(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetBasePathMappingsPages",
+				client.On("GetBasePathMappingsPagesWithContext", mock.Anything,
 					&apigateway.GetBasePathMappingsInput{
 						DomainName: aws.String("domainName1"),
 					}, mock.AnythingOfType("func(*apigateway.GetBasePathMappingsOutput, bool) bool")).Return(remoteError).Once()
@@ -708,7 +709,7 @@ func Test_apigatewayRepository_ListAllDomainNameBasePathMappings(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllDomainNameBasePathMappings(*domainName.DomainName)
+			got, err := r.ListAllDomainNameBasePathMappings(context.Background(), *domainName.DomainName)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -748,7 +749,7 @@ func Test_apigatewayRepository_ListAllRestApiModels(t *testing.T) {
 		{
 			name: "list multiple rest api models",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetModelsPages",
+				client.On("GetModelsPagesWithContext", mock.Anything,
 					&apigateway.GetModelsInput{
 						RestApiId: aws.String("restapi1"),
 					},
@@ -774,7 +775,7 @@ func Test_apigatewayRepository_ListAllRestApiModels(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetModelsPages",
+				client.On("GetModelsPagesWithContext", mock.Anything,
 					&apigateway.GetModelsInput{
 						RestApiId: aws.String("restapi1"),
 					}, mock.AnythingOfType("func(*apigateway.GetModelsOutput, bool) bool")).Return(remoteError).Once()
@@ -793,7 +794,7 @@ func Test_apigatewayRepository_ListAllRestApiModels(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRestApiModels(*api.Id)
+			got, err := r.ListAllRestApiModels(context.Background(), *api.Id)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -833,7 +834,7 @@ func Test_apigatewayRepository_ListAllRestApiGatewayResponses(t *testing.T) {
 		{
 			name: "list multiple rest api gateway responses",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetGatewayResponses",
+				client.On("GetGatewayResponsesWithContext", mock.Anything,
 					&apigateway.GetGatewayResponsesInput{
 						RestApiId: aws.String("restapi1"),
 					}).Return(&apigateway.GetGatewayResponsesOutput{Items: gtwResponses}, nil).Once()
@@ -853,7 +854,7 @@ func Test_apigatewayRepository_ListAllRestApiGatewayResponses(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGateway, store *cache.MockCache) {
-				client.On("GetGatewayResponses",
+				client.On("GetGatewayResponsesWithContext", mock.Anything,
 					&apigateway.GetGatewayResponsesInput{
 						RestApiId: aws.String("restapi1"),
 					}).Return(nil, remoteError).Once()
@@ -872,7 +873,7 @@ func Test_apigatewayRepository_ListAllRestApiGatewayResponses(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRestApiGatewayResponses(*api.Id)
+			got, err := r.ListAllRestApiGatewayResponses(context.Background(), *api.Id)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
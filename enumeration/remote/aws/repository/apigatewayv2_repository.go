@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -10,17 +11,17 @@ import (
 )
 
 type ApiGatewayV2Repository interface {
-	ListAllApis() ([]*apigatewayv2.Api, error)
-	ListAllApiRoutes(apiId *string) ([]*apigatewayv2.Route, error)
-	ListAllApiDeployments(apiId *string) ([]*apigatewayv2.Deployment, error)
-	ListAllVpcLinks() ([]*apigatewayv2.VpcLink, error)
-	ListAllApiAuthorizers(string) ([]*apigatewayv2.Authorizer, error)
-	ListAllApiIntegrations(string) ([]*apigatewayv2.Integration, error)
-	ListAllApiModels(string) ([]*apigatewayv2.Model, error)
-	ListAllApiStages(string) ([]*apigatewayv2.Stage, error)
-	ListAllApiRouteResponses(string, string) ([]*apigatewayv2.RouteResponse, error)
-	ListAllApiMappings(string) ([]*apigatewayv2.ApiMapping, error)
-	ListAllApiIntegrationResponses(string, string) ([]*apigatewayv2.IntegrationResponse, error)
+	ListAllApis(ctx context.Context) ([]*apigatewayv2.Api, error)
+	ListAllApiRoutes(ctx context.Context, apiId *string) ([]*apigatewayv2.Route, error)
+	ListAllApiDeployments(ctx context.Context, apiId *string) ([]*apigatewayv2.Deployment, error)
+	ListAllVpcLinks(ctx context.Context) ([]*apigatewayv2.VpcLink, error)
+	ListAllApiAuthorizers(context.Context, string) ([]*apigatewayv2.Authorizer, error)
+	ListAllApiIntegrations(context.Context, string) ([]*apigatewayv2.Integration, error)
+	ListAllApiModels(context.Context, string) ([]*apigatewayv2.Model, error)
+	ListAllApiStages(context.Context, string) ([]*apigatewayv2.Stage, error)
+	ListAllApiRouteResponses(context.Context, string, string) ([]*apigatewayv2.RouteResponse, error)
+	ListAllApiMappings(context.Context, string) ([]*apigatewayv2.ApiMapping, error)
+	ListAllApiIntegrationResponses(context.Context, string, string) ([]*apigatewayv2.IntegrationResponse, error)
 }
 type apigatewayv2Repository struct {
 	client apigatewayv2iface.ApiGatewayV2API
@@ -34,7 +35,7 @@ func NewApiGatewayV2Repository(session *session.Session, c cache.Cache) *apigate
 	}
 }
 
-func (r *apigatewayv2Repository) ListAllApis() ([]*apigatewayv2.Api, error) {
+func (r *apigatewayv2Repository) ListAllApis(ctx context.Context) ([]*apigatewayv2.Api, error) {
 	cacheKey := "apigatewayv2ListAllApis"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -43,7 +44,7 @@ func (r *apigatewayv2Repository) ListAllApis() ([]*apigatewayv2.Api, error) {
 	}
 
 	input := apigatewayv2.GetApisInput{}
-	resources, err := r.client.GetApis(&input)
+	resources, err := r.client.GetApisWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +53,7 @@ func (r *apigatewayv2Repository) ListAllApis() ([]*apigatewayv2.Api, error) {
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiRoutes(apiID *string) ([]*apigatewayv2.Route, error) {
+func (r *apigatewayv2Repository) ListAllApiRoutes(ctx context.Context, apiID *string) ([]*apigatewayv2.Route, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiRoutes_api_%s", *apiID)
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -60,7 +61,7 @@ func (r *apigatewayv2Repository) ListAllApiRoutes(apiID *string) ([]*apigatewayv
 		return v.([]*apigatewayv2.Route), nil
 	}
 
-	resources, err := r.client.GetRoutes(&apigatewayv2.GetRoutesInput{ApiId: apiID})
+	resources, err := r.client.GetRoutesWithContext(ctx, &apigatewayv2.GetRoutesInput{ApiId: apiID})
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +69,7 @@ func (r *apigatewayv2Repository) ListAllApiRoutes(apiID *string) ([]*apigatewayv
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiDeployments(apiID *string) ([]*apigatewayv2.Deployment, error) {
+func (r *apigatewayv2Repository) ListAllApiDeployments(ctx context.Context, apiID *string) ([]*apigatewayv2.Deployment, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiDeployments_api_%s", *apiID)
 	v := r.cache.Get(cacheKey)
 
@@ -76,7 +77,7 @@ func (r *apigatewayv2Repository) ListAllApiDeployments(apiID *string) ([]*apigat
 		return v.([]*apigatewayv2.Deployment), nil
 	}
 
-	resources, err := r.client.GetDeployments(&apigatewayv2.GetDeploymentsInput{ApiId: apiID})
+	resources, err := r.client.GetDeploymentsWithContext(ctx, &apigatewayv2.GetDeploymentsInput{ApiId: apiID})
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +85,13 @@ func (r *apigatewayv2Repository) ListAllApiDeployments(apiID *string) ([]*apigat
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllVpcLinks() ([]*apigatewayv2.VpcLink, error) {
+func (r *apigatewayv2Repository) ListAllVpcLinks(ctx context.Context) ([]*apigatewayv2.VpcLink, error) {
 	if v := r.cache.Get("apigatewayv2ListAllVpcLinks"); v != nil {
 		return v.([]*apigatewayv2.VpcLink), nil
 	}
 
 	input := apigatewayv2.GetVpcLinksInput{}
-	resources, err := r.client.GetVpcLinks(&input)
+	resources, err := r.client.GetVpcLinksWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +100,7 @@ func (r *apigatewayv2Repository) ListAllVpcLinks() ([]*apigatewayv2.VpcLink, err
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiAuthorizers(apiId string) ([]*apigatewayv2.Authorizer, error) {
+func (r *apigatewayv2Repository) ListAllApiAuthorizers(ctx context.Context, apiId string) ([]*apigatewayv2.Authorizer, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiAuthorizers_api_%s", apiId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigatewayv2.Authorizer), nil
@@ -108,7 +109,7 @@ func (r *apigatewayv2Repository) ListAllApiAuthorizers(apiId string) ([]*apigate
 	input := apigatewayv2.GetAuthorizersInput{
 		ApiId: &apiId,
 	}
-	resources, err := r.client.GetAuthorizers(&input)
+	resources, err := r.client.GetAuthorizersWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +118,7 @@ func (r *apigatewayv2Repository) ListAllApiAuthorizers(apiId string) ([]*apigate
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiIntegrations(apiId string) ([]*apigatewayv2.Integration, error) {
+func (r *apigatewayv2Repository) ListAllApiIntegrations(ctx context.Context, apiId string) ([]*apigatewayv2.Integration, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiIntegrations_api_%s", apiId)
 
 	if v := r.cache.Get(cacheKey); v != nil {
@@ -127,7 +128,7 @@ func (r *apigatewayv2Repository) ListAllApiIntegrations(apiId string) ([]*apigat
 	input := apigatewayv2.GetIntegrationsInput{
 		ApiId: &apiId,
 	}
-	resources, err := r.client.GetIntegrations(&input)
+	resources, err := r.client.GetIntegrationsWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +137,7 @@ func (r *apigatewayv2Repository) ListAllApiIntegrations(apiId string) ([]*apigat
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiModels(apiId string) ([]*apigatewayv2.Model, error) {
+func (r *apigatewayv2Repository) ListAllApiModels(ctx context.Context, apiId string) ([]*apigatewayv2.Model, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiModels_api_%s", apiId)
 
 	if v := r.cache.Get(cacheKey); v != nil {
@@ -146,7 +147,7 @@ func (r *apigatewayv2Repository) ListAllApiModels(apiId string) ([]*apigatewayv2
 	input := apigatewayv2.GetModelsInput{
 		ApiId: &apiId,
 	}
-	resources, err := r.client.GetModels(&input)
+	resources, err := r.client.GetModelsWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +156,7 @@ func (r *apigatewayv2Repository) ListAllApiModels(apiId string) ([]*apigatewayv2
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiStages(apiId string) ([]*apigatewayv2.Stage, error) {
+func (r *apigatewayv2Repository) ListAllApiStages(ctx context.Context, apiId string) ([]*apigatewayv2.Stage, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiStages_api_%s", apiId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigatewayv2.Stage), nil
@@ -164,7 +165,7 @@ func (r *apigatewayv2Repository) ListAllApiStages(apiId string) ([]*apigatewayv2
 	input := apigatewayv2.GetStagesInput{
 		ApiId: &apiId,
 	}
-	resources, err := r.client.GetStages(&input)
+	resources, err := r.client.GetStagesWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +174,7 @@ func (r *apigatewayv2Repository) ListAllApiStages(apiId string) ([]*apigatewayv2
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiIntegrationResponses(apiId, integrationId string) ([]*apigatewayv2.IntegrationResponse, error) {
+func (r *apigatewayv2Repository) ListAllApiIntegrationResponses(ctx context.Context, apiId, integrationId string) ([]*apigatewayv2.IntegrationResponse, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiIntegrationResponses_api_%s_integration_%s", apiId, integrationId)
 	v := r.cache.Get(cacheKey)
 	if v != nil {
@@ -183,7 +184,7 @@ func (r *apigatewayv2Repository) ListAllApiIntegrationResponses(apiId, integrati
 		ApiId:         &apiId,
 		IntegrationId: &integrationId,
 	}
-	resources, err := r.client.GetIntegrationResponses(&input)
+	resources, err := r.client.GetIntegrationResponsesWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +192,7 @@ func (r *apigatewayv2Repository) ListAllApiIntegrationResponses(apiId, integrati
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiRouteResponses(apiId, routeId string) ([]*apigatewayv2.RouteResponse, error) {
+func (r *apigatewayv2Repository) ListAllApiRouteResponses(ctx context.Context, apiId, routeId string) ([]*apigatewayv2.RouteResponse, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiRouteResponses_api_%s_route_%s", apiId, routeId)
 	v := r.cache.Get(cacheKey)
 	if v != nil {
@@ -201,7 +202,7 @@ func (r *apigatewayv2Repository) ListAllApiRouteResponses(apiId, routeId string)
 		ApiId:   &apiId,
 		RouteId: &routeId,
 	}
-	resources, err := r.client.GetRouteResponses(&input)
+	resources, err := r.client.GetRouteResponsesWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +210,7 @@ func (r *apigatewayv2Repository) ListAllApiRouteResponses(apiId, routeId string)
 	return resources.Items, nil
 }
 
-func (r *apigatewayv2Repository) ListAllApiMappings(domainName string) ([]*apigatewayv2.ApiMapping, error) {
+func (r *apigatewayv2Repository) ListAllApiMappings(ctx context.Context, domainName string) ([]*apigatewayv2.ApiMapping, error) {
 	cacheKey := fmt.Sprintf("apigatewayv2ListAllApiMappings_api_%s", domainName)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigatewayv2.ApiMapping), nil
@@ -218,7 +219,7 @@ func (r *apigatewayv2Repository) ListAllApiMappings(domainName string) ([]*apiga
 	input := apigatewayv2.GetApiMappingsInput{
 		DomainName: &domainName,
 	}
-	resources, err := r.client.GetApiMappings(&input)
+	resources, err := r.client.GetApiMappingsWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/r3labs/diff/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_apigatewayv2Repository_ListAllApis(t *testing.T) {
@@ -35,7 +37,7 @@ func Test_apigatewayv2Repository_ListAllApis(t *testing.T) {
 		{
 			name: "list multiple apis",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetApis",
+				client.On("GetApisWithContext", mock.Anything,
 					&apigatewayv2.GetApisInput{}).Return(&apigatewayv2.GetApisOutput{Items: apis}, nil).Once()
 
 				store.On("GetAndLock", "apigatewayv2ListAllApis").Return(nil).Times(1)
@@ -55,7 +57,7 @@ func Test_apigatewayv2Repository_ListAllApis(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetApis",
+				client.On("GetApisWithContext", mock.Anything,
 					&apigatewayv2.GetApisInput{}).Return(nil, remoteError).Once()
 
 				store.On("GetAndLock", "apigatewayv2ListAllApis").Return(nil).Times(1)
@@ -73,7 +75,7 @@ func Test_apigatewayv2Repository_ListAllApis(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApis()
+			got, err := r.ListAllApis(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -108,7 +110,7 @@ func Test_apigatewayv2Repository_ListAllApiRoutes(t *testing.T) {
 		{
 			name: "list multiple routes",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetRoutes",
+				client.On("GetRoutesWithContext", mock.Anything,
 					&apigatewayv2.GetRoutesInput{ApiId: aws.String("an-id")}).
 					Return(&apigatewayv2.GetRoutesOutput{Items: routes}, nil).Once()
 
@@ -129,7 +131,7 @@ func Test_apigatewayv2Repository_ListAllApiRoutes(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetRoutes",
+				client.On("GetRoutesWithContext", mock.Anything,
 					&apigatewayv2.GetRoutesInput{ApiId: aws.String("an-id")}).Return(nil, remoteError).Once()
 
 				store.On("GetAndLock", "apigatewayv2ListAllApiRoutes_api_an-id").Return(nil).Times(1)
@@ -147,7 +149,7 @@ func Test_apigatewayv2Repository_ListAllApiRoutes(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApiRoutes(aws.String("an-id"))
+			got, err := r.ListAllApiRoutes(context.Background(), aws.String("an-id"))
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -182,7 +184,7 @@ func Test_apigatewayv2Repository_ListAllApiDeployments(t *testing.T) {
 		{
 			name: "list multiple deployments",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetDeployments",
+				client.On("GetDeploymentsWithContext", mock.Anything,
 					&apigatewayv2.GetDeploymentsInput{ApiId: aws.String("an-id")}).
 					Return(&apigatewayv2.GetDeploymentsOutput{Items: deployments}, nil).Once()
 
@@ -201,7 +203,7 @@ func Test_apigatewayv2Repository_ListAllApiDeployments(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetDeployments",
+				client.On("GetDeploymentsWithContext", mock.Anything,
 					&apigatewayv2.GetDeploymentsInput{ApiId: aws.String("an-id")}).Return(nil, remoteError).Once()
 
 				store.On("Get", "apigatewayv2ListAllApiDeployments_api_an-id").Return(nil).Times(1)
@@ -218,7 +220,7 @@ func Test_apigatewayv2Repository_ListAllApiDeployments(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApiDeployments(aws.String("an-id"))
+			got, err := r.ListAllApiDeployments(context.Background(), aws.String("an-id"))
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -256,7 +258,7 @@ func Test_apigatewayv2Repository_ListAllVpcLinks(t *testing.T) {
 		{
 			name: "list multiple vpc links",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetVpcLinks",
+				client.On("GetVpcLinksWithContext", mock.Anything,
 					&apigatewayv2.GetVpcLinksInput{}).Return(&apigatewayv2.GetVpcLinksOutput{Items: vpcLinks}, nil).Once()
 
 				store.On("Get", "apigatewayv2ListAllVpcLinks").Return(nil).Times(1)
@@ -274,7 +276,7 @@ func Test_apigatewayv2Repository_ListAllVpcLinks(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetVpcLinks",
+				client.On("GetVpcLinksWithContext", mock.Anything,
 					&apigatewayv2.GetVpcLinksInput{}).Return(nil, remoteError).Once()
 
 				store.On("Get", "apigatewayv2ListAllVpcLinks").Return(nil).Times(1)
@@ -291,7 +293,7 @@ func Test_apigatewayv2Repository_ListAllVpcLinks(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllVpcLinks()
+			got, err := r.ListAllVpcLinks(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -331,7 +333,7 @@ func Test_apigatewayv2Repository_ListAllApiAuthorizers(t *testing.T) {
 		{
 			name: "list multiple api authorizers",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetAuthorizers",
+				client.On("GetAuthorizersWithContext", mock.Anything,
 					&apigatewayv2.GetAuthorizersInput{
 						ApiId: aws.String("api1"),
 					}).Return(&apigatewayv2.GetAuthorizersOutput{Items: apiAuthorizers}, nil).Once()
@@ -351,7 +353,7 @@ func Test_apigatewayv2Repository_ListAllApiAuthorizers(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetAuthorizers",
+				client.On("GetAuthorizersWithContext", mock.Anything,
 					&apigatewayv2.GetAuthorizersInput{
 						ApiId: aws.String("api1"),
 					}).Return(nil, remoteError).Once()
@@ -370,7 +372,7 @@ func Test_apigatewayv2Repository_ListAllApiAuthorizers(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApiAuthorizers(*api.ApiId)
+			got, err := r.ListAllApiAuthorizers(context.Background(), *api.ApiId)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -410,7 +412,7 @@ func Test_apigatewayv2Repository_ListAllApiIntegrations(t *testing.T) {
 		{
 			name: "list multiple api integrations",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetIntegrations",
+				client.On("GetIntegrationsWithContext", mock.Anything,
 					&apigatewayv2.GetIntegrationsInput{
 						ApiId: aws.String("api1"),
 					}).Return(&apigatewayv2.GetIntegrationsOutput{Items: apiIntegrations}, nil).Once()
@@ -430,7 +432,7 @@ func Test_apigatewayv2Repository_ListAllApiIntegrations(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetIntegrations",
+				client.On("GetIntegrationsWithContext", mock.Anything,
 					&apigatewayv2.GetIntegrationsInput{
 						ApiId: aws.String("api1"),
 					}).Return(nil, remoteError).Once()
@@ -449,7 +451,7 @@ func Test_apigatewayv2Repository_ListAllApiIntegrations(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApiIntegrations(*api.ApiId)
+			got, err := r.ListAllApiIntegrations(context.Background(), *api.ApiId)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -493,7 +495,7 @@ func Test_apigatewayv2Repository_ListAllApiRouteResponses(t *testing.T) {
 		{
 			name: "list multiple api route responses",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetRouteResponses",
+				client.On("GetRouteResponsesWithContext", mock.Anything,
 					&apigatewayv2.GetRouteResponsesInput{
 						ApiId:   aws.String("api1"),
 						RouteId: aws.String("route1"),
@@ -514,7 +516,7 @@ func Test_apigatewayv2Repository_ListAllApiRouteResponses(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetRouteResponses",
+				client.On("GetRouteResponsesWithContext", mock.Anything,
 					&apigatewayv2.GetRouteResponsesInput{
 						ApiId:   aws.String("api1"),
 						RouteId: aws.String("route1"),
@@ -534,7 +536,7 @@ func Test_apigatewayv2Repository_ListAllApiRouteResponses(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApiRouteResponses(*api.ApiId, *route.RouteId)
+			got, err := r.ListAllApiRouteResponses(context.Background(), *api.ApiId, *route.RouteId)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -578,7 +580,7 @@ func Test_apigatewayv2Repository_ListAllApiIntegrationResponses(t *testing.T) {
 		{
 			name: "list multiple api integration responses",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetIntegrationResponses",
+				client.On("GetIntegrationResponsesWithContext", mock.Anything,
 					&apigatewayv2.GetIntegrationResponsesInput{
 						ApiId:         aws.String("api1"),
 						IntegrationId: aws.String("integration1"),
@@ -599,7 +601,7 @@ func Test_apigatewayv2Repository_ListAllApiIntegrationResponses(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeApiGatewayV2, store *cache.MockCache) {
-				client.On("GetIntegrationResponses",
+				client.On("GetIntegrationResponsesWithContext", mock.Anything,
 					&apigatewayv2.GetIntegrationResponsesInput{
 						ApiId:         aws.String("api1"),
 						IntegrationId: aws.String("integration1"),
@@ -619,7 +621,7 @@ func Test_apigatewayv2Repository_ListAllApiIntegrationResponses(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllApiIntegrationResponses(*api.ApiId, *integration.IntegrationId)
+			got, err := r.ListAllApiIntegrationResponses(context.Background(), *api.ApiId, *integration.IntegrationId)
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
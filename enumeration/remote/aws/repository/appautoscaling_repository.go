@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -10,10 +11,10 @@ import (
 )
 
 type AppAutoScalingRepository interface {
-	ServiceNamespaceValues() []string
-	DescribeScalableTargets(string) ([]*applicationautoscaling.ScalableTarget, error)
-	DescribeScalingPolicies(string) ([]*applicationautoscaling.ScalingPolicy, error)
-	DescribeScheduledActions(string) ([]*applicationautoscaling.ScheduledAction, error)
+	ServiceNamespaceValues(ctx context.Context) []string
+	DescribeScalableTargets(context.Context, string) ([]*applicationautoscaling.ScalableTarget, error)
+	DescribeScalingPolicies(context.Context, string) ([]*applicationautoscaling.ScalingPolicy, error)
+	DescribeScheduledActions(context.Context, string) ([]*applicationautoscaling.ScheduledAction, error)
 }
 
 type appAutoScalingRepository struct {
@@ -28,11 +29,11 @@ func NewAppAutoScalingRepository(session *session.Session, c cache.Cache) *appAu
 	}
 }
 
-func (r *appAutoScalingRepository) ServiceNamespaceValues() []string {
+func (r *appAutoScalingRepository) ServiceNamespaceValues(ctx context.Context) []string {
 	return applicationautoscaling.ServiceNamespace_Values()
 }
 
-func (r *appAutoScalingRepository) DescribeScalableTargets(namespace string) ([]*applicationautoscaling.ScalableTarget, error) {
+func (r *appAutoScalingRepository) DescribeScalableTargets(ctx context.Context, namespace string) ([]*applicationautoscaling.ScalableTarget, error) {
 	cacheKey := fmt.Sprintf("appAutoScalingDescribeScalableTargets_%s", namespace)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*applicationautoscaling.ScalableTarget), nil
@@ -41,7 +42,7 @@ func (r *appAutoScalingRepository) DescribeScalableTargets(namespace string) ([]
 	input := &applicationautoscaling.DescribeScalableTargetsInput{
 		ServiceNamespace: &namespace,
 	}
-	result, err := r.client.DescribeScalableTargets(input)
+	result, err := r.client.DescribeScalableTargetsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +51,7 @@ func (r *appAutoScalingRepository) DescribeScalableTargets(namespace string) ([]
 	return result.ScalableTargets, nil
 }
 
-func (r *appAutoScalingRepository) DescribeScalingPolicies(namespace string) ([]*applicationautoscaling.ScalingPolicy, error) {
+func (r *appAutoScalingRepository) DescribeScalingPolicies(ctx context.Context, namespace string) ([]*applicationautoscaling.ScalingPolicy, error) {
 	cacheKey := fmt.Sprintf("appAutoScalingDescribeScalingPolicies_%s", namespace)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*applicationautoscaling.ScalingPolicy), nil
@@ -59,7 +60,7 @@ func (r *appAutoScalingRepository) DescribeScalingPolicies(namespace string) ([]
 	input := &applicationautoscaling.DescribeScalingPoliciesInput{
 		ServiceNamespace: &namespace,
 	}
-	result, err := r.client.DescribeScalingPolicies(input)
+	result, err := r.client.DescribeScalingPoliciesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +69,7 @@ func (r *appAutoScalingRepository) DescribeScalingPolicies(namespace string) ([]
 	return result.ScalingPolicies, nil
 }
 
-func (r *appAutoScalingRepository) DescribeScheduledActions(namespace string) ([]*applicationautoscaling.ScheduledAction, error) {
+func (r *appAutoScalingRepository) DescribeScheduledActions(ctx context.Context, namespace string) ([]*applicationautoscaling.ScheduledAction, error) {
 	cacheKey := fmt.Sprintf("appAutoScalingDescribeScheduledActions_%s", namespace)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*applicationautoscaling.ScheduledAction), nil
@@ -77,7 +78,7 @@ func (r *appAutoScalingRepository) DescribeScheduledActions(namespace string) ([
 	input := &applicationautoscaling.DescribeScheduledActionsInput{
 		ServiceNamespace: &namespace,
 	}
-	result, err := r.client.DescribeScheduledActions(input)
+	result, err := r.client.DescribeScheduledActionsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
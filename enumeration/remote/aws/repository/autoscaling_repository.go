@@ -1,6 +1,11 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
@@ -8,7 +13,9 @@ import (
 )
 
 type AutoScalingRepository interface {
-	DescribeLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error)
+	DescribeLaunchConfigurations(ctx context.Context) ([]*autoscaling.LaunchConfiguration, error)
+	// DescribeAutoScalingGroups describes the groups of the given names, every group when no name is given
+	DescribeAutoScalingGroups(ctx context.Context, names []string) ([]*autoscaling.Group, error)
 }
 
 type autoScalingRepository struct {
@@ -23,7 +30,7 @@ func NewAutoScalingRepository(session *session.Session, c cache.Cache) *autoScal
 	}
 }
 
-func (r *autoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error) {
+func (r *autoScalingRepository) DescribeLaunchConfigurations(ctx context.Context) ([]*autoscaling.LaunchConfiguration, error) {
 	cacheKey := "DescribeLaunchConfigurations"
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*autoscaling.LaunchConfiguration), nil
@@ -31,7 +38,7 @@ func (r *autoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.L
 
 	var results []*autoscaling.LaunchConfiguration
 	input := &autoscaling.DescribeLaunchConfigurationsInput{}
-	err := r.client.DescribeLaunchConfigurationsPages(input, func(resp *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+	err := r.client.DescribeLaunchConfigurationsPagesWithContext(ctx, input, func(resp *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
 		results = append(results, resp.LaunchConfigurations...)
 		return !lastPage
 	})
@@ -42,3 +49,25 @@ func (r *autoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.L
 	r.cache.Put(cacheKey, results)
 	return results, nil
 }
+
+func (r *autoScalingRepository) DescribeAutoScalingGroups(ctx context.Context, names []string) ([]*autoscaling.Group, error) {
+	cacheKey := fmt.Sprintf("DescribeAutoScalingGroups_%s", strings.Join(names, ","))
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*autoscaling.Group), nil
+	}
+
+	var results []*autoscaling.Group
+	input := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(names),
+	}
+	err := r.client.DescribeAutoScalingGroupsPagesWithContext(ctx, input, func(resp *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		results = append(results, resp.AutoScalingGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, results)
+	return results, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -37,7 +38,7 @@ func Test_AutoscalingRepository_DescribeLaunchConfigurations(t *testing.T) {
 			mocks: func(client *awstest.MockFakeAutoscaling, store *cache.MockCache) {
 				store.On("Get", "DescribeLaunchConfigurations").Return(nil).Once()
 
-				client.On("DescribeLaunchConfigurationsPages",
+				client.On("DescribeLaunchConfigurationsPagesWithContext", mock.Anything,
 					&autoscaling.DescribeLaunchConfigurationsInput{},
 					mock.MatchedBy(func(callback func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool) bool {
 						callback(&autoscaling.DescribeLaunchConfigurationsOutput{
@@ -65,7 +66,7 @@ func Test_AutoscalingRepository_DescribeLaunchConfigurations(t *testing.T) {
 			mocks: func(client *awstest.MockFakeAutoscaling, store *cache.MockCache) {
 				store.On("Get", "DescribeLaunchConfigurations").Return(nil).Once()
 
-				client.On("DescribeLaunchConfigurationsPages", &autoscaling.DescribeLaunchConfigurationsInput{}, mock.MatchedBy(func(callback func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool) bool {
+				client.On("DescribeLaunchConfigurationsPagesWithContext", mock.Anything, &autoscaling.DescribeLaunchConfigurationsInput{}, mock.MatchedBy(func(callback func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool) bool {
 					callback(&autoscaling.DescribeLaunchConfigurationsOutput{
 						LaunchConfigurations: []*autoscaling.LaunchConfiguration{},
 					}, true)
@@ -85,7 +86,7 @@ func Test_AutoscalingRepository_DescribeLaunchConfigurations(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeLaunchConfigurations()
+			got, err := r.DescribeLaunchConfigurations(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -102,3 +103,79 @@ func Test_AutoscalingRepository_DescribeLaunchConfigurations(t *testing.T) {
 		})
 	}
 }
+
+func Test_AutoscalingRepository_DescribeAutoScalingGroups(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	names := []string{"eks-workers-1", "eks-workers-2"}
+	cacheKey := "DescribeAutoScalingGroups_eks-workers-1,eks-workers-2"
+	expectedGroups := []*autoscaling.Group{
+		{AutoScalingGroupName: aws.String("eks-workers-1")},
+		{AutoScalingGroupName: aws.String("eks-workers-2")},
+	}
+
+	tests := []struct {
+		name    string
+		mocks   func(*awstest.MockFakeAutoscaling, *cache.MockCache)
+		want    []*autoscaling.Group
+		wantErr error
+	}{
+		{
+			name: "Describe autoscaling groups",
+			mocks: func(client *awstest.MockFakeAutoscaling, store *cache.MockCache) {
+				store.On("Get", cacheKey).Return(nil).Once()
+
+				client.On("DescribeAutoScalingGroupsPagesWithContext", mock.Anything,
+					&autoscaling.DescribeAutoScalingGroupsInput{AutoScalingGroupNames: aws.StringSlice(names)},
+					mock.MatchedBy(func(callback func(res *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool) bool {
+						callback(&autoscaling.DescribeAutoScalingGroupsOutput{
+							AutoScalingGroups: expectedGroups[:1],
+						}, false)
+						callback(&autoscaling.DescribeAutoScalingGroupsOutput{
+							AutoScalingGroups: expectedGroups[1:],
+						}, true)
+						return true
+					})).Return(nil).Once()
+
+				store.On("Put", cacheKey, expectedGroups).Return(false).Once()
+			},
+			want: expectedGroups,
+		},
+		{
+			name: "Hit cache and describe autoscaling groups",
+			mocks: func(client *awstest.MockFakeAutoscaling, store *cache.MockCache) {
+				store.On("Get", cacheKey).Return(expectedGroups).Once()
+			},
+			want: expectedGroups,
+		},
+		{
+			name: "Error describing autoscaling groups",
+			mocks: func(client *awstest.MockFakeAutoscaling, store *cache.MockCache) {
+				store.On("Get", cacheKey).Return(nil).Once()
+
+				client.On("DescribeAutoScalingGroupsPagesWithContext", mock.Anything,
+					&autoscaling.DescribeAutoScalingGroupsInput{AutoScalingGroupNames: aws.StringSlice(names)},
+					mock.Anything).Return(dummyError).Once()
+			},
+			want:    nil,
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeAutoscaling{}
+			tt.mocks(client, store)
+			r := &autoScalingRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.DescribeAutoScalingGroups(context.Background(), names)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
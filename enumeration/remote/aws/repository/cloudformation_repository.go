@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
@@ -8,7 +9,7 @@ import (
 )
 
 type CloudformationRepository interface {
-	ListAllStacks() ([]*cloudformation.Stack, error)
+	ListAllStacks(ctx context.Context) ([]*cloudformation.Stack, error)
 }
 
 type cloudformationRepository struct {
@@ -23,14 +24,14 @@ func NewCloudformationRepository(session *session.Session, c cache.Cache) *cloud
 	}
 }
 
-func (r *cloudformationRepository) ListAllStacks() ([]*cloudformation.Stack, error) {
+func (r *cloudformationRepository) ListAllStacks(ctx context.Context) ([]*cloudformation.Stack, error) {
 	if v := r.cache.Get("cloudformationListAllStacks"); v != nil {
 		return v.([]*cloudformation.Stack), nil
 	}
 
 	var stacks []*cloudformation.Stack
 	input := cloudformation.DescribeStacksInput{}
-	err := r.client.DescribeStacksPages(&input,
+	err := r.client.DescribeStacksPagesWithContext(ctx, &input,
 		func(resp *cloudformation.DescribeStacksOutput, lastPage bool) bool {
 			if resp.Stacks != nil {
 				stacks = append(stacks, resp.Stacks...)
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -25,7 +26,7 @@ func Test_cloudfrontRepository_ListAllDistributions(t *testing.T) {
 		{
 			name: "list multiple distributions",
 			mocks: func(client *awstest.MockFakeCloudFront) {
-				client.On("ListDistributionsPages",
+				client.On("ListDistributionsPagesWithContext", mock.Anything,
 					&cloudfront.ListDistributionsInput{},
 					mock.MatchedBy(func(callback func(res *cloudfront.ListDistributionsOutput, lastPage bool) bool) bool {
 						callback(&cloudfront.ListDistributionsOutput{
@@ -68,12 +69,12 @@ func Test_cloudfrontRepository_ListAllDistributions(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllDistributions()
+			got, err := r.ListAllDistributions(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllDistributions()
+				cachedData, err := r.ListAllDistributions(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*cloudfront.DistributionSummary{}, store.Get("cloudfrontListAllDistributions"))
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
@@ -8,7 +9,7 @@ import (
 )
 
 type CloudtrailRepository interface {
-	ListAllTrails() ([]*cloudtrail.TrailInfo, error)
+	ListAllTrails(ctx context.Context) ([]*cloudtrail.TrailInfo, error)
 }
 
 type cloudtrailRepository struct {
@@ -23,7 +24,7 @@ func NewCloudtrailRepository(session *session.Session, c cache.Cache) *cloudtrai
 	}
 }
 
-func (r *cloudtrailRepository) ListAllTrails() ([]*cloudtrail.TrailInfo, error) {
+func (r *cloudtrailRepository) ListAllTrails(ctx context.Context) ([]*cloudtrail.TrailInfo, error) {
 	cacheKey := "ListAllTrails"
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*cloudtrail.TrailInfo), nil
@@ -31,7 +32,7 @@ func (r *cloudtrailRepository) ListAllTrails() ([]*cloudtrail.TrailInfo, error)
 
 	var trails []*cloudtrail.TrailInfo
 	input := cloudtrail.ListTrailsInput{}
-	err := r.client.ListTrailsPages(&input,
+	err := r.client.ListTrailsPagesWithContext(ctx, &input,
 		func(resp *cloudtrail.ListTrailsOutput, lastPage bool) bool {
 			if resp.Trails != nil {
 				trails = append(trails, resp.Trails...)
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -26,7 +27,7 @@ func Test_cloudtrailRepository_ListAllTrails(t *testing.T) {
 		{
 			name: "list multiple trail",
 			mocks: func(client *awstest.MockFakeCloudtrail) {
-				client.On("ListTrailsPages",
+				client.On("ListTrailsPagesWithContext", mock.Anything,
 					&cloudtrail.ListTrailsInput{},
 					mock.MatchedBy(func(callback func(res *cloudtrail.ListTrailsOutput, lastPage bool) bool) bool {
 						callback(&cloudtrail.ListTrailsOutput{
@@ -65,12 +66,12 @@ func Test_cloudtrailRepository_ListAllTrails(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllTrails()
+			got, err := r.ListAllTrails(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllTrails()
+				cachedData, err := r.ListAllTrails(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*cloudtrail.TrailInfo{}, store.Get("ListAllTrails"))
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudwatchRepository_ListAllMetricAlarms(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatch)
+		want    []*cloudwatch.MetricAlarm
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatch) {
+				client.On("DescribeAlarmsPagesWithContext", mock.Anything,
+					&cloudwatch.DescribeAlarmsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatch.DescribeAlarmsOutput{MetricAlarms: []*cloudwatch.MetricAlarm{
+							{AlarmName: aws.String("cpu-high")},
+						}}, false)
+						callback(&cloudwatch.DescribeAlarmsOutput{MetricAlarms: []*cloudwatch.MetricAlarm{
+							{AlarmName: aws.String("errors")},
+						}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cloudwatch.MetricAlarm{
+				{AlarmName: aws.String("cpu-high")},
+				{AlarmName: aws.String("errors")},
+			},
+		},
+		{
+			name: "should return an error",
+			mocks: func(client *awstest.MockFakeCloudWatch) {
+				client.On("DescribeAlarmsPagesWithContext", mock.Anything,
+					&cloudwatch.DescribeAlarmsInput{},
+					mock.AnythingOfType("func(*cloudwatch.DescribeAlarmsOutput, bool) bool")).Return(dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatch{}
+			tt.mocks(&client)
+			r := &cloudwatchRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllMetricAlarms(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllMetricAlarms(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatch.MetricAlarm{}, store.Get("cloudwatchListAllMetricAlarms"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudwatchRepository_ListAllDashboards(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatch)
+		want    []*cloudwatch.DashboardEntry
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatch) {
+				client.On("ListDashboardsPagesWithContext", mock.Anything,
+					&cloudwatch.ListDashboardsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatch.ListDashboardsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatch.ListDashboardsOutput{DashboardEntries: []*cloudwatch.DashboardEntry{
+							{DashboardName: aws.String("main")},
+						}}, false)
+						callback(&cloudwatch.ListDashboardsOutput{DashboardEntries: []*cloudwatch.DashboardEntry{
+							{DashboardName: aws.String("oncall")},
+						}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cloudwatch.DashboardEntry{
+				{DashboardName: aws.String("main")},
+				{DashboardName: aws.String("oncall")},
+			},
+		},
+		{
+			name: "should return an error",
+			mocks: func(client *awstest.MockFakeCloudWatch) {
+				client.On("ListDashboardsPagesWithContext", mock.Anything,
+					&cloudwatch.ListDashboardsInput{},
+					mock.AnythingOfType("func(*cloudwatch.ListDashboardsOutput, bool) bool")).Return(dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatch{}
+			tt.mocks(&client)
+			r := &cloudwatchRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllDashboards(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllDashboards(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatch.DashboardEntry{}, store.Get("cloudwatchListAllDashboards"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type CloudwatchLogsRepository interface {
+	ListAllLogGroups(ctx context.Context) ([]*cloudwatchlogs.LogGroup, error)
+}
+
+type cloudwatchLogsRepository struct {
+	client cloudwatchlogsiface.CloudWatchLogsAPI
+	cache  cache.Cache
+}
+
+func NewCloudwatchLogsRepository(session *session.Session, c cache.Cache) *cloudwatchLogsRepository {
+	return &cloudwatchLogsRepository{
+		cloudwatchlogs.New(session),
+		c,
+	}
+}
+
+func (r *cloudwatchLogsRepository) ListAllLogGroups(ctx context.Context) ([]*cloudwatchlogs.LogGroup, error) {
+	if v := r.cache.Get("cloudwatchLogsListAllLogGroups"); v != nil {
+		return v.([]*cloudwatchlogs.LogGroup), nil
+	}
+
+	var logGroups []*cloudwatchlogs.LogGroup
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	err := r.client.DescribeLogGroupsPagesWithContext(ctx, input, func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		logGroups = append(logGroups, res.LogGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudwatchLogsListAllLogGroups", logGroups)
+	return logGroups, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudwatchLogsRepository_ListAllLogGroups(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudWatchLogs)
+		want    []*cloudwatchlogs.LogGroup
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudWatchLogs) {
+				client.On("DescribeLogGroupsPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeLogGroupsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: []*cloudwatchlogs.LogGroup{
+							{LogGroupName: aws.String("/ecs/web")},
+						}}, false)
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: []*cloudwatchlogs.LogGroup{
+							{LogGroupName: aws.String("/aws/lambda/foo")},
+						}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*cloudwatchlogs.LogGroup{
+				{LogGroupName: aws.String("/ecs/web")},
+				{LogGroupName: aws.String("/aws/lambda/foo")},
+			},
+		},
+		{
+			name: "should return an error",
+			mocks: func(client *awstest.MockFakeCloudWatchLogs) {
+				client.On("DescribeLogGroupsPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeLogGroupsInput{},
+					mock.AnythingOfType("func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool")).Return(dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeCloudWatchLogs{}
+			tt.mocks(&client)
+			r := &cloudwatchLogsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllLogGroups(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllLogGroups(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*cloudwatchlogs.LogGroup{}, store.Get("cloudwatchLogsListAllLogGroups"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -9,22 +10,29 @@ import (
 )
 
 type EC2Repository interface {
-	ListAllImages() ([]*ec2.Image, error)
-	ListAllSnapshots() ([]*ec2.Snapshot, error)
-	ListAllVolumes() ([]*ec2.Volume, error)
-	ListAllAddresses() ([]*ec2.Address, error)
-	ListAllAddressesAssociation() ([]*ec2.Address, error)
-	ListAllInstances() ([]*ec2.Instance, error)
-	ListAllKeyPairs() ([]*ec2.KeyPairInfo, error)
-	ListAllInternetGateways() ([]*ec2.InternetGateway, error)
-	ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error)
-	ListAllNatGateways() ([]*ec2.NatGateway, error)
-	ListAllRouteTables() ([]*ec2.RouteTable, error)
-	ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error)
-	ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)
-	ListAllNetworkACLs() ([]*ec2.NetworkAcl, error)
-	DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error)
-	IsEbsEncryptionEnabledByDefault() (bool, error)
+	ListAllImages(ctx context.Context) ([]*ec2.Image, error)
+	ListAllSnapshots(ctx context.Context) ([]*ec2.Snapshot, error)
+	ListAllVolumes(ctx context.Context) ([]*ec2.Volume, error)
+	ListAllAddresses(ctx context.Context) ([]*ec2.Address, error)
+	ListAllAddressesAssociation(ctx context.Context) ([]*ec2.Address, error)
+	ListAllInstances(ctx context.Context) ([]*ec2.Instance, error)
+	ListAllKeyPairs(ctx context.Context) ([]*ec2.KeyPairInfo, error)
+	ListAllInternetGateways(ctx context.Context) ([]*ec2.InternetGateway, error)
+	ListAllSubnets(ctx context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error)
+	ListAllNatGateways(ctx context.Context) ([]*ec2.NatGateway, error)
+	ListAllRouteTables(ctx context.Context) ([]*ec2.RouteTable, error)
+	ListAllVPCs(ctx context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error)
+	ListAllSecurityGroups(ctx context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)
+	ListAllNetworkACLs(ctx context.Context) ([]*ec2.NetworkAcl, error)
+	DescribeLaunchTemplates(ctx context.Context) ([]*ec2.LaunchTemplate, error)
+	IsEbsEncryptionEnabledByDefault(ctx context.Context) (bool, error)
+	ListAllVpcEndpoints(ctx context.Context) ([]*ec2.VpcEndpoint, error)
+	ListAllVpcPeeringConnections(ctx context.Context) ([]*ec2.VpcPeeringConnection, error)
+	ListAllTransitGateways(ctx context.Context) ([]*ec2.TransitGateway, error)
+	ListAllTransitGatewayVpcAttachments(ctx context.Context) ([]*ec2.TransitGatewayVpcAttachment, error)
+	ListAllFlowLogs(ctx context.Context) ([]*ec2.FlowLog, error)
+	ListAllNetworkInterfaces(ctx context.Context) ([]*ec2.NetworkInterface, error)
+	ListAllDhcpOptions(ctx context.Context) ([]*ec2.DhcpOptions, error)
 }
 
 type ec2Repository struct {
@@ -39,7 +47,7 @@ func NewEC2Repository(session *session.Session, c cache.Cache) *ec2Repository {
 	}
 }
 
-func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
+func (r *ec2Repository) ListAllImages(ctx context.Context) ([]*ec2.Image, error) {
 	if v := r.cache.Get("ec2ListAllImages"); v != nil {
 		return v.([]*ec2.Image), nil
 	}
@@ -49,7 +57,7 @@ func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
 			aws.String("self"),
 		},
 	}
-	images, err := r.client.DescribeImages(input)
+	images, err := r.client.DescribeImagesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +65,7 @@ func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
 	return images.Images, err
 }
 
-func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
+func (r *ec2Repository) ListAllSnapshots(ctx context.Context) ([]*ec2.Snapshot, error) {
 	if v := r.cache.Get("ec2ListAllSnapshots"); v != nil {
 		return v.([]*ec2.Snapshot), nil
 	}
@@ -68,7 +76,7 @@ func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 			aws.String("self"),
 		},
 	}
-	err := r.client.DescribeSnapshotsPages(input, func(res *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+	err := r.client.DescribeSnapshotsPagesWithContext(ctx, input, func(res *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
 		snapshots = append(snapshots, res.Snapshots...)
 		return !lastPage
 	})
@@ -79,14 +87,14 @@ func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	return snapshots, err
 }
 
-func (r *ec2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
+func (r *ec2Repository) ListAllVolumes(ctx context.Context) ([]*ec2.Volume, error) {
 	if v := r.cache.Get("ec2ListAllVolumes"); v != nil {
 		return v.([]*ec2.Volume), nil
 	}
 
 	var volumes []*ec2.Volume
 	input := &ec2.DescribeVolumesInput{}
-	err := r.client.DescribeVolumesPages(input, func(res *ec2.DescribeVolumesOutput, lastPage bool) bool {
+	err := r.client.DescribeVolumesPagesWithContext(ctx, input, func(res *ec2.DescribeVolumesOutput, lastPage bool) bool {
 		volumes = append(volumes, res.Volumes...)
 		return !lastPage
 	})
@@ -97,7 +105,7 @@ func (r *ec2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
 	return volumes, nil
 }
 
-func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
+func (r *ec2Repository) ListAllAddresses(ctx context.Context) ([]*ec2.Address, error) {
 	cacheKey := "ec2ListAllAddresses"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -106,7 +114,7 @@ func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	}
 
 	input := &ec2.DescribeAddressesInput{}
-	response, err := r.client.DescribeAddresses(input)
+	response, err := r.client.DescribeAddressesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -114,12 +122,12 @@ func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	return response.Addresses, nil
 }
 
-func (r *ec2Repository) ListAllAddressesAssociation() ([]*ec2.Address, error) {
+func (r *ec2Repository) ListAllAddressesAssociation(ctx context.Context) ([]*ec2.Address, error) {
 	if v := r.cache.Get("ec2ListAllAddressesAssociation"); v != nil {
 		return v.([]*ec2.Address), nil
 	}
 
-	addresses, err := r.ListAllAddresses()
+	addresses, err := r.ListAllAddresses(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +142,7 @@ func (r *ec2Repository) ListAllAddressesAssociation() ([]*ec2.Address, error) {
 	return results, nil
 }
 
-func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
+func (r *ec2Repository) ListAllInstances(ctx context.Context) ([]*ec2.Instance, error) {
 	if v := r.cache.Get("ec2ListAllInstances"); v != nil {
 		return v.([]*ec2.Instance), nil
 	}
@@ -155,7 +163,7 @@ func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 			},
 		},
 	}
-	err := r.client.DescribeInstancesPages(input, func(res *ec2.DescribeInstancesOutput, lastPage bool) bool {
+	err := r.client.DescribeInstancesPagesWithContext(ctx, input, func(res *ec2.DescribeInstancesOutput, lastPage bool) bool {
 		for _, reservation := range res.Reservations {
 			instances = append(instances, reservation.Instances...)
 		}
@@ -168,13 +176,13 @@ func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 	return instances, nil
 }
 
-func (r *ec2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
+func (r *ec2Repository) ListAllKeyPairs(ctx context.Context) ([]*ec2.KeyPairInfo, error) {
 	if v := r.cache.Get("ec2ListAllKeyPairs"); v != nil {
 		return v.([]*ec2.KeyPairInfo), nil
 	}
 
 	input := &ec2.DescribeKeyPairsInput{}
-	pairs, err := r.client.DescribeKeyPairs(input)
+	pairs, err := r.client.DescribeKeyPairsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -182,14 +190,14 @@ func (r *ec2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	return pairs.KeyPairs, err
 }
 
-func (r *ec2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error) {
+func (r *ec2Repository) ListAllInternetGateways(ctx context.Context) ([]*ec2.InternetGateway, error) {
 	if v := r.cache.Get("ec2ListAllInternetGateways"); v != nil {
 		return v.([]*ec2.InternetGateway), nil
 	}
 
 	var internetGateways []*ec2.InternetGateway
 	input := ec2.DescribeInternetGatewaysInput{}
-	err := r.client.DescribeInternetGatewaysPages(&input,
+	err := r.client.DescribeInternetGatewaysPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool {
 			internetGateways = append(internetGateways, resp.InternetGateways...)
 			return !lastPage
@@ -202,7 +210,7 @@ func (r *ec2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error
 	return internetGateways, nil
 }
 
-func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
+func (r *ec2Repository) ListAllSubnets(ctx context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	cacheKey := "ec2ListAllSubnets"
 	cacheSubnets := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -217,7 +225,7 @@ func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	input := ec2.DescribeSubnetsInput{}
 	var subnets []*ec2.Subnet
 	var defaultSubnets []*ec2.Subnet
-	err := r.client.DescribeSubnetsPages(&input,
+	err := r.client.DescribeSubnetsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeSubnetsOutput, lastPage bool) bool {
 			for _, subnet := range resp.Subnets {
 				if subnet.DefaultForAz != nil && *subnet.DefaultForAz {
@@ -236,14 +244,14 @@ func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	return subnets, defaultSubnets, nil
 }
 
-func (r *ec2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
+func (r *ec2Repository) ListAllNatGateways(ctx context.Context) ([]*ec2.NatGateway, error) {
 	if v := r.cache.Get("ec2ListAllNatGateways"); v != nil {
 		return v.([]*ec2.NatGateway), nil
 	}
 
 	var result []*ec2.NatGateway
 	input := ec2.DescribeNatGatewaysInput{}
-	err := r.client.DescribeNatGatewaysPages(&input,
+	err := r.client.DescribeNatGatewaysPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
 			result = append(result, resp.NatGateways...)
 			return !lastPage
@@ -258,7 +266,7 @@ func (r *ec2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
 	return result, nil
 }
 
-func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
+func (r *ec2Repository) ListAllRouteTables(ctx context.Context) ([]*ec2.RouteTable, error) {
 	cacheKey := "ec2ListAllRouteTables"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -268,7 +276,7 @@ func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 
 	var routeTables []*ec2.RouteTable
 	input := ec2.DescribeRouteTablesInput{}
-	err := r.client.DescribeRouteTablesPages(&input,
+	err := r.client.DescribeRouteTablesPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
 			routeTables = append(routeTables, resp.RouteTables...)
 			return !lastPage
@@ -283,7 +291,7 @@ func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 	return routeTables, nil
 }
 
-func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
+func (r *ec2Repository) ListAllVPCs(ctx context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	cacheKey := "ec2ListAllVPCs"
 	cacheVPCs := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -297,7 +305,7 @@ func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	input := ec2.DescribeVpcsInput{}
 	var VPCs []*ec2.Vpc
 	var defaultVPCs []*ec2.Vpc
-	err := r.client.DescribeVpcsPages(&input,
+	err := r.client.DescribeVpcsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeVpcsOutput, lastPage bool) bool {
 			for _, vpc := range resp.Vpcs {
 				if vpc.IsDefault != nil && *vpc.IsDefault {
@@ -318,7 +326,7 @@ func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	return VPCs, defaultVPCs, nil
 }
 
-func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
+func (r *ec2Repository) ListAllSecurityGroups(ctx context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
 	cacheKey := "ec2ListAllSecurityGroups"
 	cacheSecurityGroups := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -332,7 +340,7 @@ func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.Se
 	var securityGroups []*ec2.SecurityGroup
 	var defaultSecurityGroups []*ec2.SecurityGroup
 	input := &ec2.DescribeSecurityGroupsInput{}
-	err := r.client.DescribeSecurityGroupsPages(input, func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+	err := r.client.DescribeSecurityGroupsPagesWithContext(ctx, input, func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
 		for _, securityGroup := range res.SecurityGroups {
 			if securityGroup.GroupName != nil && *securityGroup.GroupName == "default" {
 				defaultSecurityGroups = append(defaultSecurityGroups, securityGroup)
@@ -351,7 +359,7 @@ func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.Se
 	return securityGroups, defaultSecurityGroups, nil
 }
 
-func (r *ec2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
+func (r *ec2Repository) ListAllNetworkACLs(ctx context.Context) ([]*ec2.NetworkAcl, error) {
 
 	cacheKey := "ec2ListAllNetworkACLs"
 	v := r.cache.GetAndLock(cacheKey)
@@ -362,7 +370,7 @@ func (r *ec2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
 
 	var ACLs []*ec2.NetworkAcl
 	input := ec2.DescribeNetworkAclsInput{}
-	err := r.client.DescribeNetworkAclsPages(&input,
+	err := r.client.DescribeNetworkAclsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
 			ACLs = append(ACLs, resp.NetworkAcls...)
 			return !lastPage
@@ -377,14 +385,14 @@ func (r *ec2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
 	return ACLs, nil
 }
 
-func (r *ec2Repository) DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error) {
+func (r *ec2Repository) DescribeLaunchTemplates(ctx context.Context) ([]*ec2.LaunchTemplate, error) {
 	cacheKey := "DescribeLaunchTemplates"
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*ec2.LaunchTemplate), nil
 	}
 
 	input := ec2.DescribeLaunchTemplatesInput{}
-	resp, err := r.client.DescribeLaunchTemplates(&input)
+	resp, err := r.client.DescribeLaunchTemplatesWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
@@ -393,16 +401,163 @@ func (r *ec2Repository) DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error)
 	return resp.LaunchTemplates, nil
 }
 
-func (r *ec2Repository) IsEbsEncryptionEnabledByDefault() (bool, error) {
+func (r *ec2Repository) IsEbsEncryptionEnabledByDefault(ctx context.Context) (bool, error) {
 	if v := r.cache.Get("ec2IsEbsEncryptionEnabledByDefault"); v != nil {
 		return v.(bool), nil
 	}
 
 	input := &ec2.GetEbsEncryptionByDefaultInput{}
-	resp, err := r.client.GetEbsEncryptionByDefault(input)
+	resp, err := r.client.GetEbsEncryptionByDefaultWithContext(ctx, input)
 	if err != nil {
 		return false, err
 	}
 	r.cache.Put("ec2IsEbsEncryptionEnabledByDefault", *resp.EbsEncryptionByDefault)
 	return *resp.EbsEncryptionByDefault, err
 }
+
+func (r *ec2Repository) ListAllVpcEndpoints(ctx context.Context) ([]*ec2.VpcEndpoint, error) {
+	if v := r.cache.Get("ec2ListAllVpcEndpoints"); v != nil {
+		return v.([]*ec2.VpcEndpoint), nil
+	}
+
+	var vpcEndpoints []*ec2.VpcEndpoint
+	input := ec2.DescribeVpcEndpointsInput{}
+	err := r.client.DescribeVpcEndpointsPagesWithContext(ctx, &input,
+		func(resp *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+			vpcEndpoints = append(vpcEndpoints, resp.VpcEndpoints...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllVpcEndpoints", vpcEndpoints)
+	return vpcEndpoints, nil
+}
+
+func (r *ec2Repository) ListAllVpcPeeringConnections(ctx context.Context) ([]*ec2.VpcPeeringConnection, error) {
+	if v := r.cache.Get("ec2ListAllVpcPeeringConnections"); v != nil {
+		return v.([]*ec2.VpcPeeringConnection), nil
+	}
+
+	var vpcPeeringConnections []*ec2.VpcPeeringConnection
+	input := ec2.DescribeVpcPeeringConnectionsInput{}
+	err := r.client.DescribeVpcPeeringConnectionsPagesWithContext(ctx, &input,
+		func(resp *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+			vpcPeeringConnections = append(vpcPeeringConnections, resp.VpcPeeringConnections...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllVpcPeeringConnections", vpcPeeringConnections)
+	return vpcPeeringConnections, nil
+}
+
+func (r *ec2Repository) ListAllTransitGateways(ctx context.Context) ([]*ec2.TransitGateway, error) {
+	if v := r.cache.Get("ec2ListAllTransitGateways"); v != nil {
+		return v.([]*ec2.TransitGateway), nil
+	}
+
+	var transitGateways []*ec2.TransitGateway
+	input := ec2.DescribeTransitGatewaysInput{}
+	err := r.client.DescribeTransitGatewaysPagesWithContext(ctx, &input,
+		func(resp *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool {
+			transitGateways = append(transitGateways, resp.TransitGateways...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllTransitGateways", transitGateways)
+	return transitGateways, nil
+}
+
+func (r *ec2Repository) ListAllTransitGatewayVpcAttachments(ctx context.Context) ([]*ec2.TransitGatewayVpcAttachment, error) {
+	if v := r.cache.Get("ec2ListAllTransitGatewayVpcAttachments"); v != nil {
+		return v.([]*ec2.TransitGatewayVpcAttachment), nil
+	}
+
+	var transitGatewayVpcAttachments []*ec2.TransitGatewayVpcAttachment
+	input := ec2.DescribeTransitGatewayVpcAttachmentsInput{}
+	err := r.client.DescribeTransitGatewayVpcAttachmentsPagesWithContext(ctx, &input,
+		func(resp *ec2.DescribeTransitGatewayVpcAttachmentsOutput, lastPage bool) bool {
+			transitGatewayVpcAttachments = append(transitGatewayVpcAttachments, resp.TransitGatewayVpcAttachments...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllTransitGatewayVpcAttachments", transitGatewayVpcAttachments)
+	return transitGatewayVpcAttachments, nil
+}
+
+func (r *ec2Repository) ListAllFlowLogs(ctx context.Context) ([]*ec2.FlowLog, error) {
+	if v := r.cache.Get("ec2ListAllFlowLogs"); v != nil {
+		return v.([]*ec2.FlowLog), nil
+	}
+
+	var flowLogs []*ec2.FlowLog
+	input := ec2.DescribeFlowLogsInput{}
+	err := r.client.DescribeFlowLogsPagesWithContext(ctx, &input,
+		func(resp *ec2.DescribeFlowLogsOutput, lastPage bool) bool {
+			flowLogs = append(flowLogs, resp.FlowLogs...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllFlowLogs", flowLogs)
+	return flowLogs, nil
+}
+
+func (r *ec2Repository) ListAllNetworkInterfaces(ctx context.Context) ([]*ec2.NetworkInterface, error) {
+	if v := r.cache.Get("ec2ListAllNetworkInterfaces"); v != nil {
+		return v.([]*ec2.NetworkInterface), nil
+	}
+
+	var networkInterfaces []*ec2.NetworkInterface
+	input := ec2.DescribeNetworkInterfacesInput{}
+	err := r.client.DescribeNetworkInterfacesPagesWithContext(ctx, &input,
+		func(resp *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			networkInterfaces = append(networkInterfaces, resp.NetworkInterfaces...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllNetworkInterfaces", networkInterfaces)
+	return networkInterfaces, nil
+}
+
+func (r *ec2Repository) ListAllDhcpOptions(ctx context.Context) ([]*ec2.DhcpOptions, error) {
+	if v := r.cache.Get("ec2ListAllDhcpOptions"); v != nil {
+		return v.([]*ec2.DhcpOptions), nil
+	}
+
+	var dhcpOptions []*ec2.DhcpOptions
+	input := ec2.DescribeDhcpOptionsInput{}
+	err := r.client.DescribeDhcpOptionsPagesWithContext(ctx, &input,
+		func(resp *ec2.DescribeDhcpOptionsOutput, lastPage bool) bool {
+			dhcpOptions = append(dhcpOptions, resp.DhcpOptions...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ec2ListAllDhcpOptions", dhcpOptions)
+	return dhcpOptions, nil
+}
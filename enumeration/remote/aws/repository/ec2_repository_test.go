@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -28,7 +29,7 @@ func Test_ec2Repository_ListAllImages(t *testing.T) {
 		{
 			name: "List all images",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeImages",
+				client.On("DescribeImagesWithContext", mock.Anything,
 					&ec2.DescribeImagesInput{
 						Owners: []*string{
 							aws.String("self"),
@@ -59,12 +60,12 @@ func Test_ec2Repository_ListAllImages(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllImages()
+			got, err := r.ListAllImages(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllImages()
+				cachedData, err := r.ListAllImages(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Image{}, store.Get("ec2ListAllImages"))
@@ -91,7 +92,7 @@ func Test_ec2Repository_ListAllSnapshots(t *testing.T) {
 	}{
 		{name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeSnapshotsPages",
+				client.On("DescribeSnapshotsPagesWithContext", mock.Anything,
 					&ec2.DescribeSnapshotsInput{
 						OwnerIds: []*string{
 							aws.String("self"),
@@ -138,12 +139,12 @@ func Test_ec2Repository_ListAllSnapshots(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllSnapshots()
+			got, err := r.ListAllSnapshots(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllSnapshots()
+				cachedData, err := r.ListAllSnapshots(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Snapshot{}, store.Get("ec2ListAllSnapshots"))
@@ -170,7 +171,7 @@ func Test_ec2Repository_ListAllVolumes(t *testing.T) {
 	}{
 		{name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeVolumesPages",
+				client.On("DescribeVolumesPagesWithContext", mock.Anything,
 					&ec2.DescribeVolumesInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeVolumesOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeVolumesOutput{
@@ -213,12 +214,12 @@ func Test_ec2Repository_ListAllVolumes(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllVolumes()
+			got, err := r.ListAllVolumes(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllVolumes()
+				cachedData, err := r.ListAllVolumes(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Volume{}, store.Get("ec2ListAllVolumes"))
@@ -246,7 +247,7 @@ func Test_ec2Repository_ListAllAddresses(t *testing.T) {
 		{
 			name: "List address",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeAddresses", &ec2.DescribeAddressesInput{}).
+				client.On("DescribeAddressesWithContext", mock.Anything, &ec2.DescribeAddressesInput{}).
 					Return(&ec2.DescribeAddressesOutput{
 						Addresses: []*ec2.Address{
 							{AssociationId: aws.String("1")},
@@ -273,12 +274,12 @@ func Test_ec2Repository_ListAllAddresses(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllAddresses()
+			got, err := r.ListAllAddresses(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAddresses()
+				cachedData, err := r.ListAllAddresses(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Address{}, store.Get("ec2ListAllAddresses"))
@@ -306,7 +307,7 @@ func Test_ec2Repository_ListAllAddressesAssociation(t *testing.T) {
 		{
 			name: "List address",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeAddresses", &ec2.DescribeAddressesInput{}).
+				client.On("DescribeAddressesWithContext", mock.Anything, &ec2.DescribeAddressesInput{}).
 					Return(&ec2.DescribeAddressesOutput{
 						Addresses: []*ec2.Address{
 							{AssociationId: aws.String("1")},
@@ -333,12 +334,12 @@ func Test_ec2Repository_ListAllAddressesAssociation(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllAddressesAssociation()
+			got, err := r.ListAllAddressesAssociation(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAddressesAssociation()
+				cachedData, err := r.ListAllAddressesAssociation(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Address{}, store.Get("ec2ListAllAddressesAssociation"))
@@ -365,7 +366,7 @@ func Test_ec2Repository_ListAllInstances(t *testing.T) {
 	}{
 		{name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeInstancesPages",
+				client.On("DescribeInstancesPagesWithContext", mock.Anything,
 					&ec2.DescribeInstancesInput{
 						Filters: []*ec2.Filter{
 							{
@@ -445,12 +446,12 @@ func Test_ec2Repository_ListAllInstances(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllInstances()
+			got, err := r.ListAllInstances(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllInstances()
+				cachedData, err := r.ListAllInstances(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Instance{}, store.Get("ec2ListAllInstances"))
@@ -477,7 +478,7 @@ func Test_ec2Repository_ListAllKeyPairs(t *testing.T) {
 		{
 			name: "List address",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeKeyPairs", &ec2.DescribeKeyPairsInput{}).
+				client.On("DescribeKeyPairsWithContext", mock.Anything, &ec2.DescribeKeyPairsInput{}).
 					Return(&ec2.DescribeKeyPairsOutput{
 						KeyPairs: []*ec2.KeyPairInfo{
 							{KeyPairId: aws.String("1")},
@@ -504,12 +505,12 @@ func Test_ec2Repository_ListAllKeyPairs(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllKeyPairs()
+			got, err := r.ListAllKeyPairs(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllKeyPairs()
+				cachedData, err := r.ListAllKeyPairs(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.KeyPairInfo{}, store.Get("ec2ListAllKeyPairs"))
@@ -537,7 +538,7 @@ func Test_ec2Repository_ListAllInternetGateways(t *testing.T) {
 		{
 			name: "List only gateways with multiple pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeInternetGatewaysPages",
+				client.On("DescribeInternetGatewaysPagesWithContext", mock.Anything,
 					&ec2.DescribeInternetGatewaysInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeInternetGatewaysOutput{
@@ -588,12 +589,12 @@ func Test_ec2Repository_ListAllInternetGateways(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllInternetGateways()
+			got, err := r.ListAllInternetGateways(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllInternetGateways()
+				cachedData, err := r.ListAllInternetGateways(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.InternetGateway{}, store.Get("ec2ListAllInternetGateways"))
@@ -622,7 +623,7 @@ func Test_ec2Repository_ListAllSubnets(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeSubnetsPages",
+				client.On("DescribeSubnetsPagesWithContext", mock.Anything,
 					&ec2.DescribeSubnetsInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeSubnetsOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeSubnetsOutput{
@@ -699,12 +700,12 @@ func Test_ec2Repository_ListAllSubnets(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			gotSubnet, gotDefaultSubnet, err := r.ListAllSubnets()
+			gotSubnet, gotDefaultSubnet, err := r.ListAllSubnets(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, cachedDefaultData, err := r.ListAllSubnets()
+				cachedData, cachedDefaultData, err := r.ListAllSubnets(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, gotSubnet, cachedData)
 				assert.Equal(t, gotDefaultSubnet, cachedDefaultData)
@@ -742,7 +743,7 @@ func Test_ec2Repository_ListAllNatGateways(t *testing.T) {
 		{
 			name: "List only gateways with multiple pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeNatGatewaysPages",
+				client.On("DescribeNatGatewaysPagesWithContext", mock.Anything,
 					&ec2.DescribeNatGatewaysInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeNatGatewaysOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeNatGatewaysOutput{
@@ -793,12 +794,12 @@ func Test_ec2Repository_ListAllNatGateways(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllNatGateways()
+			got, err := r.ListAllNatGateways(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllNatGateways()
+				cachedData, err := r.ListAllNatGateways(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.NatGateway{}, store.Get("ec2ListAllNatGateways"))
@@ -826,7 +827,7 @@ func Test_ec2Repository_ListAllRouteTables(t *testing.T) {
 		{
 			name: "List only route with multiple pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeRouteTablesPages",
+				client.On("DescribeRouteTablesPagesWithContext", mock.Anything,
 					&ec2.DescribeRouteTablesInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeRouteTablesOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeRouteTablesOutput{
@@ -985,12 +986,12 @@ func Test_ec2Repository_ListAllRouteTables(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRouteTables()
+			got, err := r.ListAllRouteTables(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllRouteTables()
+				cachedData, err := r.ListAllRouteTables(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.RouteTable{}, store.Get("ec2ListAllRouteTables"))
@@ -1019,7 +1020,7 @@ func Test_ec2Repository_ListAllVPCs(t *testing.T) {
 		{
 			name: "mixed default VPC and VPC",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeVpcsPages",
+				client.On("DescribeVpcsPagesWithContext", mock.Anything,
 					&ec2.DescribeVpcsInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcsOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeVpcsOutput{
@@ -1078,12 +1079,12 @@ func Test_ec2Repository_ListAllVPCs(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			gotVPCs, gotDefaultVPCs, err := r.ListAllVPCs()
+			gotVPCs, gotDefaultVPCs, err := r.ListAllVPCs(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, cachedDefaultData, err := r.ListAllVPCs()
+				cachedData, cachedDefaultData, err := r.ListAllVPCs(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, gotVPCs, cachedData)
 				assert.Equal(t, gotDefaultVPCs, cachedDefaultData)
@@ -1122,7 +1123,7 @@ func Test_ec2Repository_ListAllSecurityGroups(t *testing.T) {
 		{
 			name: "List with 1 pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeSecurityGroupsPages",
+				client.On("DescribeSecurityGroupsPagesWithContext", mock.Anything,
 					&ec2.DescribeSecurityGroupsInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeSecurityGroupsOutput{
@@ -1163,12 +1164,12 @@ func Test_ec2Repository_ListAllSecurityGroups(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			gotSecurityGroups, gotDefaultSecurityGroups, err := r.ListAllSecurityGroups()
+			gotSecurityGroups, gotDefaultSecurityGroups, err := r.ListAllSecurityGroups(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, cachedDefaultData, err := r.ListAllSecurityGroups()
+				cachedData, cachedDefaultData, err := r.ListAllSecurityGroups(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, gotSecurityGroups, cachedData)
 				assert.Equal(t, gotDefaultSecurityGroups, cachedDefaultData)
@@ -1209,7 +1210,7 @@ func Test_ec2Repository_ListAllNetworkACLs(t *testing.T) {
 		{
 			name: "List with 1 pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeNetworkAclsPages",
+				client.On("DescribeNetworkAclsPagesWithContext", mock.Anything,
 					&ec2.DescribeNetworkAclsInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeNetworkAclsOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeNetworkAclsOutput{
@@ -1237,7 +1238,7 @@ func Test_ec2Repository_ListAllNetworkACLs(t *testing.T) {
 		{
 			name: "List return error",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeNetworkAclsPages",
+				client.On("DescribeNetworkAclsPagesWithContext", mock.Anything,
 					&ec2.DescribeNetworkAclsInput{},
 					mock.Anything,
 				).Return(testErr)
@@ -1254,12 +1255,12 @@ func Test_ec2Repository_ListAllNetworkACLs(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllNetworkACLs()
+			got, err := r.ListAllNetworkACLs(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllNetworkACLs()
+				cachedData, err := r.ListAllNetworkACLs(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.NetworkAcl{}, store.Get("ec2ListAllNetworkACLs"))
@@ -1278,6 +1279,594 @@ func Test_ec2Repository_ListAllNetworkACLs(t *testing.T) {
 	}
 }
 
+func Test_ec2Repository_ListAllVpcEndpoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.VpcEndpoint
+		wantErr error
+	}{
+		{
+			name: "List only vpc endpoints with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeVpcEndpointsPagesWithContext", mock.Anything,
+					&ec2.DescribeVpcEndpointsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{
+									VpcEndpointId: aws.String("vpce-0"),
+								},
+								{
+									VpcEndpointId: aws.String("vpce-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{
+									VpcEndpointId: aws.String("vpce-2"),
+								},
+								{
+									VpcEndpointId: aws.String("vpce-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.VpcEndpoint{
+				{
+					VpcEndpointId: aws.String("vpce-0"),
+				},
+				{
+					VpcEndpointId: aws.String("vpce-1"),
+				},
+				{
+					VpcEndpointId: aws.String("vpce-2"),
+				},
+				{
+					VpcEndpointId: aws.String("vpce-3"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllVpcEndpoints(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllVpcEndpoints(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.VpcEndpoint{}, store.Get("ec2ListAllVpcEndpoints"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllVpcPeeringConnections(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.VpcPeeringConnection
+		wantErr error
+	}{
+		{
+			name: "List only vpc peering connections with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeVpcPeeringConnectionsPagesWithContext", mock.Anything,
+					&ec2.DescribeVpcPeeringConnectionsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								{
+									VpcPeeringConnectionId: aws.String("pcx-0"),
+								},
+								{
+									VpcPeeringConnectionId: aws.String("pcx-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								{
+									VpcPeeringConnectionId: aws.String("pcx-2"),
+								},
+								{
+									VpcPeeringConnectionId: aws.String("pcx-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.VpcPeeringConnection{
+				{
+					VpcPeeringConnectionId: aws.String("pcx-0"),
+				},
+				{
+					VpcPeeringConnectionId: aws.String("pcx-1"),
+				},
+				{
+					VpcPeeringConnectionId: aws.String("pcx-2"),
+				},
+				{
+					VpcPeeringConnectionId: aws.String("pcx-3"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllVpcPeeringConnections(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllVpcPeeringConnections(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.VpcPeeringConnection{}, store.Get("ec2ListAllVpcPeeringConnections"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllTransitGateways(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.TransitGateway
+		wantErr error
+	}{
+		{
+			name: "List only transit gateways with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeTransitGatewaysPagesWithContext", mock.Anything,
+					&ec2.DescribeTransitGatewaysInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeTransitGatewaysOutput{
+							TransitGateways: []*ec2.TransitGateway{
+								{
+									TransitGatewayId: aws.String("tgw-0"),
+								},
+								{
+									TransitGatewayId: aws.String("tgw-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeTransitGatewaysOutput{
+							TransitGateways: []*ec2.TransitGateway{
+								{
+									TransitGatewayId: aws.String("tgw-2"),
+								},
+								{
+									TransitGatewayId: aws.String("tgw-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.TransitGateway{
+				{
+					TransitGatewayId: aws.String("tgw-0"),
+				},
+				{
+					TransitGatewayId: aws.String("tgw-1"),
+				},
+				{
+					TransitGatewayId: aws.String("tgw-2"),
+				},
+				{
+					TransitGatewayId: aws.String("tgw-3"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllTransitGateways(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTransitGateways(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.TransitGateway{}, store.Get("ec2ListAllTransitGateways"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllTransitGatewayVpcAttachments(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.TransitGatewayVpcAttachment
+		wantErr error
+	}{
+		{
+			name: "List only transit gateway vpc attachments with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeTransitGatewayVpcAttachmentsPagesWithContext", mock.Anything,
+					&ec2.DescribeTransitGatewayVpcAttachmentsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeTransitGatewayVpcAttachmentsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+							TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-0"),
+								},
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+							TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+								},
+								{
+									TransitGatewayAttachmentId: aws.String("tgw-attach-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.TransitGatewayVpcAttachment{
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-0"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-3"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllTransitGatewayVpcAttachments(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTransitGatewayVpcAttachments(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.TransitGatewayVpcAttachment{}, store.Get("ec2ListAllTransitGatewayVpcAttachments"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllFlowLogs(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.FlowLog
+		wantErr error
+	}{
+		{
+			name: "List only flow logs with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeFlowLogsPagesWithContext", mock.Anything,
+					&ec2.DescribeFlowLogsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeFlowLogsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeFlowLogsOutput{
+							FlowLogs: []*ec2.FlowLog{
+								{
+									FlowLogId: aws.String("fl-0"),
+								},
+								{
+									FlowLogId: aws.String("fl-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeFlowLogsOutput{
+							FlowLogs: []*ec2.FlowLog{
+								{
+									FlowLogId: aws.String("fl-2"),
+								},
+								{
+									FlowLogId: aws.String("fl-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.FlowLog{
+				{
+					FlowLogId: aws.String("fl-0"),
+				},
+				{
+					FlowLogId: aws.String("fl-1"),
+				},
+				{
+					FlowLogId: aws.String("fl-2"),
+				},
+				{
+					FlowLogId: aws.String("fl-3"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllFlowLogs(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllFlowLogs(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.FlowLog{}, store.Get("ec2ListAllFlowLogs"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllNetworkInterfaces(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.NetworkInterface
+		wantErr error
+	}{
+		{
+			name: "List only network interfaces with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeNetworkInterfacesPagesWithContext", mock.Anything,
+					&ec2.DescribeNetworkInterfacesInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeNetworkInterfacesOutput{
+							NetworkInterfaces: []*ec2.NetworkInterface{
+								{
+									NetworkInterfaceId: aws.String("eni-0"),
+								},
+								{
+									NetworkInterfaceId: aws.String("eni-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeNetworkInterfacesOutput{
+							NetworkInterfaces: []*ec2.NetworkInterface{
+								{
+									NetworkInterfaceId: aws.String("eni-2"),
+								},
+								{
+									NetworkInterfaceId: aws.String("eni-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.NetworkInterface{
+				{
+					NetworkInterfaceId: aws.String("eni-0"),
+				},
+				{
+					NetworkInterfaceId: aws.String("eni-1"),
+				},
+				{
+					NetworkInterfaceId: aws.String("eni-2"),
+				},
+				{
+					NetworkInterfaceId: aws.String("eni-3"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllNetworkInterfaces(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllNetworkInterfaces(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.NetworkInterface{}, store.Get("ec2ListAllNetworkInterfaces"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
+func Test_ec2Repository_ListAllDhcpOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEC2)
+		want    []*ec2.DhcpOptions
+		wantErr error
+	}{
+		{
+			name: "List only dhcp options with multiple pages",
+			mocks: func(client *awstest.MockFakeEC2) {
+				client.On("DescribeDhcpOptionsPagesWithContext", mock.Anything,
+					&ec2.DescribeDhcpOptionsInput{},
+					mock.MatchedBy(func(callback func(res *ec2.DescribeDhcpOptionsOutput, lastPage bool) bool) bool {
+						callback(&ec2.DescribeDhcpOptionsOutput{
+							DhcpOptions: []*ec2.DhcpOptions{
+								{
+									DhcpOptionsId: aws.String("dopt-0"),
+								},
+								{
+									DhcpOptionsId: aws.String("dopt-1"),
+								},
+							},
+						}, false)
+						callback(&ec2.DescribeDhcpOptionsOutput{
+							DhcpOptions: []*ec2.DhcpOptions{
+								{
+									DhcpOptionsId: aws.String("dopt-2"),
+								},
+								{
+									DhcpOptionsId: aws.String("dopt-3"),
+								},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ec2.DhcpOptions{
+				{
+					DhcpOptionsId: aws.String("dopt-0"),
+				},
+				{
+					DhcpOptionsId: aws.String("dopt-1"),
+				},
+				{
+					DhcpOptionsId: aws.String("dopt-2"),
+				},
+				{
+					DhcpOptionsId: aws.String("dopt-3"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeEC2{}
+			tt.mocks(client)
+			r := &ec2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDhcpOptions(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllDhcpOptions(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ec2.DhcpOptions{}, store.Get("ec2ListAllDhcpOptions"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+		})
+	}
+}
+
 func Test_ec2Repository_DescribeLaunchTemplates(t *testing.T) {
 
 	testErr := errors.New("test")
@@ -1291,7 +1880,7 @@ func Test_ec2Repository_DescribeLaunchTemplates(t *testing.T) {
 		{
 			name: "List with 1 pages",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeLaunchTemplates",
+				client.On("DescribeLaunchTemplatesWithContext", mock.Anything,
 					&ec2.DescribeLaunchTemplatesInput{},
 				).Return(&ec2.DescribeLaunchTemplatesOutput{
 					LaunchTemplates: []*ec2.LaunchTemplate{
@@ -1316,7 +1905,7 @@ func Test_ec2Repository_DescribeLaunchTemplates(t *testing.T) {
 		{
 			name: "List return error",
 			mocks: func(client *awstest.MockFakeEC2) {
-				client.On("DescribeLaunchTemplates",
+				client.On("DescribeLaunchTemplatesWithContext", mock.Anything,
 					&ec2.DescribeLaunchTemplatesInput{},
 				).Return(nil, testErr)
 			},
@@ -1332,12 +1921,12 @@ func Test_ec2Repository_DescribeLaunchTemplates(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeLaunchTemplates()
+			got, err := r.DescribeLaunchTemplates(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.DescribeLaunchTemplates()
+				cachedData, err := r.DescribeLaunchTemplates(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.LaunchTemplate{}, store.Get("DescribeLaunchTemplates"))
@@ -1373,7 +1962,7 @@ func Test_ec2Repository_IsEbsEncryptionEnabledByDefault(t *testing.T) {
 					Return(nil).
 					Once()
 
-				client.On("GetEbsEncryptionByDefault",
+				client.On("GetEbsEncryptionByDefaultWithContext", mock.Anything,
 					&ec2.GetEbsEncryptionByDefaultInput{},
 				).Return(&ec2.GetEbsEncryptionByDefaultOutput{
 					EbsEncryptionByDefault: aws.Bool(true),
@@ -1401,7 +1990,7 @@ func Test_ec2Repository_IsEbsEncryptionEnabledByDefault(t *testing.T) {
 					Return(nil).
 					Once()
 
-				client.On("GetEbsEncryptionByDefault",
+				client.On("GetEbsEncryptionByDefaultWithContext", mock.Anything,
 					&ec2.GetEbsEncryptionByDefaultInput{},
 				).Return(nil, testErr).Once()
 			},
@@ -1417,7 +2006,7 @@ func Test_ec2Repository_IsEbsEncryptionEnabledByDefault(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.IsEbsEncryptionEnabledByDefault()
+			got, err := r.IsEbsEncryptionEnabledByDefault(context.Background())
 
 			assert.Equal(t, tt.wantErr, err)
 			assert.Equal(t, tt.want, got)
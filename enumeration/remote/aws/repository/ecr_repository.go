@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -10,8 +11,8 @@ import (
 )
 
 type ECRRepository interface {
-	ListAllRepositories() ([]*ecr.Repository, error)
-	GetRepositoryPolicy(*ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error)
+	ListAllRepositories(ctx context.Context) ([]*ecr.Repository, error)
+	GetRepositoryPolicy(context.Context, *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error)
 }
 
 type ecrRepository struct {
@@ -26,14 +27,14 @@ func NewECRRepository(session *session.Session, c cache.Cache) *ecrRepository {
 	}
 }
 
-func (r *ecrRepository) ListAllRepositories() ([]*ecr.Repository, error) {
+func (r *ecrRepository) ListAllRepositories(ctx context.Context) ([]*ecr.Repository, error) {
 	if v := r.cache.Get("ecrListAllRepositories"); v != nil {
 		return v.([]*ecr.Repository), nil
 	}
 
 	var repositories []*ecr.Repository
 	input := &ecr.DescribeRepositoriesInput{}
-	err := r.client.DescribeRepositoriesPages(input, func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+	err := r.client.DescribeRepositoriesPagesWithContext(ctx, input, func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
 		repositories = append(repositories, res.Repositories...)
 		return !lastPage
 	})
@@ -45,7 +46,7 @@ func (r *ecrRepository) ListAllRepositories() ([]*ecr.Repository, error) {
 	return repositories, nil
 }
 
-func (r *ecrRepository) GetRepositoryPolicy(repo *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error) {
+func (r *ecrRepository) GetRepositoryPolicy(ctx context.Context, repo *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error) {
 	cacheKey := fmt.Sprintf("ecrListAllRepositoriesGetRepositoryPolicy_%s_%s", *repo.RegistryId, *repo.RepositoryName)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.(*ecr.GetRepositoryPolicyOutput), nil
@@ -56,7 +57,7 @@ func (r *ecrRepository) GetRepositoryPolicy(repo *ecr.Repository) (*ecr.GetRepos
 		RepositoryName: repo.RepositoryName,
 	}
 
-	repoOutput, err := r.client.GetRepositoryPolicy(repositoryPolicyInput)
+	repoOutput, err := r.client.GetRepositoryPolicyWithContext(ctx, repositoryPolicyInput)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+const (
+	// DescribeClusters and DescribeServices accept a limited number of ARNs per call
+	ecsDescribeClustersMaxResults = 100
+	ecsDescribeServicesMaxResults = 10
+)
+
+type ECSRepository interface {
+	ListAllClusters(ctx context.Context) ([]*ecs.Cluster, error)
+	ListAllServices(ctx context.Context, clusterArn string) ([]*ecs.Service, error)
+	ListAllActiveTaskDefinitions(ctx context.Context) ([]string, error)
+}
+
+type ecsRepository struct {
+	client ecsiface.ECSAPI
+	cache  cache.Cache
+}
+
+func NewECSRepository(session *session.Session, c cache.Cache) *ecsRepository {
+	return &ecsRepository{
+		ecs.New(session),
+		c,
+	}
+}
+
+func (r *ecsRepository) ListAllClusters(ctx context.Context) ([]*ecs.Cluster, error) {
+	cacheKey := "ecsListAllClusters"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*ecs.Cluster), nil
+	}
+
+	var arns []*string
+	err := r.client.ListClustersPagesWithContext(ctx, &ecs.ListClustersInput{},
+		func(resp *ecs.ListClustersOutput, lastPage bool) bool {
+			arns = append(arns, resp.ClusterArns...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*ecs.Cluster, 0, len(arns))
+	for start := 0; start < len(arns); start += ecsDescribeClustersMaxResults {
+		end := start + ecsDescribeClustersMaxResults
+		if end > len(arns) {
+			end = len(arns)
+		}
+		resp, err := r.client.DescribeClustersWithContext(ctx, &ecs.DescribeClustersInput{
+			Clusters: arns[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, resp.Clusters...)
+	}
+
+	r.cache.Put(cacheKey, clusters)
+	return clusters, nil
+}
+
+func (r *ecsRepository) ListAllServices(ctx context.Context, clusterArn string) ([]*ecs.Service, error) {
+	cacheKey := fmt.Sprintf("ecsListAllServices_cluster_%s", clusterArn)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*ecs.Service), nil
+	}
+
+	var arns []*string
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(clusterArn),
+	}
+	err := r.client.ListServicesPagesWithContext(ctx, input,
+		func(resp *ecs.ListServicesOutput, lastPage bool) bool {
+			arns = append(arns, resp.ServiceArns...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]*ecs.Service, 0, len(arns))
+	for start := 0; start < len(arns); start += ecsDescribeServicesMaxResults {
+		end := start + ecsDescribeServicesMaxResults
+		if end > len(arns) {
+			end = len(arns)
+		}
+		resp, err := r.client.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(clusterArn),
+			Services: arns[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, resp.Services...)
+	}
+
+	r.cache.Put(cacheKey, services)
+	return services, nil
+}
+
+// ListAllActiveTaskDefinitions returns the ARN of every active task definition revision
+func (r *ecsRepository) ListAllActiveTaskDefinitions(ctx context.Context) ([]string, error) {
+	cacheKey := "ecsListAllActiveTaskDefinitions"
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]string), nil
+	}
+
+	var arns []string
+	input := &ecs.ListTaskDefinitionsInput{
+		Status: aws.String(ecs.TaskDefinitionStatusActive),
+	}
+	err := r.client.ListTaskDefinitionsPagesWithContext(ctx, input,
+		func(resp *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+			arns = append(arns, aws.StringValueSlice(resp.TaskDefinitionArns)...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, arns)
+	return arns, nil
+}
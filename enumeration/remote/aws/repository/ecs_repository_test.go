@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ecsRepository_ListAllClusters(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	arns := make([]*string, 0, 101)
+	for i := 0; i < 101; i++ {
+		arns = append(arns, aws.String(fmt.Sprintf("arn:aws:ecs:us-east-1:123456789012:cluster/cluster-%d", i)))
+	}
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS)
+		want    []*ecs.Cluster
+		wantErr error
+	}{
+		{
+			name: "List clusters with 2 pages and describe them by batches",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&ecs.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *ecs.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListClustersOutput{ClusterArns: arns[:50]}, false)
+						callback(&ecs.ListClustersOutput{ClusterArns: arns[50:]}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeClustersWithContext", mock.Anything, &ecs.DescribeClustersInput{Clusters: arns[:100]}).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{
+						{ClusterName: aws.String("cluster-0")},
+					},
+				}, nil).Once()
+				client.On("DescribeClustersWithContext", mock.Anything, &ecs.DescribeClustersInput{Clusters: arns[100:]}).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{
+						{ClusterName: aws.String("cluster-100")},
+					},
+				}, nil).Once()
+			},
+			want: []*ecs.Cluster{
+				{ClusterName: aws.String("cluster-0")},
+				{ClusterName: aws.String("cluster-100")},
+			},
+		},
+		{
+			name: "Error describing clusters",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&ecs.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *ecs.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListClustersOutput{ClusterArns: arns[:1]}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeClustersWithContext", mock.Anything, &ecs.DescribeClustersInput{Clusters: arns[:1]}).Return(nil, dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeECS{}
+			tt.mocks(&client)
+			r := &ecsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllClusters(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ecs.Cluster{}, store.Get("ecsListAllClusters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllServices(t *testing.T) {
+	clusterArn := "arn:aws:ecs:us-east-1:123456789012:cluster/foo"
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS)
+		want    []*ecs.Service
+		wantErr error
+	}{
+		{
+			name: "List services and describe them by batches",
+			mocks: func(client *awstest.MockFakeECS) {
+				arns := make([]*string, 0, 11)
+				for i := 0; i < 11; i++ {
+					arns = append(arns, aws.String(fmt.Sprintf("arn:aws:ecs:us-east-1:123456789012:service/foo/service-%d", i)))
+				}
+				client.On("ListServicesPagesWithContext", mock.Anything,
+					&ecs.ListServicesInput{Cluster: aws.String(clusterArn)},
+					mock.MatchedBy(func(callback func(res *ecs.ListServicesOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListServicesOutput{ServiceArns: arns}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeServicesWithContext", mock.Anything, &ecs.DescribeServicesInput{Cluster: aws.String(clusterArn), Services: arns[:10]}).Return(&ecs.DescribeServicesOutput{
+					Services: []*ecs.Service{
+						{ServiceName: aws.String("service-0")},
+					},
+				}, nil).Once()
+				client.On("DescribeServicesWithContext", mock.Anything, &ecs.DescribeServicesInput{Cluster: aws.String(clusterArn), Services: arns[10:]}).Return(&ecs.DescribeServicesOutput{
+					Services: []*ecs.Service{
+						{ServiceName: aws.String("service-10")},
+					},
+				}, nil).Once()
+			},
+			want: []*ecs.Service{
+				{ServiceName: aws.String("service-0")},
+				{ServiceName: aws.String("service-10")},
+			},
+		},
+		{
+			name: "List services without any service",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListServicesPagesWithContext", mock.Anything,
+					&ecs.ListServicesInput{Cluster: aws.String(clusterArn)},
+					mock.MatchedBy(func(callback func(res *ecs.ListServicesOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListServicesOutput{}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ecs.Service{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeECS{}
+			tt.mocks(&client)
+			r := &ecsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllServices(context.Background(), clusterArn)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllServices(context.Background(), clusterArn)
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ecs.Service{}, store.Get(fmt.Sprintf("ecsListAllServices_cluster_%s", clusterArn)))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllActiveTaskDefinitions(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS)
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "List active task definitions with 2 pages",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListTaskDefinitionsPagesWithContext", mock.Anything,
+					&ecs.ListTaskDefinitionsInput{Status: aws.String("ACTIVE")},
+					mock.MatchedBy(func(callback func(res *ecs.ListTaskDefinitionsOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListTaskDefinitionsOutput{
+							TaskDefinitionArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:1")},
+						}, false)
+						callback(&ecs.ListTaskDefinitionsOutput{
+							TaskDefinitionArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:2")},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []string{
+				"arn:aws:ecs:us-east-1:123456789012:task-definition/web:1",
+				"arn:aws:ecs:us-east-1:123456789012:task-definition/web:2",
+			},
+		},
+		{
+			name: "Error listing task definitions",
+			mocks: func(client *awstest.MockFakeECS) {
+				client.On("ListTaskDefinitionsPagesWithContext", mock.Anything, &ecs.ListTaskDefinitionsInput{Status: aws.String("ACTIVE")}, mock.Anything).Return(dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeECS{}
+			tt.mocks(&client)
+			r := &ecsRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllActiveTaskDefinitions(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllActiveTaskDefinitions(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []string{}, store.Get("ecsListAllActiveTaskDefinitions"))
+			}
+
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type EKSRepository interface {
+	ListAllClusters(ctx context.Context) ([]*eks.Cluster, error)
+	ListAllNodeGroups(ctx context.Context, clusterName string) ([]*eks.Nodegroup, error)
+	ListAllFargateProfiles(ctx context.Context, clusterName string) ([]string, error)
+	ListAllAddons(ctx context.Context, clusterName string) ([]string, error)
+}
+
+type eksRepository struct {
+	client eksiface.EKSAPI
+	cache  cache.Cache
+}
+
+func NewEKSRepository(session *session.Session, c cache.Cache) *eksRepository {
+	return &eksRepository{
+		eks.New(session),
+		c,
+	}
+}
+
+func (r *eksRepository) ListAllClusters(ctx context.Context) ([]*eks.Cluster, error) {
+	cacheKey := "eksListAllClusters"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*eks.Cluster), nil
+	}
+
+	var names []*string
+	err := r.client.ListClustersPagesWithContext(ctx, &eks.ListClustersInput{},
+		func(resp *eks.ListClustersOutput, lastPage bool) bool {
+			names = append(names, resp.Clusters...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*eks.Cluster, 0, len(names))
+	for _, name := range names {
+		resp, err := r.client.DescribeClusterWithContext(ctx, &eks.DescribeClusterInput{
+			Name: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, resp.Cluster)
+	}
+
+	r.cache.Put(cacheKey, clusters)
+	return clusters, nil
+}
+
+func (r *eksRepository) ListAllNodeGroups(ctx context.Context, clusterName string) ([]*eks.Nodegroup, error) {
+	cacheKey := fmt.Sprintf("eksListAllNodeGroups_cluster_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*eks.Nodegroup), nil
+	}
+
+	var names []*string
+	input := &eks.ListNodegroupsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	err := r.client.ListNodegroupsPagesWithContext(ctx, input,
+		func(resp *eks.ListNodegroupsOutput, lastPage bool) bool {
+			names = append(names, resp.Nodegroups...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroups := make([]*eks.Nodegroup, 0, len(names))
+	for _, name := range names {
+		resp, err := r.client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		nodeGroups = append(nodeGroups, resp.Nodegroup)
+	}
+
+	r.cache.Put(cacheKey, nodeGroups)
+	return nodeGroups, nil
+}
+
+func (r *eksRepository) ListAllFargateProfiles(ctx context.Context, clusterName string) ([]string, error) {
+	cacheKey := fmt.Sprintf("eksListAllFargateProfiles_cluster_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]string), nil
+	}
+
+	var names []string
+	input := &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(clusterName),
+	}
+	err := r.client.ListFargateProfilesPagesWithContext(ctx, input,
+		func(resp *eks.ListFargateProfilesOutput, lastPage bool) bool {
+			names = append(names, aws.StringValueSlice(resp.FargateProfileNames)...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, names)
+	return names, nil
+}
+
+func (r *eksRepository) ListAllAddons(ctx context.Context, clusterName string) ([]string, error) {
+	cacheKey := fmt.Sprintf("eksListAllAddons_cluster_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]string), nil
+	}
+
+	var names []string
+	input := &eks.ListAddonsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	err := r.client.ListAddonsPagesWithContext(ctx, input,
+		func(resp *eks.ListAddonsOutput, lastPage bool) bool {
+			names = append(names, aws.StringValueSlice(resp.Addons)...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, names)
+	return names, nil
+}
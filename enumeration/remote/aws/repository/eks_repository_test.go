@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_eksRepository_ListAllClusters(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS)
+		want    []*eks.Cluster
+		wantErr error
+	}{
+		{
+			name: "List clusters with 2 pages and describe them",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&eks.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *eks.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&eks.ListClustersOutput{Clusters: []*string{aws.String("foo")}}, false)
+						callback(&eks.ListClustersOutput{Clusters: []*string{aws.String("bar")}}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeClusterWithContext", mock.Anything, &eks.DescribeClusterInput{Name: aws.String("foo")}).Return(&eks.DescribeClusterOutput{
+					Cluster: &eks.Cluster{Name: aws.String("foo")},
+				}, nil).Once()
+				client.On("DescribeClusterWithContext", mock.Anything, &eks.DescribeClusterInput{Name: aws.String("bar")}).Return(&eks.DescribeClusterOutput{
+					Cluster: &eks.Cluster{Name: aws.String("bar")},
+				}, nil).Once()
+			},
+			want: []*eks.Cluster{
+				{Name: aws.String("foo")},
+				{Name: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error describing clusters",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&eks.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *eks.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&eks.ListClustersOutput{Clusters: []*string{aws.String("foo")}}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeClusterWithContext", mock.Anything, &eks.DescribeClusterInput{Name: aws.String("foo")}).Return(nil, dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEKS{}
+			tt.mocks(&client)
+			r := &eksRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllClusters(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*eks.Cluster{}, store.Get("eksListAllClusters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eksRepository_ListAllNodeGroups(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS)
+		want    []*eks.Nodegroup
+		wantErr error
+	}{
+		{
+			name: "List node groups and describe them",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListNodegroupsPagesWithContext", mock.Anything,
+					&eks.ListNodegroupsInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListNodegroupsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListNodegroupsOutput{Nodegroups: []*string{aws.String("workers")}}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeNodegroupWithContext", mock.Anything, &eks.DescribeNodegroupInput{ClusterName: aws.String("foo"), NodegroupName: aws.String("workers")}).Return(&eks.DescribeNodegroupOutput{
+					Nodegroup: &eks.Nodegroup{ClusterName: aws.String("foo"), NodegroupName: aws.String("workers")},
+				}, nil).Once()
+			},
+			want: []*eks.Nodegroup{
+				{ClusterName: aws.String("foo"), NodegroupName: aws.String("workers")},
+			},
+		},
+		{
+			name: "Error listing node groups",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListNodegroupsPagesWithContext", mock.Anything,
+					&eks.ListNodegroupsInput{ClusterName: aws.String("foo")},
+					mock.AnythingOfType("func(*eks.ListNodegroupsOutput, bool) bool")).Return(dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEKS{}
+			tt.mocks(&client)
+			r := &eksRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllNodeGroups(context.Background(), "foo")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllNodeGroups(context.Background(), "foo")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*eks.Nodegroup{}, store.Get("eksListAllNodeGroups_cluster_foo"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eksRepository_ListAllFargateProfiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS)
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "List Fargate profiles with 2 pages",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListFargateProfilesPagesWithContext", mock.Anything,
+					&eks.ListFargateProfilesInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListFargateProfilesOutput, lastPage bool) bool) bool {
+						callback(&eks.ListFargateProfilesOutput{FargateProfileNames: []*string{aws.String("default")}}, false)
+						callback(&eks.ListFargateProfilesOutput{FargateProfileNames: []*string{aws.String("monitoring")}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []string{"default", "monitoring"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEKS{}
+			tt.mocks(&client)
+			r := &eksRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllFargateProfiles(context.Background(), "foo")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllFargateProfiles(context.Background(), "foo")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []string{}, store.Get("eksListAllFargateProfiles_cluster_foo"))
+			}
+
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eksRepository_ListAllAddons(t *testing.T) {
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS)
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "List add-ons",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListAddonsPagesWithContext", mock.Anything,
+					&eks.ListAddonsInput{ClusterName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *eks.ListAddonsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListAddonsOutput{Addons: []*string{aws.String("vpc-cni"), aws.String("coredns")}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []string{"vpc-cni", "coredns"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEKS{}
+			tt.mocks(&client)
+			r := &eksRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllAddons(context.Background(), "foo")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllAddons(context.Background(), "foo")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []string{}, store.Get("eksListAllAddons_cluster_foo"))
+			}
+
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
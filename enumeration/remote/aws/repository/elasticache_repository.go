@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
@@ -8,7 +9,7 @@ import (
 )
 
 type ElastiCacheRepository interface {
-	ListAllCacheClusters() ([]*elasticache.CacheCluster, error)
+	ListAllCacheClusters(ctx context.Context) ([]*elasticache.CacheCluster, error)
 }
 
 type elasticacheRepository struct {
@@ -23,14 +24,14 @@ func NewElastiCacheRepository(session *session.Session, c cache.Cache) *elastica
 	}
 }
 
-func (r *elasticacheRepository) ListAllCacheClusters() ([]*elasticache.CacheCluster, error) {
+func (r *elasticacheRepository) ListAllCacheClusters(ctx context.Context) ([]*elasticache.CacheCluster, error) {
 	if v := r.cache.Get("elasticacheListAllCacheClusters"); v != nil {
 		return v.([]*elasticache.CacheCluster), nil
 	}
 
 	var clusters []*elasticache.CacheCluster
 	input := elasticache.DescribeCacheClustersInput{}
-	err := r.client.DescribeCacheClustersPages(&input,
+	err := r.client.DescribeCacheClustersPagesWithContext(ctx, &input,
 		func(resp *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
 			clusters = append(clusters, resp.CacheClusters...)
 			return !lastPage
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -39,7 +40,7 @@ func Test_ELBRepository_ListAllLoadBalancers(t *testing.T) {
 			mocks: func(client *awstest.MockFakeELB, store *cache.MockCache) {
 				store.On("Get", "elbListAllLoadBalancers").Return(nil).Once()
 
-				client.On("DescribeLoadBalancersPages",
+				client.On("DescribeLoadBalancersPagesWithContext", mock.Anything,
 					&elb.DescribeLoadBalancersInput{},
 					mock.MatchedBy(func(callback func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool) bool {
 						callback(&elb.DescribeLoadBalancersOutput{LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
@@ -81,7 +82,7 @@ func Test_ELBRepository_ListAllLoadBalancers(t *testing.T) {
 			mocks: func(client *awstest.MockFakeELB, store *cache.MockCache) {
 				store.On("Get", "elbListAllLoadBalancers").Return(nil).Once()
 
-				client.On("DescribeLoadBalancersPages",
+				client.On("DescribeLoadBalancersPagesWithContext", mock.Anything,
 					&elb.DescribeLoadBalancersInput{},
 					mock.MatchedBy(func(callback func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool) bool {
 						callback(&elb.DescribeLoadBalancersOutput{LoadBalancerDescriptions: []*elb.LoadBalancerDescription{}}, true)
@@ -100,7 +101,7 @@ func Test_ELBRepository_ListAllLoadBalancers(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLoadBalancers()
+			got, err := r.ListAllLoadBalancers(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type EventBridgeRepository interface {
+	ListAllEventBuses(ctx context.Context) ([]*eventbridge.EventBus, error)
+	ListAllRules(ctx context.Context, eventBusName string) ([]*eventbridge.Rule, error)
+	ListAllTargets(ctx context.Context, eventBusName, ruleName string) ([]*eventbridge.Target, error)
+}
+
+type eventBridgeRepository struct {
+	client eventbridgeiface.EventBridgeAPI
+	cache  cache.Cache
+}
+
+func NewEventBridgeRepository(session *session.Session, c cache.Cache) *eventBridgeRepository {
+	return &eventBridgeRepository{
+		eventbridge.New(session),
+		c,
+	}
+}
+
+func (r *eventBridgeRepository) ListAllEventBuses(ctx context.Context) ([]*eventbridge.EventBus, error) {
+	cacheKey := "eventBridgeListAllEventBuses"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*eventbridge.EventBus), nil
+	}
+
+	var eventBuses []*eventbridge.EventBus
+	input := &eventbridge.ListEventBusesInput{}
+	for {
+		resp, err := r.client.ListEventBusesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		eventBuses = append(eventBuses, resp.EventBuses...)
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	r.cache.Put(cacheKey, eventBuses)
+	return eventBuses, nil
+}
+
+func (r *eventBridgeRepository) ListAllRules(ctx context.Context, eventBusName string) ([]*eventbridge.Rule, error) {
+	cacheKey := fmt.Sprintf("eventBridgeListAllRules_bus_%s", eventBusName)
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*eventbridge.Rule), nil
+	}
+
+	var rules []*eventbridge.Rule
+	input := &eventbridge.ListRulesInput{
+		EventBusName: aws.String(eventBusName),
+	}
+	for {
+		resp, err := r.client.ListRulesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, resp.Rules...)
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	r.cache.Put(cacheKey, rules)
+	return rules, nil
+}
+
+func (r *eventBridgeRepository) ListAllTargets(ctx context.Context, eventBusName, ruleName string) ([]*eventbridge.Target, error) {
+	cacheKey := fmt.Sprintf("eventBridgeListAllTargets_bus_%s_rule_%s", eventBusName, ruleName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*eventbridge.Target), nil
+	}
+
+	var targets []*eventbridge.Target
+	input := &eventbridge.ListTargetsByRuleInput{
+		EventBusName: aws.String(eventBusName),
+		Rule:         aws.String(ruleName),
+	}
+	for {
+		resp, err := r.client.ListTargetsByRuleWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, resp.Targets...)
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	r.cache.Put(cacheKey, targets)
+	return targets, nil
+}
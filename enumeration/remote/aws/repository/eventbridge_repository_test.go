@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_eventBridgeRepository_ListAllEventBuses(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEventBridge)
+		want    []*eventbridge.EventBus
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEventBridge) {
+				client.On("ListEventBusesWithContext", mock.Anything, &eventbridge.ListEventBusesInput{}).Return(&eventbridge.ListEventBusesOutput{
+					EventBuses: []*eventbridge.EventBus{{Name: aws.String("default")}},
+					NextToken:  aws.String("next"),
+				}, nil).Once()
+				client.On("ListEventBusesWithContext", mock.Anything, &eventbridge.ListEventBusesInput{NextToken: aws.String("next")}).Return(&eventbridge.ListEventBusesOutput{
+					EventBuses: []*eventbridge.EventBus{{Name: aws.String("orders")}},
+				}, nil).Once()
+			},
+			want: []*eventbridge.EventBus{
+				{Name: aws.String("default")},
+				{Name: aws.String("orders")},
+			},
+		},
+		{
+			name: "should return an error",
+			mocks: func(client *awstest.MockFakeEventBridge) {
+				client.On("ListEventBusesWithContext", mock.Anything, &eventbridge.ListEventBusesInput{}).Return(nil, dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEventBridge{}
+			tt.mocks(&client)
+			r := &eventBridgeRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllEventBuses(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllEventBuses(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*eventbridge.EventBus{}, store.Get("eventBridgeListAllEventBuses"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eventBridgeRepository_ListAllRules(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEventBridge)
+		want    []*eventbridge.Rule
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEventBridge) {
+				client.On("ListRulesWithContext", mock.Anything, &eventbridge.ListRulesInput{EventBusName: aws.String("orders")}).Return(&eventbridge.ListRulesOutput{
+					Rules:     []*eventbridge.Rule{{Name: aws.String("created")}},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("ListRulesWithContext", mock.Anything, &eventbridge.ListRulesInput{EventBusName: aws.String("orders"), NextToken: aws.String("next")}).Return(&eventbridge.ListRulesOutput{
+					Rules: []*eventbridge.Rule{{Name: aws.String("shipped")}},
+				}, nil).Once()
+			},
+			want: []*eventbridge.Rule{
+				{Name: aws.String("created")},
+				{Name: aws.String("shipped")},
+			},
+		},
+		{
+			name: "should return an error",
+			mocks: func(client *awstest.MockFakeEventBridge) {
+				client.On("ListRulesWithContext", mock.Anything, &eventbridge.ListRulesInput{EventBusName: aws.String("orders")}).Return(nil, dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEventBridge{}
+			tt.mocks(&client)
+			r := &eventBridgeRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllRules(context.Background(), "orders")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllRules(context.Background(), "orders")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*eventbridge.Rule{}, store.Get("eventBridgeListAllRules_bus_orders"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eventBridgeRepository_ListAllTargets(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEventBridge)
+		want    []*eventbridge.Target
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeEventBridge) {
+				client.On("ListTargetsByRuleWithContext", mock.Anything, &eventbridge.ListTargetsByRuleInput{EventBusName: aws.String("orders"), Rule: aws.String("created")}).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets:   []*eventbridge.Target{{Id: aws.String("queue")}},
+					NextToken: aws.String("next"),
+				}, nil).Once()
+				client.On("ListTargetsByRuleWithContext", mock.Anything, &eventbridge.ListTargetsByRuleInput{EventBusName: aws.String("orders"), Rule: aws.String("created"), NextToken: aws.String("next")}).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{Id: aws.String("lambda")}},
+				}, nil).Once()
+			},
+			want: []*eventbridge.Target{
+				{Id: aws.String("queue")},
+				{Id: aws.String("lambda")},
+			},
+		},
+		{
+			name: "should return an error",
+			mocks: func(client *awstest.MockFakeEventBridge) {
+				client.On("ListTargetsByRuleWithContext", mock.Anything, &eventbridge.ListTargetsByRuleInput{EventBusName: aws.String("orders"), Rule: aws.String("created")}).Return(nil, dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeEventBridge{}
+			tt.mocks(&client)
+			r := &eventBridgeRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllTargets(context.Background(), "orders", "created")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllTargets(context.Background(), "orders", "created")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*eventbridge.Target{}, store.Get("eventBridgeListAllTargets_bus_orders_rule_created"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -14,8 +15,8 @@ import (
 )
 
 type KMSRepository interface {
-	ListAllKeys() ([]*kms.KeyListEntry, error)
-	ListAllAliases() ([]*kms.AliasListEntry, error)
+	ListAllKeys(ctx context.Context) ([]*kms.KeyListEntry, error)
+	ListAllAliases(ctx context.Context) ([]*kms.AliasListEntry, error)
 }
 
 type kmsRepository struct {
@@ -32,14 +33,14 @@ func NewKMSRepository(session *session.Session, c cache.Cache) *kmsRepository {
 	}
 }
 
-func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
+func (r *kmsRepository) ListAllKeys(ctx context.Context) ([]*kms.KeyListEntry, error) {
 	if v := r.cache.Get("kmsListAllKeys"); v != nil {
 		return v.([]*kms.KeyListEntry), nil
 	}
 
 	var keys []*kms.KeyListEntry
 	input := kms.ListKeysInput{}
-	err := r.client.ListKeysPages(&input,
+	err := r.client.ListKeysPagesWithContext(ctx, &input,
 		func(resp *kms.ListKeysOutput, lastPage bool) bool {
 			keys = append(keys, resp.Keys...)
 			return !lastPage
@@ -48,7 +49,7 @@ func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	if err != nil {
 		return nil, err
 	}
-	customerKeys, err := r.filterKeys(keys)
+	customerKeys, err := r.filterKeys(ctx, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -57,14 +58,14 @@ func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	return customerKeys, nil
 }
 
-func (r *kmsRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
+func (r *kmsRepository) ListAllAliases(ctx context.Context) ([]*kms.AliasListEntry, error) {
 	if v := r.cache.Get("kmsListAllAliases"); v != nil {
 		return v.([]*kms.AliasListEntry), nil
 	}
 
 	var aliases []*kms.AliasListEntry
 	input := kms.ListAliasesInput{}
-	err := r.client.ListAliasesPages(&input,
+	err := r.client.ListAliasesPagesWithContext(ctx, &input,
 		func(resp *kms.ListAliasesOutput, lastPage bool) bool {
 			aliases = append(aliases, resp.Aliases...)
 			return !lastPage
@@ -74,7 +75,7 @@ func (r *kmsRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 		return nil, err
 	}
 
-	result, err := r.filterAliases(aliases)
+	result, err := r.filterAliases(ctx, aliases)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +83,7 @@ func (r *kmsRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	return result, nil
 }
 
-func (r *kmsRepository) describeKey(keyId *string) (*kms.DescribeKeyOutput, error) {
+func (r *kmsRepository) describeKey(ctx context.Context, keyId *string) (*kms.DescribeKeyOutput, error) {
 	var results interface{}
 	// Since this method can be call in parallel, we should lock and unlock if we want to be sure to hit the cache
 	r.describeKeyLock.Lock()
@@ -91,7 +92,7 @@ func (r *kmsRepository) describeKey(keyId *string) (*kms.DescribeKeyOutput, erro
 	results = r.cache.Get(cacheKey)
 	if results == nil {
 		var err error
-		results, err = r.client.DescribeKey(&kms.DescribeKeyInput{KeyId: keyId})
+		results, err = r.client.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{KeyId: keyId})
 		if err != nil {
 			return nil, err
 		}
@@ -104,10 +105,10 @@ func (r *kmsRepository) describeKey(keyId *string) (*kms.DescribeKeyOutput, erro
 	return describeKey, nil
 }
 
-func (r *kmsRepository) filterKeys(keys []*kms.KeyListEntry) ([]*kms.KeyListEntry, error) {
+func (r *kmsRepository) filterKeys(ctx context.Context, keys []*kms.KeyListEntry) ([]*kms.KeyListEntry, error) {
 	var customerKeys []*kms.KeyListEntry
 	for _, key := range keys {
-		k, err := r.describeKey(key.KeyId)
+		k, err := r.describeKey(ctx, key.KeyId)
 		if err != nil {
 			return nil, err
 		}
@@ -124,11 +125,11 @@ func (r *kmsRepository) filterKeys(keys []*kms.KeyListEntry) ([]*kms.KeyListEntr
 	return customerKeys, nil
 }
 
-func (r *kmsRepository) filterAliases(aliases []*kms.AliasListEntry) ([]*kms.AliasListEntry, error) {
+func (r *kmsRepository) filterAliases(ctx context.Context, aliases []*kms.AliasListEntry) ([]*kms.AliasListEntry, error) {
 	var customerAliases []*kms.AliasListEntry
 	for _, alias := range aliases {
 		if alias.AliasName != nil && !strings.HasPrefix(*alias.AliasName, "alias/aws/") {
-			k, err := r.describeKey(alias.TargetKeyId)
+			k, err := r.describeKey(ctx, alias.TargetKeyId)
 			if err != nil {
 				return nil, err
 			}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
@@ -8,8 +9,8 @@ import (
 )
 
 type LambdaRepository interface {
-	ListAllLambdaFunctions() ([]*lambda.FunctionConfiguration, error)
-	ListAllLambdaEventSourceMappings() ([]*lambda.EventSourceMappingConfiguration, error)
+	ListAllLambdaFunctions(ctx context.Context) ([]*lambda.FunctionConfiguration, error)
+	ListAllLambdaEventSourceMappings(ctx context.Context) ([]*lambda.EventSourceMappingConfiguration, error)
 }
 
 type lambdaRepository struct {
@@ -24,14 +25,14 @@ func NewLambdaRepository(session *session.Session, c cache.Cache) *lambdaReposit
 	}
 }
 
-func (r *lambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConfiguration, error) {
+func (r *lambdaRepository) ListAllLambdaFunctions(ctx context.Context) ([]*lambda.FunctionConfiguration, error) {
 	if v := r.cache.Get("lambdaListAllLambdaFunctions"); v != nil {
 		return v.([]*lambda.FunctionConfiguration), nil
 	}
 
 	var functions []*lambda.FunctionConfiguration
 	input := &lambda.ListFunctionsInput{}
-	err := r.client.ListFunctionsPages(input, func(res *lambda.ListFunctionsOutput, lastPage bool) bool {
+	err := r.client.ListFunctionsPagesWithContext(ctx, input, func(res *lambda.ListFunctionsOutput, lastPage bool) bool {
 		functions = append(functions, res.Functions...)
 		return !lastPage
 	})
@@ -43,14 +44,14 @@ func (r *lambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConfigura
 	return functions, nil
 }
 
-func (r *lambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.EventSourceMappingConfiguration, error) {
+func (r *lambdaRepository) ListAllLambdaEventSourceMappings(ctx context.Context) ([]*lambda.EventSourceMappingConfiguration, error) {
 	if v := r.cache.Get("lambdaListAllLambdaEventSourceMappings"); v != nil {
 		return v.([]*lambda.EventSourceMappingConfiguration), nil
 	}
 
 	var eventSourceMappingConfigurations []*lambda.EventSourceMappingConfiguration
 	input := &lambda.ListEventSourceMappingsInput{}
-	err := r.client.ListEventSourceMappingsPages(input, func(res *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
+	err := r.client.ListEventSourceMappingsPagesWithContext(ctx, input, func(res *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
 		eventSourceMappingConfigurations = append(eventSourceMappingConfigurations, res.EventSourceMappings...)
 		return !lastPage
 	})
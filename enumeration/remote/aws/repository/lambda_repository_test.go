@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -24,7 +25,7 @@ func Test_lambdaRepository_ListAllLambdaFunctions(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeLambda) {
-				client.On("ListFunctionsPages",
+				client.On("ListFunctionsPagesWithContext", mock.Anything,
 					&lambda.ListFunctionsInput{},
 					mock.MatchedBy(func(callback func(res *lambda.ListFunctionsOutput, lastPage bool) bool) bool {
 						callback(&lambda.ListFunctionsOutput{
@@ -68,12 +69,12 @@ func Test_lambdaRepository_ListAllLambdaFunctions(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLambdaFunctions()
+			got, err := r.ListAllLambdaFunctions(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllLambdaFunctions()
+				cachedData, err := r.ListAllLambdaFunctions(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*lambda.FunctionConfiguration{}, store.Get("lambdaListAllLambdaFunctions"))
@@ -101,7 +102,7 @@ func Test_lambdaRepository_ListAllLambdaEventSourceMappings(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeLambda) {
-				client.On("ListEventSourceMappingsPages",
+				client.On("ListEventSourceMappingsPagesWithContext", mock.Anything,
 					&lambda.ListEventSourceMappingsInput{},
 					mock.MatchedBy(func(callback func(res *lambda.ListEventSourceMappingsOutput, lastPage bool) bool) bool {
 						callback(&lambda.ListEventSourceMappingsOutput{
@@ -145,12 +146,12 @@ func Test_lambdaRepository_ListAllLambdaEventSourceMappings(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLambdaEventSourceMappings()
+			got, err := r.ListAllLambdaEventSourceMappings(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllLambdaEventSourceMappings()
+				cachedData, err := r.ListAllLambdaEventSourceMappings(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*lambda.EventSourceMappingConfiguration{}, store.Get("lambdaListAllLambdaEventSourceMappings"))
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	apigateway "github.com/aws/aws-sdk-go/service/apigateway"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockApiGatewayRepository struct {
 	mock.Mock
 }
 
-// GetAccount provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) GetAccount() (*apigateway.Account, error) {
-	ret := _m.Called()
+// GetAccount provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) GetAccount(ctx context.Context) (*apigateway.Account, error) {
+	ret := _m.Called(ctx)
 
 	var r0 *apigateway.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*apigateway.Account, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*apigateway.Account, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *apigateway.Account); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *apigateway.Account); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*apigateway.Account)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockApiGatewayRepository) GetAccount() (*apigateway.Account, error) {
 	return r0, r1
 }
 
-// ListAllApiKeys provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllApiKeys() ([]*apigateway.ApiKey, error) {
-	ret := _m.Called()
+// ListAllApiKeys provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllApiKeys(ctx context.Context) ([]*apigateway.ApiKey, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.ApiKey
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*apigateway.ApiKey, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*apigateway.ApiKey, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*apigateway.ApiKey); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.ApiKey); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.ApiKey)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +66,25 @@ func (_m *MockApiGatewayRepository) ListAllApiKeys() ([]*apigateway.ApiKey, erro
 	return r0, r1
 }
 
-// ListAllDomainNameBasePathMappings provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllDomainNameBasePathMappings(_a0 string) ([]*apigateway.BasePathMapping, error) {
-	ret := _m.Called(_a0)
+// ListAllDomainNameBasePathMappings provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayRepository) ListAllDomainNameBasePathMappings(ctx context.Context, _a0 string) ([]*apigateway.BasePathMapping, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigateway.BasePathMapping
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigateway.BasePathMapping, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigateway.BasePathMapping, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.BasePathMapping); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.BasePathMapping); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.BasePathMapping)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,25 +92,25 @@ func (_m *MockApiGatewayRepository) ListAllDomainNameBasePathMappings(_a0 string
 	return r0, r1
 }
 
-// ListAllDomainNames provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllDomainNames() ([]*apigateway.DomainName, error) {
-	ret := _m.Called()
+// ListAllDomainNames provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllDomainNames(ctx context.Context) ([]*apigateway.DomainName, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.DomainName
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*apigateway.DomainName, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*apigateway.DomainName, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*apigateway.DomainName); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.DomainName); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.DomainName)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,25 +118,25 @@ func (_m *MockApiGatewayRepository) ListAllDomainNames() ([]*apigateway.DomainNa
 	return r0, r1
 }
 
-// ListAllRestApiAuthorizers provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiAuthorizers(_a0 string) ([]*apigateway.Authorizer, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiAuthorizers provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayRepository) ListAllRestApiAuthorizers(ctx context.Context, _a0 string) ([]*apigateway.Authorizer, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigateway.Authorizer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigateway.Authorizer, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigateway.Authorizer, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Authorizer); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Authorizer); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Authorizer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -142,25 +144,25 @@ func (_m *MockApiGatewayRepository) ListAllRestApiAuthorizers(_a0 string) ([]*ap
 	return r0, r1
 }
 
-// ListAllRestApiGatewayResponses provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiGatewayResponses(_a0 string) ([]*apigateway.UpdateGatewayResponseOutput, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiGatewayResponses provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayRepository) ListAllRestApiGatewayResponses(ctx context.Context, _a0 string) ([]*apigateway.UpdateGatewayResponseOutput, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigateway.UpdateGatewayResponseOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigateway.UpdateGatewayResponseOutput, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigateway.UpdateGatewayResponseOutput, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.UpdateGatewayResponseOutput); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.UpdateGatewayResponseOutput); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.UpdateGatewayResponseOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,25 +170,25 @@ func (_m *MockApiGatewayRepository) ListAllRestApiGatewayResponses(_a0 string) (
 	return r0, r1
 }
 
-// ListAllRestApiModels provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiModels(_a0 string) ([]*apigateway.Model, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiModels provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayRepository) ListAllRestApiModels(ctx context.Context, _a0 string) ([]*apigateway.Model, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigateway.Model
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigateway.Model, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigateway.Model, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Model); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Model); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Model)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -194,25 +196,25 @@ func (_m *MockApiGatewayRepository) ListAllRestApiModels(_a0 string) ([]*apigate
 	return r0, r1
 }
 
-// ListAllRestApiRequestValidators provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiRequestValidators(_a0 string) ([]*apigateway.UpdateRequestValidatorOutput, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiRequestValidators provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayRepository) ListAllRestApiRequestValidators(ctx context.Context, _a0 string) ([]*apigateway.UpdateRequestValidatorOutput, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigateway.UpdateRequestValidatorOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigateway.UpdateRequestValidatorOutput, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigateway.UpdateRequestValidatorOutput, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.UpdateRequestValidatorOutput); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.UpdateRequestValidatorOutput); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.UpdateRequestValidatorOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,25 +222,25 @@ func (_m *MockApiGatewayRepository) ListAllRestApiRequestValidators(_a0 string)
 	return r0, r1
 }
 
-// ListAllRestApiResources provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiResources(_a0 string) ([]*apigateway.Resource, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiResources provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayRepository) ListAllRestApiResources(ctx context.Context, _a0 string) ([]*apigateway.Resource, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigateway.Resource
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigateway.Resource, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigateway.Resource, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Resource); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Resource); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Resource)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -246,25 +248,25 @@ func (_m *MockApiGatewayRepository) ListAllRestApiResources(_a0 string) ([]*apig
 	return r0, r1
 }
 
-// ListAllRestApiStages provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiStages(_a0 string) ([]*apigateway.Stage, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiStages provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayRepository) ListAllRestApiStages(ctx context.Context, _a0 string) ([]*apigateway.Stage, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigateway.Stage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigateway.Stage, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigateway.Stage, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Stage); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Stage); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Stage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -272,25 +274,25 @@ func (_m *MockApiGatewayRepository) ListAllRestApiStages(_a0 string) ([]*apigate
 	return r0, r1
 }
 
-// ListAllRestApis provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, error) {
-	ret := _m.Called()
+// ListAllRestApis provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllRestApis(ctx context.Context) ([]*apigateway.RestApi, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.RestApi
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*apigateway.RestApi, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*apigateway.RestApi, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*apigateway.RestApi); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.RestApi); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.RestApi)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -298,25 +300,25 @@ func (_m *MockApiGatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, er
 	return r0, r1
 }
 
-// ListAllVpcLinks provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllVpcLinks() ([]*apigateway.UpdateVpcLinkOutput, error) {
-	ret := _m.Called()
+// ListAllVpcLinks provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllVpcLinks(ctx context.Context) ([]*apigateway.UpdateVpcLinkOutput, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.UpdateVpcLinkOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*apigateway.UpdateVpcLinkOutput, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*apigateway.UpdateVpcLinkOutput, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*apigateway.UpdateVpcLinkOutput); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.UpdateVpcLinkOutput); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.UpdateVpcLinkOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
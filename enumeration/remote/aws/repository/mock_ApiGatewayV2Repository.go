@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	apigatewayv2 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockApiGatewayV2Repository struct {
 	mock.Mock
 }
 
-// ListAllApiAuthorizers provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiAuthorizers(_a0 string) ([]*apigatewayv2.Authorizer, error) {
-	ret := _m.Called(_a0)
+// ListAllApiAuthorizers provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayV2Repository) ListAllApiAuthorizers(ctx context.Context, _a0 string) ([]*apigatewayv2.Authorizer, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigatewayv2.Authorizer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigatewayv2.Authorizer, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigatewayv2.Authorizer, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Authorizer); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Authorizer); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Authorizer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiAuthorizers(_a0 string) ([]*apig
 	return r0, r1
 }
 
-// ListAllApiDeployments provides a mock function with given fields: apiId
-func (_m *MockApiGatewayV2Repository) ListAllApiDeployments(apiId *string) ([]*apigatewayv2.Deployment, error) {
-	ret := _m.Called(apiId)
+// ListAllApiDeployments provides a mock function with given fields: ctx, apiId
+func (_m *MockApiGatewayV2Repository) ListAllApiDeployments(ctx context.Context, apiId *string) ([]*apigatewayv2.Deployment, error) {
+	ret := _m.Called(ctx, apiId)
 
 	var r0 []*apigatewayv2.Deployment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string) ([]*apigatewayv2.Deployment, error)); ok {
-		return rf(apiId)
+	if rf, ok := ret.Get(0).(func(context.Context, *string) ([]*apigatewayv2.Deployment, error)); ok {
+		return rf(ctx, apiId)
 	}
-	if rf, ok := ret.Get(0).(func(*string) []*apigatewayv2.Deployment); ok {
-		r0 = rf(apiId)
+	if rf, ok := ret.Get(0).(func(context.Context, *string) []*apigatewayv2.Deployment); ok {
+		r0 = rf(ctx, apiId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Deployment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string) error); ok {
-		r1 = rf(apiId)
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, apiId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +66,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiDeployments(apiId *string) ([]*a
 	return r0, r1
 }
 
-// ListAllApiIntegrationResponses provides a mock function with given fields: _a0, _a1
-func (_m *MockApiGatewayV2Repository) ListAllApiIntegrationResponses(_a0 string, _a1 string) ([]*apigatewayv2.IntegrationResponse, error) {
-	ret := _m.Called(_a0, _a1)
+// ListAllApiIntegrationResponses provides a mock function with given fields: ctx, _a0, _a1
+func (_m *MockApiGatewayV2Repository) ListAllApiIntegrationResponses(ctx context.Context, _a0 string, _a1 string) ([]*apigatewayv2.IntegrationResponse, error) {
+	ret := _m.Called(ctx, _a0, _a1)
 
 	var r0 []*apigatewayv2.IntegrationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) ([]*apigatewayv2.IntegrationResponse, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*apigatewayv2.IntegrationResponse, error)); ok {
+		return rf(ctx, _a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) []*apigatewayv2.IntegrationResponse); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*apigatewayv2.IntegrationResponse); ok {
+		r0 = rf(ctx, _a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.IntegrationResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, _a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,25 +92,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiIntegrationResponses(_a0 string,
 	return r0, r1
 }
 
-// ListAllApiIntegrations provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiIntegrations(_a0 string) ([]*apigatewayv2.Integration, error) {
-	ret := _m.Called(_a0)
+// ListAllApiIntegrations provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayV2Repository) ListAllApiIntegrations(ctx context.Context, _a0 string) ([]*apigatewayv2.Integration, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigatewayv2.Integration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigatewayv2.Integration, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigatewayv2.Integration, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Integration); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Integration); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Integration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,25 +118,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiIntegrations(_a0 string) ([]*api
 	return r0, r1
 }
 
-// ListAllApiMappings provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiMappings(_a0 string) ([]*apigatewayv2.ApiMapping, error) {
-	ret := _m.Called(_a0)
+// ListAllApiMappings provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayV2Repository) ListAllApiMappings(ctx context.Context, _a0 string) ([]*apigatewayv2.ApiMapping, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigatewayv2.ApiMapping
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigatewayv2.ApiMapping, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigatewayv2.ApiMapping, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.ApiMapping); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.ApiMapping); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.ApiMapping)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -142,25 +144,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiMappings(_a0 string) ([]*apigate
 	return r0, r1
 }
 
-// ListAllApiModels provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiModels(_a0 string) ([]*apigatewayv2.Model, error) {
-	ret := _m.Called(_a0)
+// ListAllApiModels provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayV2Repository) ListAllApiModels(ctx context.Context, _a0 string) ([]*apigatewayv2.Model, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigatewayv2.Model
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigatewayv2.Model, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigatewayv2.Model, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Model); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Model); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Model)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,25 +170,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiModels(_a0 string) ([]*apigatewa
 	return r0, r1
 }
 
-// ListAllApiRouteResponses provides a mock function with given fields: _a0, _a1
-func (_m *MockApiGatewayV2Repository) ListAllApiRouteResponses(_a0 string, _a1 string) ([]*apigatewayv2.RouteResponse, error) {
-	ret := _m.Called(_a0, _a1)
+// ListAllApiRouteResponses provides a mock function with given fields: ctx, _a0, _a1
+func (_m *MockApiGatewayV2Repository) ListAllApiRouteResponses(ctx context.Context, _a0 string, _a1 string) ([]*apigatewayv2.RouteResponse, error) {
+	ret := _m.Called(ctx, _a0, _a1)
 
 	var r0 []*apigatewayv2.RouteResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) ([]*apigatewayv2.RouteResponse, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*apigatewayv2.RouteResponse, error)); ok {
+		return rf(ctx, _a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) []*apigatewayv2.RouteResponse); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*apigatewayv2.RouteResponse); ok {
+		r0 = rf(ctx, _a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.RouteResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, _a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -194,25 +196,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiRouteResponses(_a0 string, _a1 s
 	return r0, r1
 }
 
-// ListAllApiRoutes provides a mock function with given fields: apiId
-func (_m *MockApiGatewayV2Repository) ListAllApiRoutes(apiId *string) ([]*apigatewayv2.Route, error) {
-	ret := _m.Called(apiId)
+// ListAllApiRoutes provides a mock function with given fields: ctx, apiId
+func (_m *MockApiGatewayV2Repository) ListAllApiRoutes(ctx context.Context, apiId *string) ([]*apigatewayv2.Route, error) {
+	ret := _m.Called(ctx, apiId)
 
 	var r0 []*apigatewayv2.Route
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string) ([]*apigatewayv2.Route, error)); ok {
-		return rf(apiId)
+	if rf, ok := ret.Get(0).(func(context.Context, *string) ([]*apigatewayv2.Route, error)); ok {
+		return rf(ctx, apiId)
 	}
-	if rf, ok := ret.Get(0).(func(*string) []*apigatewayv2.Route); ok {
-		r0 = rf(apiId)
+	if rf, ok := ret.Get(0).(func(context.Context, *string) []*apigatewayv2.Route); ok {
+		r0 = rf(ctx, apiId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Route)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string) error); ok {
-		r1 = rf(apiId)
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, apiId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,25 +222,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiRoutes(apiId *string) ([]*apigat
 	return r0, r1
 }
 
-// ListAllApiStages provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiStages(_a0 string) ([]*apigatewayv2.Stage, error) {
-	ret := _m.Called(_a0)
+// ListAllApiStages provides a mock function with given fields: ctx, _a0
+func (_m *MockApiGatewayV2Repository) ListAllApiStages(ctx context.Context, _a0 string) ([]*apigatewayv2.Stage, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*apigatewayv2.Stage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*apigatewayv2.Stage, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*apigatewayv2.Stage, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Stage); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Stage); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Stage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -246,25 +248,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApiStages(_a0 string) ([]*apigatewa
 	return r0, r1
 }
 
-// ListAllApis provides a mock function with given fields:
-func (_m *MockApiGatewayV2Repository) ListAllApis() ([]*apigatewayv2.Api, error) {
-	ret := _m.Called()
+// ListAllApis provides a mock function with given fields: ctx
+func (_m *MockApiGatewayV2Repository) ListAllApis(ctx context.Context) ([]*apigatewayv2.Api, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigatewayv2.Api
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*apigatewayv2.Api, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*apigatewayv2.Api, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*apigatewayv2.Api); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigatewayv2.Api); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Api)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -272,25 +274,25 @@ func (_m *MockApiGatewayV2Repository) ListAllApis() ([]*apigatewayv2.Api, error)
 	return r0, r1
 }
 
-// ListAllVpcLinks provides a mock function with given fields:
-func (_m *MockApiGatewayV2Repository) ListAllVpcLinks() ([]*apigatewayv2.VpcLink, error) {
-	ret := _m.Called()
+// ListAllVpcLinks provides a mock function with given fields: ctx
+func (_m *MockApiGatewayV2Repository) ListAllVpcLinks(ctx context.Context) ([]*apigatewayv2.VpcLink, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigatewayv2.VpcLink
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*apigatewayv2.VpcLink, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*apigatewayv2.VpcLink, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*apigatewayv2.VpcLink); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigatewayv2.VpcLink); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.VpcLink)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	applicationautoscaling "github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockAppAutoScalingRepository struct {
 	mock.Mock
 }
 
-// DescribeScalableTargets provides a mock function with given fields: _a0
-func (_m *MockAppAutoScalingRepository) DescribeScalableTargets(_a0 string) ([]*applicationautoscaling.ScalableTarget, error) {
-	ret := _m.Called(_a0)
+// DescribeScalableTargets provides a mock function with given fields: ctx, _a0
+func (_m *MockAppAutoScalingRepository) DescribeScalableTargets(ctx context.Context, _a0 string) ([]*applicationautoscaling.ScalableTarget, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*applicationautoscaling.ScalableTarget
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*applicationautoscaling.ScalableTarget, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*applicationautoscaling.ScalableTarget, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*applicationautoscaling.ScalableTarget); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*applicationautoscaling.ScalableTarget); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*applicationautoscaling.ScalableTarget)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockAppAutoScalingRepository) DescribeScalableTargets(_a0 string) ([]*
 	return r0, r1
 }
 
-// DescribeScalingPolicies provides a mock function with given fields: _a0
-func (_m *MockAppAutoScalingRepository) DescribeScalingPolicies(_a0 string) ([]*applicationautoscaling.ScalingPolicy, error) {
-	ret := _m.Called(_a0)
+// DescribeScalingPolicies provides a mock function with given fields: ctx, _a0
+func (_m *MockAppAutoScalingRepository) DescribeScalingPolicies(ctx context.Context, _a0 string) ([]*applicationautoscaling.ScalingPolicy, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*applicationautoscaling.ScalingPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*applicationautoscaling.ScalingPolicy, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*applicationautoscaling.ScalingPolicy, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*applicationautoscaling.ScalingPolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*applicationautoscaling.ScalingPolicy); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*applicationautoscaling.ScalingPolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +66,25 @@ func (_m *MockAppAutoScalingRepository) DescribeScalingPolicies(_a0 string) ([]*
 	return r0, r1
 }
 
-// DescribeScheduledActions provides a mock function with given fields: _a0
-func (_m *MockAppAutoScalingRepository) DescribeScheduledActions(_a0 string) ([]*applicationautoscaling.ScheduledAction, error) {
-	ret := _m.Called(_a0)
+// DescribeScheduledActions provides a mock function with given fields: ctx, _a0
+func (_m *MockAppAutoScalingRepository) DescribeScheduledActions(ctx context.Context, _a0 string) ([]*applicationautoscaling.ScheduledAction, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*applicationautoscaling.ScheduledAction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*applicationautoscaling.ScheduledAction, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*applicationautoscaling.ScheduledAction, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*applicationautoscaling.ScheduledAction); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*applicationautoscaling.ScheduledAction); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*applicationautoscaling.ScheduledAction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,13 +92,13 @@ func (_m *MockAppAutoScalingRepository) DescribeScheduledActions(_a0 string) ([]
 	return r0, r1
 }
 
-// ServiceNamespaceValues provides a mock function with given fields:
-func (_m *MockAppAutoScalingRepository) ServiceNamespaceValues() []string {
-	ret := _m.Called()
+// ServiceNamespaceValues provides a mock function with given fields: ctx
+func (_m *MockAppAutoScalingRepository) ServiceNamespaceValues(ctx context.Context) []string {
+	ret := _m.Called(ctx)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func() []string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockCloudformationRepository struct {
 	mock.Mock
 }
 
-// ListAllStacks provides a mock function with given fields:
-func (_m *MockCloudformationRepository) ListAllStacks() ([]*cloudformation.Stack, error) {
-	ret := _m.Called()
+// ListAllStacks provides a mock function with given fields: ctx
+func (_m *MockCloudformationRepository) ListAllStacks(ctx context.Context) ([]*cloudformation.Stack, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*cloudformation.Stack
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*cloudformation.Stack, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*cloudformation.Stack, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*cloudformation.Stack); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*cloudformation.Stack); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*cloudformation.Stack)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	ec2 "github.com/aws/aws-sdk-go/service/ec2"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockEC2Repository struct {
 	mock.Mock
 }
 
-// DescribeLaunchTemplates provides a mock function with given fields:
-func (_m *MockEC2Repository) DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, error) {
-	ret := _m.Called()
+// DescribeLaunchTemplates provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) DescribeLaunchTemplates(ctx context.Context) ([]*ec2.LaunchTemplate, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.LaunchTemplate
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.LaunchTemplate, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.LaunchTemplate, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.LaunchTemplate); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.LaunchTemplate); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.LaunchTemplate)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,23 +40,23 @@ func (_m *MockEC2Repository) DescribeLaunchTemplates() ([]*ec2.LaunchTemplate, e
 	return r0, r1
 }
 
-// IsEbsEncryptionEnabledByDefault provides a mock function with given fields:
-func (_m *MockEC2Repository) IsEbsEncryptionEnabledByDefault() (bool, error) {
-	ret := _m.Called()
+// IsEbsEncryptionEnabledByDefault provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) IsEbsEncryptionEnabledByDefault(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (bool, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() bool); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,25 +64,25 @@ func (_m *MockEC2Repository) IsEbsEncryptionEnabledByDefault() (bool, error) {
 	return r0, r1
 }
 
-// ListAllAddresses provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllAddresses() ([]*ec2.Address, error) {
-	ret := _m.Called()
+// ListAllAddresses provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllAddresses(ctx context.Context) ([]*ec2.Address, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Address
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Address, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Address, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Address); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Address); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Address)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,25 +90,25 @@ func (_m *MockEC2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	return r0, r1
 }
 
-// ListAllAddressesAssociation provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllAddressesAssociation() ([]*ec2.Address, error) {
-	ret := _m.Called()
+// ListAllAddressesAssociation provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllAddressesAssociation(ctx context.Context) ([]*ec2.Address, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Address
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Address, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Address, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Address); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Address); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Address)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,25 +116,77 @@ func (_m *MockEC2Repository) ListAllAddressesAssociation() ([]*ec2.Address, erro
 	return r0, r1
 }
 
-// ListAllImages provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllImages() ([]*ec2.Image, error) {
-	ret := _m.Called()
+// ListAllDhcpOptions provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllDhcpOptions(ctx context.Context) ([]*ec2.DhcpOptions, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*ec2.DhcpOptions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.DhcpOptions, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.DhcpOptions); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.DhcpOptions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllFlowLogs provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllFlowLogs(ctx context.Context) ([]*ec2.FlowLog, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*ec2.FlowLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.FlowLog, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.FlowLog); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.FlowLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllImages provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllImages(ctx context.Context) ([]*ec2.Image, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Image
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Image, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Image, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Image); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Image); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Image)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -140,25 +194,25 @@ func (_m *MockEC2Repository) ListAllImages() ([]*ec2.Image, error) {
 	return r0, r1
 }
 
-// ListAllInstances provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllInstances() ([]*ec2.Instance, error) {
-	ret := _m.Called()
+// ListAllInstances provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllInstances(ctx context.Context) ([]*ec2.Instance, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Instance
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Instance, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Instance, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Instance); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Instance); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Instance)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,25 +220,25 @@ func (_m *MockEC2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 	return r0, r1
 }
 
-// ListAllInternetGateways provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error) {
-	ret := _m.Called()
+// ListAllInternetGateways provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllInternetGateways(ctx context.Context) ([]*ec2.InternetGateway, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.InternetGateway
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.InternetGateway, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.InternetGateway, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.InternetGateway); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.InternetGateway); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.InternetGateway)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -192,25 +246,25 @@ func (_m *MockEC2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway,
 	return r0, r1
 }
 
-// ListAllKeyPairs provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
-	ret := _m.Called()
+// ListAllKeyPairs provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllKeyPairs(ctx context.Context) ([]*ec2.KeyPairInfo, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.KeyPairInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.KeyPairInfo, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.KeyPairInfo, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.KeyPairInfo); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.KeyPairInfo); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.KeyPairInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -218,25 +272,25 @@ func (_m *MockEC2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	return r0, r1
 }
 
-// ListAllNatGateways provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
-	ret := _m.Called()
+// ListAllNatGateways provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllNatGateways(ctx context.Context) ([]*ec2.NatGateway, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.NatGateway
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.NatGateway, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.NatGateway, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.NatGateway); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.NatGateway); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.NatGateway)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -244,25 +298,51 @@ func (_m *MockEC2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
 	return r0, r1
 }
 
-// ListAllNetworkACLs provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
-	ret := _m.Called()
+// ListAllNetworkACLs provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllNetworkACLs(ctx context.Context) ([]*ec2.NetworkAcl, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.NetworkAcl
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.NetworkAcl, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.NetworkAcl, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.NetworkAcl); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.NetworkAcl); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.NetworkAcl)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllNetworkInterfaces provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllNetworkInterfaces(ctx context.Context) ([]*ec2.NetworkInterface, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*ec2.NetworkInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.NetworkInterface, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.NetworkInterface); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.NetworkInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -270,25 +350,25 @@ func (_m *MockEC2Repository) ListAllNetworkACLs() ([]*ec2.NetworkAcl, error) {
 	return r0, r1
 }
 
-// ListAllRouteTables provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
-	ret := _m.Called()
+// ListAllRouteTables provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllRouteTables(ctx context.Context) ([]*ec2.RouteTable, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.RouteTable
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.RouteTable, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.RouteTable, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.RouteTable); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.RouteTable); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.RouteTable)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -296,34 +376,34 @@ func (_m *MockEC2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 	return r0, r1
 }
 
-// ListAllSecurityGroups provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
-	ret := _m.Called()
+// ListAllSecurityGroups provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllSecurityGroups(ctx context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.SecurityGroup
 	var r1 []*ec2.SecurityGroup
 	var r2 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.SecurityGroup); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.SecurityGroup); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.SecurityGroup)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() []*ec2.SecurityGroup); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) []*ec2.SecurityGroup); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]*ec2.SecurityGroup)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func() error); ok {
-		r2 = rf()
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -331,25 +411,25 @@ func (_m *MockEC2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*e
 	return r0, r1, r2
 }
 
-// ListAllSnapshots provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
-	ret := _m.Called()
+// ListAllSnapshots provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllSnapshots(ctx context.Context) ([]*ec2.Snapshot, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Snapshot
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Snapshot, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Snapshot, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Snapshot); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Snapshot); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Snapshot)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -357,34 +437,34 @@ func (_m *MockEC2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	return r0, r1
 }
 
-// ListAllSubnets provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
-	ret := _m.Called()
+// ListAllSubnets provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllSubnets(ctx context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Subnet
 	var r1 []*ec2.Subnet
 	var r2 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Subnet, []*ec2.Subnet, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Subnet); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Subnet); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Subnet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() []*ec2.Subnet); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) []*ec2.Subnet); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]*ec2.Subnet)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func() error); ok {
-		r2 = rf()
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -392,34 +472,86 @@ func (_m *MockEC2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, err
 	return r0, r1, r2
 }
 
-// ListAllVPCs provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
-	ret := _m.Called()
+// ListAllTransitGatewayVpcAttachments provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllTransitGatewayVpcAttachments(ctx context.Context) ([]*ec2.TransitGatewayVpcAttachment, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*ec2.TransitGatewayVpcAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.TransitGatewayVpcAttachment, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.TransitGatewayVpcAttachment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.TransitGatewayVpcAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllTransitGateways provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllTransitGateways(ctx context.Context) ([]*ec2.TransitGateway, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*ec2.TransitGateway
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.TransitGateway, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.TransitGateway); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.TransitGateway)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllVPCs provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllVPCs(ctx context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Vpc
 	var r1 []*ec2.Vpc
 	var r2 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Vpc, []*ec2.Vpc, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Vpc); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Vpc); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Vpc)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() []*ec2.Vpc); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) []*ec2.Vpc); ok {
+		r1 = rf(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]*ec2.Vpc)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func() error); ok {
-		r2 = rf()
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -427,25 +559,77 @@ func (_m *MockEC2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	return r0, r1, r2
 }
 
-// ListAllVolumes provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
-	ret := _m.Called()
+// ListAllVolumes provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllVolumes(ctx context.Context) ([]*ec2.Volume, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ec2.Volume
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*ec2.Volume, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.Volume, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*ec2.Volume); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Volume); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Volume)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllVpcEndpoints provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllVpcEndpoints(ctx context.Context) ([]*ec2.VpcEndpoint, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*ec2.VpcEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.VpcEndpoint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.VpcEndpoint); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.VpcEndpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllVpcPeeringConnections provides a mock function with given fields: ctx
+func (_m *MockEC2Repository) ListAllVpcPeeringConnections(ctx context.Context) ([]*ec2.VpcPeeringConnection, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*ec2.VpcPeeringConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ec2.VpcPeeringConnection, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.VpcPeeringConnection); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.VpcPeeringConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
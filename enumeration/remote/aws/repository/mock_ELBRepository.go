@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	elb "github.com/aws/aws-sdk-go/service/elb"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockELBRepository struct {
 	mock.Mock
 }
 
-// ListAllLoadBalancers provides a mock function with given fields:
-func (_m *MockELBRepository) ListAllLoadBalancers() ([]*elb.LoadBalancerDescription, error) {
-	ret := _m.Called()
+// ListAllLoadBalancers provides a mock function with given fields: ctx
+func (_m *MockELBRepository) ListAllLoadBalancers(ctx context.Context) ([]*elb.LoadBalancerDescription, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*elb.LoadBalancerDescription
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*elb.LoadBalancerDescription, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*elb.LoadBalancerDescription, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*elb.LoadBalancerDescription); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*elb.LoadBalancerDescription); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*elb.LoadBalancerDescription)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	elasticache "github.com/aws/aws-sdk-go/service/elasticache"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockElastiCacheRepository struct {
 	mock.Mock
 }
 
-// ListAllCacheClusters provides a mock function with given fields:
-func (_m *MockElastiCacheRepository) ListAllCacheClusters() ([]*elasticache.CacheCluster, error) {
-	ret := _m.Called()
+// ListAllCacheClusters provides a mock function with given fields: ctx
+func (_m *MockElastiCacheRepository) ListAllCacheClusters(ctx context.Context) ([]*elasticache.CacheCluster, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*elasticache.CacheCluster
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*elasticache.CacheCluster, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*elasticache.CacheCluster, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*elasticache.CacheCluster); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*elasticache.CacheCluster); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*elasticache.CacheCluster)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	iam "github.com/aws/aws-sdk-go/service/iam"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockIAMRepository struct {
 	mock.Mock
 }
 
-// ListAllAccessKeys provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKeyMetadata, error) {
-	ret := _m.Called(_a0)
+// ListAllAccessKeys provides a mock function with given fields: ctx, _a0
+func (_m *MockIAMRepository) ListAllAccessKeys(ctx context.Context, _a0 []*iam.User) ([]*iam.AccessKeyMetadata, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*iam.AccessKeyMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]*iam.User) ([]*iam.AccessKeyMetadata, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) ([]*iam.AccessKeyMetadata, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func([]*iam.User) []*iam.AccessKeyMetadata); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []*iam.AccessKeyMetadata); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.AccessKeyMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKe
 	return r0, r1
 }
 
-// ListAllGroupPolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllGroupPolicies(_a0 []*iam.Group) ([]string, error) {
-	ret := _m.Called(_a0)
+// ListAllGroupPolicies provides a mock function with given fields: ctx, _a0
+func (_m *MockIAMRepository) ListAllGroupPolicies(ctx context.Context, _a0 []*iam.Group) ([]string, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]*iam.Group) ([]string, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Group) ([]string, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func([]*iam.Group) []string); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Group) []string); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]*iam.Group) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Group) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +66,25 @@ func (_m *MockIAMRepository) ListAllGroupPolicies(_a0 []*iam.Group) ([]string, e
 	return r0, r1
 }
 
-// ListAllGroupPolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllGroupPolicyAttachments(_a0 []*iam.Group) ([]*AttachedGroupPolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllGroupPolicyAttachments provides a mock function with given fields: ctx, _a0
+func (_m *MockIAMRepository) ListAllGroupPolicyAttachments(ctx context.Context, _a0 []*iam.Group) ([]*AttachedGroupPolicy, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*AttachedGroupPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]*iam.Group) ([]*AttachedGroupPolicy, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Group) ([]*AttachedGroupPolicy, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func([]*iam.Group) []*AttachedGroupPolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Group) []*AttachedGroupPolicy); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedGroupPolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]*iam.Group) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Group) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,25 +92,25 @@ func (_m *MockIAMRepository) ListAllGroupPolicyAttachments(_a0 []*iam.Group) ([]
 	return r0, r1
 }
 
-// ListAllGroups provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllGroups() ([]*iam.Group, error) {
-	ret := _m.Called()
+// ListAllGroups provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllGroups(ctx context.Context) ([]*iam.Group, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.Group
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*iam.Group, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*iam.Group, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*iam.Group); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Group); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Group)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,25 +118,25 @@ func (_m *MockIAMRepository) ListAllGroups() ([]*iam.Group, error) {
 	return r0, r1
 }
 
-// ListAllPolicies provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
-	ret := _m.Called()
+// ListAllPolicies provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllPolicies(ctx context.Context) ([]*iam.Policy, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.Policy
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*iam.Policy, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*iam.Policy, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*iam.Policy); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Policy); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Policy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -142,25 +144,25 @@ func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	return r0, r1
 }
 
-// ListAllRolePolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]RolePolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllRolePolicies provides a mock function with given fields: ctx, _a0
+func (_m *MockIAMRepository) ListAllRolePolicies(ctx context.Context, _a0 []*iam.Role) ([]RolePolicy, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []RolePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]*iam.Role) ([]RolePolicy, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) ([]RolePolicy, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func([]*iam.Role) []RolePolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) []RolePolicy); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]RolePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]*iam.Role) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Role) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,25 +170,25 @@ func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]RolePolicy,
 	return r0, r1
 }
 
-// ListAllRolePolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*AttachedRolePolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllRolePolicyAttachments provides a mock function with given fields: ctx, _a0
+func (_m *MockIAMRepository) ListAllRolePolicyAttachments(ctx context.Context, _a0 []*iam.Role) ([]*AttachedRolePolicy, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*AttachedRolePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]*iam.Role) ([]*AttachedRolePolicy, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) ([]*AttachedRolePolicy, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func([]*iam.Role) []*AttachedRolePolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) []*AttachedRolePolicy); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedRolePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]*iam.Role) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Role) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -194,25 +196,25 @@ func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*A
 	return r0, r1
 }
 
-// ListAllRoles provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
-	ret := _m.Called()
+// ListAllRoles provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllRoles(ctx context.Context) ([]*iam.Role, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.Role
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*iam.Role, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*iam.Role, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*iam.Role); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Role); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Role)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,25 +222,25 @@ func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
 	return r0, r1
 }
 
-// ListAllUserPolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, error) {
-	ret := _m.Called(_a0)
+// ListAllUserPolicies provides a mock function with given fields: ctx, _a0
+func (_m *MockIAMRepository) ListAllUserPolicies(ctx context.Context, _a0 []*iam.User) ([]string, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]*iam.User) ([]string, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) ([]string, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func([]*iam.User) []string); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []string); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -246,25 +248,25 @@ func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, err
 	return r0, r1
 }
 
-// ListAllUserPolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*AttachedUserPolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllUserPolicyAttachments provides a mock function with given fields: ctx, _a0
+func (_m *MockIAMRepository) ListAllUserPolicyAttachments(ctx context.Context, _a0 []*iam.User) ([]*AttachedUserPolicy, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 []*AttachedUserPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]*iam.User) ([]*AttachedUserPolicy, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) ([]*AttachedUserPolicy, error)); ok {
+		return rf(ctx, _a0)
 	}
-	if rf, ok := ret.Get(0).(func([]*iam.User) []*AttachedUserPolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []*AttachedUserPolicy); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedUserPolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -272,25 +274,25 @@ func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*A
 	return r0, r1
 }
 
-// ListAllUsers provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllUsers() ([]*iam.User, error) {
-	ret := _m.Called()
+// ListAllUsers provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllUsers(ctx context.Context) ([]*iam.User, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*iam.User, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*iam.User, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*iam.User); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.User); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	s3control "github.com/aws/aws-sdk-go/service/s3control"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockS3ControlRepository struct {
 	mock.Mock
 }
 
-// DescribeAccountPublicAccessBlock provides a mock function with given fields: accountID
-func (_m *MockS3ControlRepository) DescribeAccountPublicAccessBlock(accountID string) (*s3control.PublicAccessBlockConfiguration, error) {
-	ret := _m.Called(accountID)
+// DescribeAccountPublicAccessBlock provides a mock function with given fields: ctx, accountID
+func (_m *MockS3ControlRepository) DescribeAccountPublicAccessBlock(ctx context.Context, accountID string) (*s3control.PublicAccessBlockConfiguration, error) {
+	ret := _m.Called(ctx, accountID)
 
 	var r0 *s3control.PublicAccessBlockConfiguration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*s3control.PublicAccessBlockConfiguration, error)); ok {
-		return rf(accountID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*s3control.PublicAccessBlockConfiguration, error)); ok {
+		return rf(ctx, accountID)
 	}
-	if rf, ok := ret.Get(0).(func(string) *s3control.PublicAccessBlockConfiguration); ok {
-		r0 = rf(accountID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *s3control.PublicAccessBlockConfiguration); ok {
+		r0 = rf(ctx, accountID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*s3control.PublicAccessBlockConfiguration)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(accountID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accountID)
 	} else {
 		r1 = ret.Error(1)
 	}
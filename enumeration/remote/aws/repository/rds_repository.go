@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
@@ -8,9 +9,9 @@ import (
 )
 
 type RDSRepository interface {
-	ListAllDBInstances() ([]*rds.DBInstance, error)
-	ListAllDBSubnetGroups() ([]*rds.DBSubnetGroup, error)
-	ListAllDBClusters() ([]*rds.DBCluster, error)
+	ListAllDBInstances(ctx context.Context) ([]*rds.DBInstance, error)
+	ListAllDBSubnetGroups(ctx context.Context) ([]*rds.DBSubnetGroup, error)
+	ListAllDBClusters(ctx context.Context) ([]*rds.DBCluster, error)
 }
 
 type rdsRepository struct {
@@ -25,14 +26,14 @@ func NewRDSRepository(session *session.Session, c cache.Cache) *rdsRepository {
 	}
 }
 
-func (r *rdsRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
+func (r *rdsRepository) ListAllDBInstances(ctx context.Context) ([]*rds.DBInstance, error) {
 	if v := r.cache.Get("rdsListAllDBInstances"); v != nil {
 		return v.([]*rds.DBInstance), nil
 	}
 
 	var result []*rds.DBInstance
 	input := &rds.DescribeDBInstancesInput{}
-	err := r.client.DescribeDBInstancesPages(input, func(res *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+	err := r.client.DescribeDBInstancesPagesWithContext(ctx, input, func(res *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 		result = append(result, res.DBInstances...)
 		return !lastPage
 	})
@@ -44,14 +45,14 @@ func (r *rdsRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
 	return result, nil
 }
 
-func (r *rdsRepository) ListAllDBSubnetGroups() ([]*rds.DBSubnetGroup, error) {
+func (r *rdsRepository) ListAllDBSubnetGroups(ctx context.Context) ([]*rds.DBSubnetGroup, error) {
 	if v := r.cache.Get("rdsListAllDBSubnetGroups"); v != nil {
 		return v.([]*rds.DBSubnetGroup), nil
 	}
 
 	var subnetGroups []*rds.DBSubnetGroup
 	input := rds.DescribeDBSubnetGroupsInput{}
-	err := r.client.DescribeDBSubnetGroupsPages(&input,
+	err := r.client.DescribeDBSubnetGroupsPagesWithContext(ctx, &input,
 		func(resp *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
 			subnetGroups = append(subnetGroups, resp.DBSubnetGroups...)
 			return !lastPage
@@ -62,7 +63,7 @@ func (r *rdsRepository) ListAllDBSubnetGroups() ([]*rds.DBSubnetGroup, error) {
 	return subnetGroups, err
 }
 
-func (r *rdsRepository) ListAllDBClusters() ([]*rds.DBCluster, error) {
+func (r *rdsRepository) ListAllDBClusters(ctx context.Context) ([]*rds.DBCluster, error) {
 	cacheKey := "rdsListAllDBClusters"
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*rds.DBCluster), nil
@@ -70,7 +71,7 @@ func (r *rdsRepository) ListAllDBClusters() ([]*rds.DBCluster, error) {
 
 	var clusters []*rds.DBCluster
 	input := rds.DescribeDBClustersInput{}
-	err := r.client.DescribeDBClustersPages(&input,
+	err := r.client.DescribeDBClustersPagesWithContext(ctx, &input,
 		func(resp *rds.DescribeDBClustersOutput, lastPage bool) bool {
 			clusters = append(clusters, resp.DBClusters...)
 			return !lastPage
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/snyk/driftctl/enumeration/remote/aws/client"
@@ -14,14 +15,14 @@ import (
 )
 
 type S3Repository interface {
-	ListAllBuckets() ([]*s3.Bucket, error)
-	GetBucketNotification(bucketName, region string) (*s3.NotificationConfiguration, error)
-	GetBucketPolicy(bucketName, region string) (*string, error)
-	GetBucketPublicAccessBlock(bucketName, region string) (*s3.PublicAccessBlockConfiguration, error)
-	ListBucketInventoryConfigurations(bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error)
-	ListBucketMetricsConfigurations(bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error)
-	ListBucketAnalyticsConfigurations(bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error)
-	GetBucketLocation(bucketName string) (string, error)
+	ListAllBuckets(ctx context.Context) ([]*s3.Bucket, error)
+	GetBucketNotification(ctx context.Context, bucketName, region string) (*s3.NotificationConfiguration, error)
+	GetBucketPolicy(ctx context.Context, bucketName, region string) (*string, error)
+	GetBucketPublicAccessBlock(ctx context.Context, bucketName, region string) (*s3.PublicAccessBlockConfiguration, error)
+	ListBucketInventoryConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error)
+	ListBucketMetricsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error)
+	ListBucketAnalyticsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error)
+	GetBucketLocation(ctx context.Context, bucketName string) (string, error)
 }
 
 type s3Repository struct {
@@ -36,7 +37,7 @@ func NewS3Repository(factory client.AwsClientFactoryInterface, c cache.Cache) *s
 	}
 }
 
-func (s *s3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
+func (s *s3Repository) ListAllBuckets(ctx context.Context) ([]*s3.Bucket, error) {
 	cacheKey := "s3ListAllBuckets"
 	v := s.cache.GetAndLock(cacheKey)
 	defer s.cache.Unlock(cacheKey)
@@ -44,7 +45,7 @@ func (s *s3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
 		return v.([]*s3.Bucket), nil
 	}
 
-	out, err := s.clientFactory.GetS3Client(nil).ListBuckets(&s3.ListBucketsInput{})
+	out, err := s.clientFactory.GetS3Client(nil).ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
 	if err != nil {
 		return nil, err
 	}
@@ -52,14 +53,14 @@ func (s *s3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
 	return out.Buckets, nil
 }
 
-func (s *s3Repository) GetBucketPolicy(bucketName, region string) (*string, error) {
+func (s *s3Repository) GetBucketPolicy(ctx context.Context, bucketName, region string) (*string, error) {
 	cacheKey := fmt.Sprintf("s3GetBucketPolicy_%s_%s", bucketName, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.(*string), nil
 	}
 	policy, err := s.clientFactory.
 		GetS3Client(&awssdk.Config{Region: &region}).
-		GetBucketPolicy(
+		GetBucketPolicyWithContext(ctx,
 			&s3.GetBucketPolicyInput{Bucket: &bucketName},
 		)
 	if err != nil {
@@ -84,14 +85,14 @@ func (s *s3Repository) GetBucketPolicy(bucketName, region string) (*string, erro
 	return result, nil
 }
 
-func (s *s3Repository) GetBucketPublicAccessBlock(bucketName, region string) (*s3.PublicAccessBlockConfiguration, error) {
+func (s *s3Repository) GetBucketPublicAccessBlock(ctx context.Context, bucketName, region string) (*s3.PublicAccessBlockConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3GetBucketPublicAccessBlock_%s_%s", bucketName, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.(*s3.PublicAccessBlockConfiguration), nil
 	}
 	response, err := s.clientFactory.
 		GetS3Client(&awssdk.Config{Region: &region}).
-		GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{Bucket: &bucketName})
+		GetPublicAccessBlockWithContext(ctx, &s3.GetPublicAccessBlockInput{Bucket: &bucketName})
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -112,14 +113,14 @@ func (s *s3Repository) GetBucketPublicAccessBlock(bucketName, region string) (*s
 	return result, nil
 }
 
-func (s *s3Repository) GetBucketNotification(bucketName, region string) (*s3.NotificationConfiguration, error) {
+func (s *s3Repository) GetBucketNotification(ctx context.Context, bucketName, region string) (*s3.NotificationConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3GetBucketNotification_%s_%s", bucketName, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.(*s3.NotificationConfiguration), nil
 	}
 	bucketNotificationConfig, err := s.clientFactory.
 		GetS3Client(&awssdk.Config{Region: &region}).
-		GetBucketNotificationConfiguration(
+		GetBucketNotificationConfigurationWithContext(ctx,
 			&s3.GetBucketNotificationConfigurationRequest{Bucket: &bucketName},
 		)
 	if err != nil {
@@ -145,7 +146,7 @@ func (s *s3Repository) notificationIsEmpty(notification *s3.NotificationConfigur
 		notification.LambdaFunctionConfigurations == nil
 }
 
-func (s *s3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
+func (s *s3Repository) ListBucketInventoryConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3ListBucketInventoryConfigurations_%s_%s", *bucket.Name, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*s3.InventoryConfiguration), nil
@@ -159,7 +160,7 @@ func (s *s3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, regi
 	}
 
 	for {
-		configurations, err := s3client.ListBucketInventoryConfigurations(request)
+		configurations, err := s3client.ListBucketInventoryConfigurationsWithContext(ctx, request)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
@@ -179,7 +180,7 @@ func (s *s3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, regi
 	return inventoryConfigurations, nil
 }
 
-func (s *s3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
+func (s *s3Repository) ListBucketMetricsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3ListBucketMetricsConfigurations_%s_%s", *bucket.Name, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*s3.MetricsConfiguration), nil
@@ -193,7 +194,7 @@ func (s *s3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region
 	}
 
 	for {
-		configurations, err := s3client.ListBucketMetricsConfigurations(request)
+		configurations, err := s3client.ListBucketMetricsConfigurationsWithContext(ctx, request)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
@@ -213,7 +214,7 @@ func (s *s3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region
 	return metricsConfigurationList, nil
 }
 
-func (s *s3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
+func (s *s3Repository) ListBucketAnalyticsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3ListBucketAnalyticsConfigurations_%s_%s", *bucket.Name, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*s3.AnalyticsConfiguration), nil
@@ -227,7 +228,7 @@ func (s *s3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, regi
 	}
 
 	for {
-		configurations, err := s3client.ListBucketAnalyticsConfigurations(request)
+		configurations, err := s3client.ListBucketAnalyticsConfigurationsWithContext(ctx, request)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
@@ -248,7 +249,7 @@ func (s *s3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, regi
 	return analyticsConfigurationList, nil
 }
 
-func (s *s3Repository) GetBucketLocation(bucketName string) (string, error) {
+func (s *s3Repository) GetBucketLocation(ctx context.Context, bucketName string) (string, error) {
 	cacheKey := fmt.Sprintf("s3GetBucketLocation_%s", bucketName)
 	v := s.cache.GetAndLock(cacheKey)
 	defer s.cache.Unlock(cacheKey)
@@ -257,7 +258,7 @@ func (s *s3Repository) GetBucketLocation(bucketName string) (string, error) {
 	}
 
 	bucketLocationRequest := s3.GetBucketLocationInput{Bucket: &bucketName}
-	bucketLocationResponse, err := s.clientFactory.GetS3Client(nil).GetBucketLocation(&bucketLocationRequest)
+	bucketLocationResponse, err := s.clientFactory.GetS3Client(nil).GetBucketLocationWithContext(ctx, &bucketLocationRequest)
 	if err != nil {
 		awsErr, ok := err.(awserr.Error)
 		if ok && awsErr.Code() == s3.ErrCodeNoSuchBucket {
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// SecretsManagerRepository only reads the metadata of secrets, secret values are never fetched
+type SecretsManagerRepository interface {
+	ListAllSecrets(ctx context.Context) ([]*secretsmanager.SecretListEntry, error)
+	GetSecretPolicy(ctx context.Context, secretArn string) (*string, error)
+}
+
+type secretsManagerRepository struct {
+	client secretsmanageriface.SecretsManagerAPI
+	cache  cache.Cache
+}
+
+func NewSecretsManagerRepository(session *session.Session, c cache.Cache) *secretsManagerRepository {
+	return &secretsManagerRepository{
+		secretsmanager.New(session),
+		c,
+	}
+}
+
+func (r *secretsManagerRepository) ListAllSecrets(ctx context.Context) ([]*secretsmanager.SecretListEntry, error) {
+	cacheKey := "secretsManagerListAllSecrets"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*secretsmanager.SecretListEntry), nil
+	}
+
+	var secrets []*secretsmanager.SecretListEntry
+	err := r.client.ListSecretsPagesWithContext(ctx, &secretsmanager.ListSecretsInput{},
+		func(resp *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+			secrets = append(secrets, resp.SecretList...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, secrets)
+	return secrets, nil
+}
+
+func (r *secretsManagerRepository) GetSecretPolicy(ctx context.Context, secretArn string) (*string, error) {
+	cacheKey := fmt.Sprintf("secretsManagerGetSecretPolicy_%s", secretArn)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.(*string), nil
+	}
+
+	resp, err := r.client.GetResourcePolicyWithContext(ctx, &secretsmanager.GetResourcePolicyInput{
+		SecretId: aws.String(secretArn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, resp.ResourcePolicy)
+	return resp.ResourcePolicy, nil
+}
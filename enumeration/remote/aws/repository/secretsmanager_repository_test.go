@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_secretsManagerRepository_ListAllSecrets(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSecretsManager)
+		want    []*secretsmanager.SecretListEntry
+		wantErr error
+	}{
+		{
+			name: "List secrets with 2 pages",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("ListSecretsPagesWithContext", mock.Anything,
+					&secretsmanager.ListSecretsInput{},
+					mock.MatchedBy(func(callback func(res *secretsmanager.ListSecretsOutput, lastPage bool) bool) bool {
+						callback(&secretsmanager.ListSecretsOutput{SecretList: []*secretsmanager.SecretListEntry{
+							{Name: aws.String("foo")},
+						}}, false)
+						callback(&secretsmanager.ListSecretsOutput{SecretList: []*secretsmanager.SecretListEntry{
+							{Name: aws.String("bar")},
+						}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*secretsmanager.SecretListEntry{
+				{Name: aws.String("foo")},
+				{Name: aws.String("bar")},
+			},
+		},
+		{
+			name: "Error listing secrets",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("ListSecretsPagesWithContext", mock.Anything,
+					&secretsmanager.ListSecretsInput{},
+					mock.AnythingOfType("func(*secretsmanager.ListSecretsOutput, bool) bool")).Return(dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSecretsManager{}
+			tt.mocks(&client)
+			r := &secretsManagerRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllSecrets(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllSecrets(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*secretsmanager.SecretListEntry{}, store.Get("secretsManagerListAllSecrets"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_secretsManagerRepository_GetSecretPolicy(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	secretArn := "arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf"
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSecretsManager)
+		want    *string
+		wantErr error
+	}{
+		{
+			name: "Get secret policy",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("GetResourcePolicyWithContext", mock.Anything, &secretsmanager.GetResourcePolicyInput{SecretId: aws.String(secretArn)}).Return(&secretsmanager.GetResourcePolicyOutput{
+					ARN:            aws.String(secretArn),
+					ResourcePolicy: aws.String("{\"Version\":\"2012-10-17\"}"),
+				}, nil).Once()
+			},
+			want: aws.String("{\"Version\":\"2012-10-17\"}"),
+		},
+		{
+			name: "Error getting secret policy",
+			mocks: func(client *awstest.MockFakeSecretsManager) {
+				client.On("GetResourcePolicyWithContext", mock.Anything, &secretsmanager.GetResourcePolicyInput{SecretId: aws.String(secretArn)}).Return(nil, dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSecretsManager{}
+			tt.mocks(&client)
+			r := &secretsManagerRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.GetSecretPolicy(context.Background(), secretArn)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.GetSecretPolicy(context.Background(), secretArn)
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, aws.String(""), store.Get("secretsManagerGetSecretPolicy_"+secretArn))
+			}
+
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
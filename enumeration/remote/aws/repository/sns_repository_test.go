@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -26,7 +27,7 @@ func Test_snsRepository_ListAllTopics(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeSNS) {
-				client.On("ListTopicsPages",
+				client.On("ListTopicsPagesWithContext", mock.Anything,
 					&sns.ListTopicsInput{},
 					mock.MatchedBy(func(callback func(res *sns.ListTopicsOutput, lastPage bool) bool) bool {
 						callback(&sns.ListTopicsOutput{
@@ -65,12 +66,12 @@ func Test_snsRepository_ListAllTopics(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllTopics()
+			got, err := r.ListAllTopics(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllTopics()
+				cachedData, err := r.ListAllTopics(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*sns.Topic{}, store.Get("snsListAllTopics"))
@@ -98,7 +99,7 @@ func Test_snsRepository_ListAllSubscriptions(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeSNS) {
-				client.On("ListSubscriptionsPages",
+				client.On("ListSubscriptionsPagesWithContext", mock.Anything,
 					&sns.ListSubscriptionsInput{},
 					mock.MatchedBy(func(callback func(res *sns.ListSubscriptionsOutput, lastPage bool) bool) bool {
 						callback(&sns.ListSubscriptionsOutput{
@@ -137,12 +138,12 @@ func Test_snsRepository_ListAllSubscriptions(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllSubscriptions()
+			got, err := r.ListAllSubscriptions(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllSubscriptions()
+				cachedData, err := r.ListAllSubscriptions(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*sns.Subscription{}, store.Get("snsListAllSubscriptions"))
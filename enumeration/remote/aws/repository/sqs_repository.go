@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -11,8 +12,8 @@ import (
 )
 
 type SQSRepository interface {
-	ListAllQueues() ([]*string, error)
-	GetQueueAttributes(url string) (*sqs.GetQueueAttributesOutput, error)
+	ListAllQueues(ctx context.Context) ([]*string, error)
+	GetQueueAttributes(ctx context.Context, url string) (*sqs.GetQueueAttributesOutput, error)
 }
 
 type sqsRepository struct {
@@ -27,13 +28,13 @@ func NewSQSRepository(session *session.Session, c cache.Cache) *sqsRepository {
 	}
 }
 
-func (r *sqsRepository) GetQueueAttributes(url string) (*sqs.GetQueueAttributesOutput, error) {
+func (r *sqsRepository) GetQueueAttributes(ctx context.Context, url string) (*sqs.GetQueueAttributesOutput, error) {
 	cacheKey := fmt.Sprintf("sqsGetQueueAttributes_%s", url)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.(*sqs.GetQueueAttributesOutput), nil
 	}
 
-	attributes, err := r.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+	attributes, err := r.client.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
 		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNamePolicy}),
 		QueueUrl:       &url,
 	})
@@ -46,7 +47,7 @@ func (r *sqsRepository) GetQueueAttributes(url string) (*sqs.GetQueueAttributesO
 	return attributes, nil
 }
 
-func (r *sqsRepository) ListAllQueues() ([]*string, error) {
+func (r *sqsRepository) ListAllQueues(ctx context.Context) ([]*string, error) {
 
 	cacheKey := "sqsListAllQueues"
 	v := r.cache.GetAndLock(cacheKey)
@@ -57,7 +58,7 @@ func (r *sqsRepository) ListAllQueues() ([]*string, error) {
 
 	var queues []*string
 	input := sqs.ListQueuesInput{}
-	err := r.client.ListQueuesPages(&input,
+	err := r.client.ListQueuesPagesWithContext(ctx, &input,
 		func(resp *sqs.ListQueuesOutput, lastPage bool) bool {
 			queues = append(queues, resp.QueueUrls...)
 			return !lastPage
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// SSMRepository only reads the metadata of parameters, parameter values are never fetched
+type SSMRepository interface {
+	ListAllParameters(ctx context.Context) ([]*ssm.ParameterMetadata, error)
+}
+
+type ssmRepository struct {
+	client ssmiface.SSMAPI
+	cache  cache.Cache
+}
+
+func NewSSMRepository(session *session.Session, c cache.Cache) *ssmRepository {
+	return &ssmRepository{
+		ssm.New(session),
+		c,
+	}
+}
+
+func (r *ssmRepository) ListAllParameters(ctx context.Context) ([]*ssm.ParameterMetadata, error) {
+	if v := r.cache.Get("ssmListAllParameters"); v != nil {
+		return v.([]*ssm.ParameterMetadata), nil
+	}
+
+	var parameters []*ssm.ParameterMetadata
+	err := r.client.DescribeParametersPagesWithContext(ctx, &ssm.DescribeParametersInput{},
+		func(resp *ssm.DescribeParametersOutput, lastPage bool) bool {
+			parameters = append(parameters, resp.Parameters...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ssmListAllParameters", parameters)
+	return parameters, nil
+}
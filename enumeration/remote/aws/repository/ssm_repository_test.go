@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ssmRepository_ListAllParameters(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSSM)
+		want    []*ssm.ParameterMetadata
+		wantErr error
+	}{
+		{
+			name: "List parameters with 2 pages",
+			mocks: func(client *awstest.MockFakeSSM) {
+				client.On("DescribeParametersPagesWithContext", mock.Anything,
+					&ssm.DescribeParametersInput{},
+					mock.MatchedBy(func(callback func(res *ssm.DescribeParametersOutput, lastPage bool) bool) bool {
+						callback(&ssm.DescribeParametersOutput{Parameters: []*ssm.ParameterMetadata{
+							{Name: aws.String("/app/url")},
+						}}, false)
+						callback(&ssm.DescribeParametersOutput{Parameters: []*ssm.ParameterMetadata{
+							{Name: aws.String("/app/password"), Type: aws.String(ssm.ParameterTypeSecureString)},
+						}}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*ssm.ParameterMetadata{
+				{Name: aws.String("/app/url")},
+				{Name: aws.String("/app/password"), Type: aws.String(ssm.ParameterTypeSecureString)},
+			},
+		},
+		{
+			name: "Error listing parameters",
+			mocks: func(client *awstest.MockFakeSSM) {
+				client.On("DescribeParametersPagesWithContext", mock.Anything,
+					&ssm.DescribeParametersInput{},
+					mock.AnythingOfType("func(*ssm.DescribeParametersOutput, bool) bool")).Return(dummyError).Once()
+			},
+			wantErr: dummyError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := awstest.MockFakeSSM{}
+			tt.mocks(&client)
+			r := &ssmRepository{
+				client: &client,
+				cache:  store,
+			}
+			got, err := r.ListAllParameters(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllParameters(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*ssm.ParameterMetadata{}, store.Get("ssmListAllParameters"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
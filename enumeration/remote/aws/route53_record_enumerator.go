@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strconv"
@@ -26,9 +28,9 @@ func (e *Route53RecordEnumerator) SupportedType() resource.ResourceType {
 	return resourceaws.AwsRoute53RecordResourceType
 }
 
-func (e *Route53RecordEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *Route53RecordEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 
-	zones, err := e.client.ListAllZones()
+	zones, err := e.client.ListAllZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsRoute53ZoneResourceType)
 	}
@@ -36,7 +38,7 @@ func (e *Route53RecordEnumerator) Enumerate() ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0, len(zones))
 
 	for _, hostedZone := range zones {
-		records, err := e.listRecordsForZone(strings.TrimPrefix(*hostedZone.Id, "/hostedzone/"))
+		records, err := e.listRecordsForZone(ctx, strings.TrimPrefix(*hostedZone.Id, "/hostedzone/"))
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -47,9 +49,9 @@ func (e *Route53RecordEnumerator) Enumerate() ([]*resource.Resource, error) {
 	return results, err
 }
 
-func (e *Route53RecordEnumerator) listRecordsForZone(zoneId string) ([]*resource.Resource, error) {
+func (e *Route53RecordEnumerator) listRecordsForZone(ctx context.Context, zoneId string) ([]*resource.Resource, error) {
 
-	records, err := e.client.ListRecordsForZone(zoneId)
+	records, err := e.client.ListRecordsForZone(ctx, zoneId)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -25,8 +27,8 @@ func (e *Route53ZoneSupplier) SupportedType() resource.ResourceType {
 	return resourceaws.AwsRoute53ZoneResourceType
 }
 
-func (e *Route53ZoneSupplier) Enumerate() ([]*resource.Resource, error) {
-	zones, err := e.client.ListAllZones()
+func (e *Route53ZoneSupplier) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	zones, err := e.client.ListAllZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SecretsManagerSecretRotationEnumerator struct {
+	repository repository.SecretsManagerRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSecretsManagerSecretRotationEnumerator(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) *SecretsManagerSecretRotationEnumerator {
+	return &SecretsManagerSecretRotationEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SecretsManagerSecretRotationEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSecretsManagerSecretRotationResourceType
+}
+
+func (e *SecretsManagerSecretRotationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	secrets, err := e.repository.ListAllSecrets(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsSecretsManagerSecretResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, secret := range secrets {
+		if secret.RotationEnabled == nil || !*secret.RotationEnabled {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*secret.ARN,
+				map[string]interface{}{
+					"secret_id": *secret.ARN,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *SNSTopicPolicyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsSnsTopicPolicyResourceType
 }
 
-func (e *SNSTopicPolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	topics, err := e.repository.ListAllTopics()
+func (e *SNSTopicPolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	topics, err := e.repository.ListAllTopics(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsSnsTopicResourceType)
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -29,8 +31,8 @@ func (e *SQSQueuePolicyEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsSqsQueuePolicyResourceType
 }
 
-func (e *SQSQueuePolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	queues, err := e.repository.ListAllQueues()
+func (e *SQSQueuePolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	queues, err := e.repository.ListAllQueues(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsSqsQueueResourceType)
 	}
@@ -41,7 +43,7 @@ func (e *SQSQueuePolicyEnumerator) Enumerate() ([]*resource.Resource, error) {
 		attrs := map[string]interface{}{
 			"policy": "",
 		}
-		attributes, err := e.repository.GetQueueAttributes(*queue)
+		attributes, err := e.repository.GetQueueAttributes(ctx, *queue)
 		if err != nil {
 			if strings.Contains(err.Error(), "NonExistentQueue") {
 				logrus.WithFields(logrus.Fields{
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -25,8 +27,8 @@ func (e *VPCDefaultSecurityGroupEnumerator) SupportedType() resource.ResourceTyp
 	return resourceaws.AwsDefaultSecurityGroupResourceType
 }
 
-func (e *VPCDefaultSecurityGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	_, defaultSecurityGroups, err := e.repository.ListAllSecurityGroups()
+func (e *VPCDefaultSecurityGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	_, defaultSecurityGroups, err := e.repository.ListAllSecurityGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
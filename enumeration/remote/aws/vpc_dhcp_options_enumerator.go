@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type VPCDhcpOptionsEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewVPCDhcpOptionsEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *VPCDhcpOptionsEnumerator {
+	return &VPCDhcpOptionsEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *VPCDhcpOptionsEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcDhcpOptionsResourceType
+}
+
+func (e *VPCDhcpOptionsEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	dhcpOptions, err := e.repository.ListAllDhcpOptions(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(dhcpOptions))
+
+	for _, options := range dhcpOptions {
+		attrs := map[string]interface{}{}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*options.DhcpOptionsId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
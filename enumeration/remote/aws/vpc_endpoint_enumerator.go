@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type VPCEndpointEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewVPCEndpointEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *VPCEndpointEnumerator {
+	return &VPCEndpointEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *VPCEndpointEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcEndpointResourceType
+}
+
+func (e *VPCEndpointEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	endpoints, err := e.repository.ListAllVpcEndpoints(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		state := awssdk.StringValue(endpoint.State)
+		if state == ec2.StateDeleting || state == ec2.StateDeleted {
+			continue
+		}
+
+		attrs := map[string]interface{}{
+			"vpc_id":            awssdk.StringValue(endpoint.VpcId),
+			"service_name":      awssdk.StringValue(endpoint.ServiceName),
+			"vpc_endpoint_type": awssdk.StringValue(endpoint.VpcEndpointType),
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*endpoint.VpcEndpointId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource/aws"
@@ -24,8 +26,8 @@ func (e *VPCEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsVpcResourceType
 }
 
-func (e *VPCEnumerator) Enumerate() ([]*resource.Resource, error) {
-	VPCs, _, err := e.repo.ListAllVPCs()
+func (e *VPCEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	VPCs, _, err := e.repo.ListAllVPCs(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type VPCPeeringConnectionEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewVPCPeeringConnectionEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *VPCPeeringConnectionEnumerator {
+	return &VPCPeeringConnectionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *VPCPeeringConnectionEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcPeeringConnectionResourceType
+}
+
+func (e *VPCPeeringConnectionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	connections, err := e.repository.ListAllVpcPeeringConnections(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(connections))
+
+	for _, connection := range connections {
+		if connection.Status != nil && isVpcPeeringConnectionGone(awssdk.StringValue(connection.Status.Code)) {
+			continue
+		}
+
+		attrs := map[string]interface{}{}
+		if connection.RequesterVpcInfo != nil {
+			attrs["vpc_id"] = awssdk.StringValue(connection.RequesterVpcInfo.VpcId)
+		}
+		if connection.AccepterVpcInfo != nil {
+			attrs["peer_vpc_id"] = awssdk.StringValue(connection.AccepterVpcInfo.VpcId)
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*connection.VpcPeeringConnectionId,
+				attrs,
+			),
+		)
+	}
+
+	return results, err
+}
+
+// Peering connections stay visible for a while once they are deleted, rejected or expired
+func isVpcPeeringConnectionGone(status string) bool {
+	switch status {
+	case ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+		ec2.VpcPeeringConnectionStateReasonCodeDeleting,
+		ec2.VpcPeeringConnectionStateReasonCodeRejected,
+		ec2.VpcPeeringConnectionStateReasonCodeFailed,
+		ec2.VpcPeeringConnectionStateReasonCodeExpired:
+		return true
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -25,8 +27,8 @@ func (e *VPCSecurityGroupEnumerator) SupportedType() resource.ResourceType {
 	return resourceaws.AwsSecurityGroupResourceType
 }
 
-func (e *VPCSecurityGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	securityGroups, _, err := e.repository.ListAllSecurityGroups()
+func (e *VPCSecurityGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	securityGroups, _, err := e.repository.ListAllSecurityGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
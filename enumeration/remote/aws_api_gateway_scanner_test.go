@@ -34,7 +34,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "no api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{}, nil)
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,7 +43,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "multiple api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("3of73v5ob4")},
 					{Id: awssdk.String("1jitcobwol")},
 				}, nil)
@@ -61,7 +61,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "cannot list api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return(nil, dummyError)
+				repository.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -113,7 +113,7 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "no api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(nil, nil)
+				repository.On("GetAccount", mock.Anything).Return(nil, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -122,7 +122,7 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "empty api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(&apigateway.Account{}, nil)
+				repository.On("GetAccount", mock.Anything).Return(&apigateway.Account{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -134,7 +134,7 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "cannot get api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(nil, dummyError)
+				repository.On("GetAccount", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayAccountResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAccountResourceType, resourceaws.AwsApiGatewayAccountResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAccountResourceType),
@@ -186,7 +186,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "no api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return([]*apigateway.ApiKey{}, nil)
+				repository.On("ListAllApiKeys", mock.Anything).Return([]*apigateway.ApiKey{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -195,7 +195,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "multiple api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return([]*apigateway.ApiKey{
+				repository.On("ListAllApiKeys", mock.Anything).Return([]*apigateway.ApiKey{
 					{Id: awssdk.String("fuwnl8lrva")},
 					{Id: awssdk.String("9ge737dd45")},
 				}, nil)
@@ -213,7 +213,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "cannot list api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return(nil, dummyError)
+				repository.On("ListAllApiKeys", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayApiKeyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayApiKeyResourceType, resourceaws.AwsApiGatewayApiKeyResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayApiKeyResourceType),
@@ -269,9 +269,9 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "no api gateway authorizers",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return([]*apigateway.Authorizer{}, nil).Once()
-				repo.On("ListAllRestApiAuthorizers", *apis[1].Id).Return([]*apigateway.Authorizer{}, nil).Once()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return([]*apigateway.Authorizer{}, nil).Once()
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[1].Id).Return([]*apigateway.Authorizer{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -280,11 +280,11 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "multiple api gateway authorizers",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return([]*apigateway.Authorizer{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return([]*apigateway.Authorizer{
 					{Id: awssdk.String("ypcpde")},
 				}, nil).Once()
-				repo.On("ListAllRestApiAuthorizers", *apis[1].Id).Return([]*apigateway.Authorizer{
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[1].Id).Return([]*apigateway.Authorizer{
 					{Id: awssdk.String("bwhebj")},
 				}, nil).Once()
 			},
@@ -301,7 +301,7 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -309,8 +309,8 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayAuthorizerResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType),
@@ -365,8 +365,8 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "no api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -375,8 +375,8 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "multiple api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo")},
 					{StageName: awssdk.String("baz")},
 				}, nil)
@@ -394,7 +394,7 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -402,8 +402,8 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "cannot list api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayStageResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayStageResourceType),
@@ -458,8 +458,8 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "no api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -468,8 +468,8 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "multiple api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("21zk4y"), Path: awssdk.String("/")},
 					{Id: awssdk.String("2ltv32p058"), Path: awssdk.String("/")},
 				}, nil)
@@ -487,7 +487,7 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -495,8 +495,8 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayResourceResourceType),
@@ -548,7 +548,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "no api gateway domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -557,7 +557,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "single api gateway domain name",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example-driftctl.com")},
 				}, nil)
 			},
@@ -571,7 +571,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "cannot list api gateway domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return(nil, dummyError)
+				repository.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayDomainNameResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType, resourceaws.AwsApiGatewayDomainNameResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType),
@@ -623,7 +623,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "no api gateway vpc links",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigateway.UpdateVpcLinkOutput{}, nil)
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigateway.UpdateVpcLinkOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -632,7 +632,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "single api gateway vpc link",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigateway.UpdateVpcLinkOutput{
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigateway.UpdateVpcLinkOutput{
 					{Id: awssdk.String("ipu24n")},
 				}, nil)
 			},
@@ -646,7 +646,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "cannot list api gateway vpc links",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return(nil, dummyError)
+				repository.On("ListAllVpcLinks", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayVpcLinkResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayVpcLinkResourceType, resourceaws.AwsApiGatewayVpcLinkResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayVpcLinkResourceType),
@@ -701,8 +701,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "no api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -711,8 +711,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "multiple api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{
 					{Id: awssdk.String("ywlcuf")},
 					{Id: awssdk.String("qmpbs8")},
 				}, nil)
@@ -730,7 +730,7 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -738,8 +738,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "cannot list api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRequestValidatorResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType),
@@ -791,7 +791,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "no api gateway rest api policies",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("3of73v5ob4")},
 					{Id: awssdk.String("9x7kq9pbyh"), Policy: awssdk.String("")},
 				}, nil)
@@ -803,7 +803,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "multiple api gateway rest api policies",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("c3n3aqga5d"), Policy: awssdk.String("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"},\"Action\":\"execute-api:Invoke\",\"Resource\":\"arn:aws:execute-api:us-east-1:111111111111:c3n3aqga5d/*\",\"Condition\":{\"IpAddress\":{\"aws:SourceIp\":\"123.123.123.123/32\"}}}]}")},
 					{Id: awssdk.String("9y1eus3hr7"), Policy: awssdk.String("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"},\"Action\":\"execute-api:Invoke\",\"Resource\":\"arn:aws:execute-api:us-east-1:111111111111:9y1eus3hr7/*\",\"Condition\":{\"IpAddress\":{\"aws:SourceIp\":\"123.123.123.123/32\"}}}]}")},
 				}, nil)
@@ -821,7 +821,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiPolicyResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiPolicyResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -876,8 +876,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "no domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{}, nil)
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -886,8 +886,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "multiple domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{
 					{BasePath: awssdk.String("foo")},
 					{BasePath: awssdk.String("(none)")},
 				}, nil)
@@ -905,7 +905,7 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "cannot list domain names",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(nil, dummyError)
+				repo.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType),
@@ -913,8 +913,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "cannot list domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return(nil, dummyError)
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayBasePathMappingResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType),
@@ -969,8 +969,8 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "no api gateway methods",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo")},
 				}, nil)
 			},
@@ -981,8 +981,8 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "multiple api gateway methods",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET":    {},
 						"POST":   {},
@@ -1006,7 +1006,7 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1014,8 +1014,8 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -1070,8 +1070,8 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "no api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return([]*apigateway.Model{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return([]*apigateway.Model{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1080,8 +1080,8 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "multiple api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return([]*apigateway.Model{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return([]*apigateway.Model{
 					{Id: awssdk.String("g68a4s")},
 					{Id: awssdk.String("85v536")},
 				}, nil)
@@ -1099,7 +1099,7 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1107,8 +1107,8 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "cannot list api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayModelResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayModelResourceType),
@@ -1163,8 +1163,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "no api gateway method responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {},
 					}},
@@ -1177,8 +1177,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "multiple api gateway method responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {MethodResponses: map[string]*apigateway.MethodResponse{
 							"200": {},
@@ -1204,7 +1204,7 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1212,8 +1212,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -1268,8 +1268,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "no api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1278,8 +1278,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "multiple api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{
 					{ResponseType: awssdk.String("UNAUTHORIZED")},
 					{ResponseType: awssdk.String("ACCESS_DENIED")},
 				}, nil)
@@ -1297,7 +1297,7 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1305,8 +1305,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayGatewayResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType),
@@ -1361,8 +1361,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "no api gateway method settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo"), MethodSettings: map[string]*apigateway.MethodSetting{}},
 				}, nil)
 			},
@@ -1373,8 +1373,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "multiple api gateway method settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo"), MethodSettings: map[string]*apigateway.MethodSetting{
 						"*/*":        {},
 						"foo/GET":    {},
@@ -1398,7 +1398,7 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1406,8 +1406,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "cannot list api gateway settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayStageResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayStageResourceType),
@@ -1462,8 +1462,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "no api gateway integrations",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo")},
 				}, nil)
 			},
@@ -1474,8 +1474,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "multiple api gateway integrations",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET":    {},
 						"POST":   {},
@@ -1499,7 +1499,7 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1507,8 +1507,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -1563,8 +1563,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "no api gateway integration responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {},
 					}},
@@ -1577,8 +1577,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "multiple api gateway integration responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {
 							MethodIntegration: &apigateway.Integration{
@@ -1604,7 +1604,7 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1612,8 +1612,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -35,7 +35,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +44,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "single api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("f5vdrg12tk")},
 				}, nil)
 			},
@@ -58,7 +58,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ApiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -110,7 +110,7 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -119,10 +119,10 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single route",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("a-gateway")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Route{{
 						RouteId:  awssdk.String("a-route"),
 						RouteKey: awssdk.String("POST /an-example"),
@@ -143,7 +143,7 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -195,10 +195,10 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single deployment",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Deployment{{
 						DeploymentId: awssdk.String("a-deployment"),
 					}}, nil)
@@ -215,7 +215,7 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "no API gateways",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -224,10 +224,10 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "single API gateway with no deployments",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Deployment{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -237,7 +237,7 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "error listing API gateways",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -245,10 +245,10 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "error listing deployments of an API",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).Return(nil, dummyError)
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2DeploymentResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2DeploymentResourceType),
@@ -300,7 +300,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "no api gateway v2 vpc links",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigatewayv2.VpcLink{}, nil)
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigatewayv2.VpcLink{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -309,7 +309,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "single api gateway v2 vpc link",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigatewayv2.VpcLink{
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigatewayv2.VpcLink{
 					{VpcLinkId: awssdk.String("b8r351")},
 				}, nil)
 			},
@@ -323,7 +323,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 vpc links",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return(nil, dummyError)
+				repository.On("ListAllVpcLinks", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2VpcLinkResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2VpcLinkResourceType, resourceaws.AwsApiGatewayV2VpcLinkResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2VpcLinkResourceType),
@@ -380,9 +380,9 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "no api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
-				repo.On("ListAllApiAuthorizers", *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -391,11 +391,11 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "multiple api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{
 					{AuthorizerId: awssdk.String("xaappu")},
 				}, nil).Once()
-				repo.On("ListAllApiAuthorizers", *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{
 					{AuthorizerId: awssdk.String("bwhebj")},
 				}, nil).Once()
 			},
@@ -412,7 +412,7 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -420,8 +420,8 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2AuthorizerResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType),
@@ -478,9 +478,9 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "no api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
-				repo.On("ListAllApiIntegrations", *apis[1].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -489,14 +489,14 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "multiple api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return([]*apigatewayv2.Integration{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Integration{
 					{
 						IntegrationId:   awssdk.String("xaappu"),
 						IntegrationType: awssdk.String("MOCK"),
 					},
 				}, nil).Once()
-				repo.On("ListAllApiIntegrations", *apis[1].ApiId).Return([]*apigatewayv2.Integration{
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Integration{
 					{
 						IntegrationId:   awssdk.String("bwhebj"),
 						IntegrationType: awssdk.String("MOCK"),
@@ -516,7 +516,7 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -524,8 +524,8 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2IntegrationResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType),
@@ -582,9 +582,9 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "no api gateway v2 models",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
-				repo.On("ListAllApiModels", *apis[1].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
+				repo.On("ListAllApiModels", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -593,14 +593,14 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "multiple api gateway v2 models",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return([]*apigatewayv2.Model{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Model{
 					{
 						ModelId: awssdk.String("vdw6up"),
 						Name:    awssdk.String("model1"),
 					},
 				}, nil).Once()
-				repo.On("ListAllApiModels", *apis[1].ApiId).Return([]*apigatewayv2.Model{
+				repo.On("ListAllApiModels", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Model{
 					{
 						ModelId: awssdk.String("bwhebj"),
 						Name:    awssdk.String("model2"),
@@ -623,7 +623,7 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -631,8 +631,8 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 model",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ModelResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType),
@@ -684,7 +684,7 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -693,10 +693,10 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single stage",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiStages", "a-gateway").
+				repository.On("ListAllApiStages", mock.Anything, "a-gateway").
 					Return([]*apigatewayv2.Stage{{
 						StageName: awssdk.String("a-stage"),
 					}}, nil)
@@ -711,7 +711,7 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2StageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2StageResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2StageResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -763,14 +763,14 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "no api gateway v2 route responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").
 					Return([]*apigatewayv2.RouteResponse{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -780,14 +780,14 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "single api gateway v2 route with one route response",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").
 					Return([]*apigatewayv2.RouteResponse{
 						{RouteResponseId: awssdk.String("nbw7vw")},
 					}, nil)
@@ -802,7 +802,7 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -810,10 +810,10 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 routes",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).Return(nil, dummyError)
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResourceType),
@@ -821,14 +821,14 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 route responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").Return(nil, dummyError)
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType),
@@ -880,7 +880,7 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "no api gateway v2 domains",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -889,10 +889,10 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "single api gateway v2 domain with a single mapping",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return([]*apigatewayv2.ApiMapping{{
 						Stage:        awssdk.String("a-stage"),
 						ApiId:        awssdk.String("foobar"),
@@ -909,7 +909,7 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 domains",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return(nil, dummyError)
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType, resourceaws.AwsApiGatewayV2MappingResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType),
@@ -917,10 +917,10 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 mappings",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType, resourceaws.AwsApiGatewayV2MappingResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -929,10 +929,10 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "returning mapping with invalid attributes",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return([]*apigatewayv2.ApiMapping{
 						{
 							ApiMappingId: awssdk.String("barfoo"),
@@ -1001,7 +1001,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "no api gateway v2 domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1010,7 +1010,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "single api gateway v2 domain name",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("b8r351.example.com")},
 				}, nil)
 			},
@@ -1024,7 +1024,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return(nil, dummyError)
+				repository.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DomainNameResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DomainNameResourceType, resourceaws.AwsApiGatewayV2DomainNameResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2DomainNameResourceType),
@@ -1076,14 +1076,14 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "no api gateway v2 integration responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").
 					Return([]*apigatewayv2.IntegrationResponse{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -1093,14 +1093,14 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "single api gateway v2 integration with one integration response",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").
 					Return([]*apigatewayv2.IntegrationResponse{
 						{IntegrationResponseId: awssdk.String("sf67ti7")},
 					}, nil)
@@ -1115,7 +1115,7 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -1123,10 +1123,10 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 integrations",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").Return(nil, dummyError)
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResourceType),
@@ -1134,14 +1134,14 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 integration responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").Return(nil, dummyError)
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType),
@@ -37,9 +37,9 @@ func TestAppAutoScalingTarget(t *testing.T) {
 			test:    "should return one target",
 			dirName: "aws_appautoscaling_target_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalableTargets", "dynamodb").Return([]*applicationautoscaling.ScalableTarget{
+				client.On("DescribeScalableTargets", mock.Anything, "dynamodb").Return([]*applicationautoscaling.ScalableTarget{
 					{
 						ResourceId:        awssdk.String("table/GameScores"),
 						RoleARN:           awssdk.String("arn:aws:iam::533948124879:role/aws-service-role/dynamodb.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_DynamoDBTable"),
@@ -50,7 +50,7 @@ func TestAppAutoScalingTarget(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScalableTargets", mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalableTarget{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScalableTargets", mock.Anything, mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalableTarget{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -64,9 +64,9 @@ func TestAppAutoScalingTarget(t *testing.T) {
 			test:    "should return remote error",
 			dirName: "aws_appautoscaling_target_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalableTargets", mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
+				client.On("DescribeScalableTargets", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
 			},
 			wantErr: remoteerror.NewResourceListingError(errors.New("remote error"), resourceaws.AwsAppAutoscalingTargetResourceType),
 		},
@@ -144,9 +144,9 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 			test:    "should return one policy",
 			dirName: "aws_appautoscaling_policy_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalingPolicies", "dynamodb").Return([]*applicationautoscaling.ScalingPolicy{
+				client.On("DescribeScalingPolicies", mock.Anything, "dynamodb").Return([]*applicationautoscaling.ScalingPolicy{
 					{
 						PolicyName:        awssdk.String("DynamoDBReadCapacityUtilization:table/GameScores"),
 						ResourceId:        awssdk.String("table/GameScores"),
@@ -155,7 +155,7 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScalingPolicies", mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalingPolicy{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScalingPolicies", mock.Anything, mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalingPolicy{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -169,9 +169,9 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 			test:    "should return remote error",
 			dirName: "aws_appautoscaling_policy_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalingPolicies", mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
+				client.On("DescribeScalingPolicies", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
 			},
 			wantErr: remoteerror.NewResourceListingError(errors.New("remote error"), resourceaws.AwsAppAutoscalingPolicyResourceType),
 		},
@@ -259,9 +259,9 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 					return false
 				}
 
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScheduledActions", mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{
+				client.On("DescribeScheduledActions", mock.Anything, mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{
 					{
 						ScheduledActionName: awssdk.String("action"),
 						ResourceId:          awssdk.String("table/GameScores"),
@@ -270,7 +270,7 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScheduledActions", mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScheduledActions", mock.Anything, mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -282,9 +282,9 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 		{
 			test: "should return remote error",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScheduledActions", mock.AnythingOfType("string")).Return(nil, dummyError).Once()
+				client.On("DescribeScheduledActions", mock.Anything, mock.AnythingOfType("string")).Return(nil, dummyError).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -33,7 +33,7 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 		{
 			test: "no launch configuration",
 			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeLaunchConfigurations").Return([]*autoscaling.LaunchConfiguration{}, nil)
+				repository.On("DescribeLaunchConfigurations", mock.Anything).Return([]*autoscaling.LaunchConfiguration{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -42,7 +42,7 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 		{
 			test: "multiple launch configurations",
 			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeLaunchConfigurations").Return([]*autoscaling.LaunchConfiguration{
+				repository.On("DescribeLaunchConfigurations", mock.Anything).Return([]*autoscaling.LaunchConfiguration{
 					{LaunchConfigurationName: awssdk.String("web_config_1")},
 					{LaunchConfigurationName: awssdk.String("web_config_2")},
 				}, nil)
@@ -61,7 +61,7 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 			test: "cannot list launch configurations",
 			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("DescribeLaunchConfigurations").Return(nil, awsError)
+				repository.On("DescribeLaunchConfigurations", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLaunchConfigurationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLaunchConfigurationResourceType, resourceaws.AwsLaunchConfigurationResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -103,3 +103,83 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoscaling_Group(t *testing.T) {
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockAutoScalingRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no autoscaling groups",
+			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				repository.On("DescribeAutoScalingGroups", mock.Anything, []string(nil)).Return([]*autoscaling.Group{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple autoscaling groups",
+			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				repository.On("DescribeAutoScalingGroups", mock.Anything, []string(nil)).Return([]*autoscaling.Group{
+					{AutoScalingGroupName: awssdk.String("web")},
+					{AutoScalingGroupName: awssdk.String("eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+
+				assert.Equal(t, "web", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsAutoscalingGroupResourceType, got[0].ResourceType())
+
+				assert.Equal(t, "eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsAutoscalingGroupResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list autoscaling groups",
+			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("DescribeAutoScalingGroups", mock.Anything, []string(nil)).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsAutoscalingGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsAutoscalingGroupResourceType, resourceaws.AwsAutoscalingGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockAutoScalingRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.AutoScalingRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewAutoscalingGroupEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -39,7 +39,7 @@ func TestCloudfrontDistribution(t *testing.T) {
 			test:    "no cloudfront distributions",
 			dirName: "aws_cloudfront_distribution_empty",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{}, nil)
+				repository.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -49,7 +49,7 @@ func TestCloudfrontDistribution(t *testing.T) {
 			test:    "single cloudfront distribution",
 			dirName: "aws_cloudfront_distribution_single",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{
+				repository.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{
 					{Id: awssdk.String("E1M9CNS0XSHI19")},
 				}, nil)
 			},
@@ -65,7 +65,7 @@ func TestCloudfrontDistribution(t *testing.T) {
 			dirName: "aws_cloudfront_distribution_list",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				repository.On("ListAllDistributions").Return(nil, awsError)
+				repository.On("ListAllDistributions", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsCloudfrontDistributionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudfrontDistributionResourceType, resourceaws.AwsCloudfrontDistributionResourceType), alerts.EnumerationPhase)).Return()
 			},
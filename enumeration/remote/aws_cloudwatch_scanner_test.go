@@ -0,0 +1,272 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudwatchLogGroup(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudwatchLogsRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no log groups",
+			mocks: func(repository *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllLogGroups", mock.Anything).Return([]*cloudwatchlogs.LogGroup{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list log groups",
+			mocks: func(repository *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllLogGroups", mock.Anything).Return([]*cloudwatchlogs.LogGroup{
+					{LogGroupName: awssdk.String("/ecs/web")},
+					{LogGroupName: awssdk.String("/aws/lambda/foo")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "/ecs/web", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogGroupResourceType, got[0].ResourceType())
+				assert.Equal(t, "/aws/lambda/foo", got[1].ResourceId())
+			},
+		},
+		{
+			test: "cannot list log groups (403)",
+			mocks: func(repository *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllLogGroups", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchLogGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchLogGroupResourceType, resourceaws.AwsCloudwatchLogGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list log groups (dummy error)",
+			mocks: func(repository *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllLogGroups", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsCloudwatchLogGroupResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudwatchLogsRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudwatchLogsRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudwatchLogGroupEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCloudwatchMetricAlarm(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudwatchRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list metric alarms with the attributes changed during incidents",
+			mocks: func(repository *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllMetricAlarms", mock.Anything).Return([]*cloudwatch.MetricAlarm{
+					{
+						AlarmName:          awssdk.String("cpu-high"),
+						ComparisonOperator: awssdk.String("GreaterThanThreshold"),
+						EvaluationPeriods:  awssdk.Int64(3),
+						Period:             awssdk.Int64(60),
+						Threshold:          awssdk.Float64(90),
+						ActionsEnabled:     awssdk.Bool(false),
+						TreatMissingData:   awssdk.String("notBreaching"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "cpu-high", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchMetricAlarmResourceType, got[0].ResourceType())
+				assert.Equal(t, &resource.Attributes{
+					"alarm_name":          "cpu-high",
+					"comparison_operator": "GreaterThanThreshold",
+					"evaluation_periods":  float64(3),
+					"period":              float64(60),
+					"threshold":           float64(90),
+					"actions_enabled":     false,
+					"treat_missing_data":  "notBreaching",
+				}, got[0].Attributes())
+			},
+		},
+		{
+			test: "cannot list metric alarms (403)",
+			mocks: func(repository *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllMetricAlarms", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchMetricAlarmResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchMetricAlarmResourceType, resourceaws.AwsCloudwatchMetricAlarmResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list metric alarms (dummy error)",
+			mocks: func(repository *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllMetricAlarms", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsCloudwatchMetricAlarmResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudwatchRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudwatchRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudwatchMetricAlarmEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCloudwatchDashboard(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockCloudwatchRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list dashboards",
+			mocks: func(repository *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDashboards", mock.Anything).Return([]*cloudwatch.DashboardEntry{
+					{DashboardName: awssdk.String("oncall")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "oncall", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchDashboardResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list dashboards (403)",
+			mocks: func(repository *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllDashboards", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchDashboardResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchDashboardResourceType, resourceaws.AwsCloudwatchDashboardResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list dashboards (dummy error)",
+			mocks: func(repository *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDashboards", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsCloudwatchDashboardResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudwatchRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.CloudwatchRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudwatchDashboardEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -38,7 +38,7 @@ func TestDynamoDBTable(t *testing.T) {
 			test:    "no DynamoDB Table",
 			dirName: "aws_dynamodb_table_empty",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTables").Return([]*string{}, nil)
+				client.On("ListAllTables", mock.Anything).Return([]*string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -49,7 +49,7 @@ func TestDynamoDBTable(t *testing.T) {
 			test:    "Multiple DynamoDB Table",
 			dirName: "aws_dynamodb_table_multiple",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTables").Return([]*string{
+				client.On("ListAllTables", mock.Anything).Return([]*string{
 					awssdk.String("GameScores"),
 					awssdk.String("example"),
 				}, nil)
@@ -70,7 +70,7 @@ func TestDynamoDBTable(t *testing.T) {
 			dirName: "aws_dynamodb_table_list",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				client.On("ListAllTables").Return(nil, awsError)
+				client.On("ListAllTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDynamodbTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDynamodbTableResourceType, resourceaws.AwsDynamodbTableResourceType), alerts.EnumerationPhase)).Return()
 			},
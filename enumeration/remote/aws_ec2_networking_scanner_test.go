@@ -0,0 +1,684 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVPCEndpoint(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no vpc endpoints",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcEndpoints", mock.Anything).Return([]*ec2.VpcEndpoint{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list vpc endpoints but deleted ones",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcEndpoints", mock.Anything).Return([]*ec2.VpcEndpoint{
+					{
+						VpcEndpointId:   awssdk.String("vpce-0a1b2c3d4e5f"),
+						VpcId:           awssdk.String("vpc-0a1b2c3d"),
+						ServiceName:     awssdk.String("com.amazonaws.us-east-1.s3"),
+						VpcEndpointType: awssdk.String(ec2.VpcEndpointTypeGateway),
+						State:           awssdk.String("available"),
+					},
+					{
+						VpcEndpointId: awssdk.String("vpce-deleted"),
+						State:         awssdk.String(ec2.StateDeleted),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "vpce-0a1b2c3d4e5f", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcEndpointResourceType, got[0].ResourceType())
+				assert.Equal(t, "vpc-0a1b2c3d", *got[0].Attributes().GetString("vpc_id"))
+				assert.Equal(t, "com.amazonaws.us-east-1.s3", *got[0].Attributes().GetString("service_name"))
+				assert.Equal(t, "Gateway", *got[0].Attributes().GetString("vpc_endpoint_type"))
+			},
+		},
+		{
+			test: "cannot list vpc endpoints (403)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllVpcEndpoints", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsVpcEndpointResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcEndpointResourceType, resourceaws.AwsVpcEndpointResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list vpc endpoints (dummy error)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcEndpoints", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsVpcEndpointResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewVPCEndpointEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestVPCPeeringConnection(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no vpc peering connections",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcPeeringConnections", mock.Anything).Return([]*ec2.VpcPeeringConnection{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list active vpc peering connections",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcPeeringConnections", mock.Anything).Return([]*ec2.VpcPeeringConnection{
+					{
+						VpcPeeringConnectionId: awssdk.String("pcx-0a1b2c3d"),
+						RequesterVpcInfo:       &ec2.VpcPeeringConnectionVpcInfo{VpcId: awssdk.String("vpc-requester")},
+						AccepterVpcInfo:        &ec2.VpcPeeringConnectionVpcInfo{VpcId: awssdk.String("vpc-accepter")},
+						Status:                 &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+					},
+					{
+						VpcPeeringConnectionId: awssdk.String("pcx-deleted"),
+						Status:                 &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String(ec2.VpcPeeringConnectionStateReasonCodeDeleted)},
+					},
+					{
+						VpcPeeringConnectionId: awssdk.String("pcx-rejected"),
+						Status:                 &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String(ec2.VpcPeeringConnectionStateReasonCodeRejected)},
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "pcx-0a1b2c3d", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcPeeringConnectionResourceType, got[0].ResourceType())
+				assert.Equal(t, "vpc-requester", *got[0].Attributes().GetString("vpc_id"))
+				assert.Equal(t, "vpc-accepter", *got[0].Attributes().GetString("peer_vpc_id"))
+			},
+		},
+		{
+			test: "cannot list vpc peering connections (403)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllVpcPeeringConnections", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsVpcPeeringConnectionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcPeeringConnectionResourceType, resourceaws.AwsVpcPeeringConnectionResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list vpc peering connections (dummy error)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllVpcPeeringConnections", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsVpcPeeringConnectionResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewVPCPeeringConnectionEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2TransitGateway(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no transit gateways",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGateways", mock.Anything).Return([]*ec2.TransitGateway{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list transit gateways but deleted ones",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGateways", mock.Anything).Return([]*ec2.TransitGateway{
+					{
+						TransitGatewayId: awssdk.String("tgw-0a1b2c3d"),
+						State:            awssdk.String(ec2.TransitGatewayStateAvailable),
+					},
+					{
+						TransitGatewayId: awssdk.String("tgw-deleting"),
+						State:            awssdk.String(ec2.TransitGatewayStateDeleting),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "tgw-0a1b2c3d", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list transit gateways (403)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllTransitGateways", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEc2TransitGatewayResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEc2TransitGatewayResourceType, resourceaws.AwsEc2TransitGatewayResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list transit gateways (dummy error)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGateways", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEc2TransitGatewayResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2TransitGatewayEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2TransitGatewayVpcAttachment(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no transit gateway vpc attachments",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGatewayVpcAttachments", mock.Anything).Return([]*ec2.TransitGatewayVpcAttachment{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list transit gateway vpc attachments but deleted ones",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGatewayVpcAttachments", mock.Anything).Return([]*ec2.TransitGatewayVpcAttachment{
+					{
+						TransitGatewayAttachmentId: awssdk.String("tgw-attach-0a1b2c3d"),
+						TransitGatewayId:           awssdk.String("tgw-0a1b2c3d"),
+						VpcId:                      awssdk.String("vpc-0a1b2c3d"),
+						State:                      awssdk.String(ec2.TransitGatewayAttachmentStateAvailable),
+					},
+					{
+						TransitGatewayAttachmentId: awssdk.String("tgw-attach-deleted"),
+						State:                      awssdk.String(ec2.TransitGatewayAttachmentStateDeleted),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "tgw-attach-0a1b2c3d", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, got[0].ResourceType())
+				assert.Equal(t, "tgw-0a1b2c3d", *got[0].Attributes().GetString("transit_gateway_id"))
+				assert.Equal(t, "vpc-0a1b2c3d", *got[0].Attributes().GetString("vpc_id"))
+			},
+		},
+		{
+			test: "cannot list transit gateway vpc attachments (403)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllTransitGatewayVpcAttachments", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list transit gateway vpc attachments (dummy error)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllTransitGatewayVpcAttachments", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2TransitGatewayVpcAttachmentEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2FlowLog(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no flow logs",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFlowLogs", mock.Anything).Return([]*ec2.FlowLog{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list flow logs",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFlowLogs", mock.Anything).Return([]*ec2.FlowLog{
+					{FlowLogId: awssdk.String("fl-0a1b2c3d")},
+					{FlowLogId: awssdk.String("fl-4e5f6a7b")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "fl-0a1b2c3d", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsFlowLogResourceType, got[0].ResourceType())
+				assert.Equal(t, "fl-4e5f6a7b", got[1].ResourceId())
+			},
+		},
+		{
+			test: "cannot list flow logs (403)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllFlowLogs", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsFlowLogResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsFlowLogResourceType, resourceaws.AwsFlowLogResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list flow logs (dummy error)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllFlowLogs", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsFlowLogResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2FlowLogEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEC2NetworkInterface(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no network interfaces",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllNetworkInterfaces", mock.Anything).Return([]*ec2.NetworkInterface{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list network interfaces with the service managing them",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllNetworkInterfaces", mock.Anything).Return([]*ec2.NetworkInterface{
+					{
+						NetworkInterfaceId: awssdk.String("eni-standalone"),
+						SubnetId:           awssdk.String("subnet-0a1b2c3d"),
+						InterfaceType:      awssdk.String(ec2.NetworkInterfaceTypeInterface),
+						Attachment: &ec2.NetworkInterfaceAttachment{
+							InstanceId:  awssdk.String("i-0a1b2c3d"),
+							DeviceIndex: awssdk.Int64(1),
+						},
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-instance"),
+						SubnetId:           awssdk.String("subnet-0a1b2c3d"),
+						InterfaceType:      awssdk.String(ec2.NetworkInterfaceTypeInterface),
+						Attachment: &ec2.NetworkInterfaceAttachment{
+							InstanceId:  awssdk.String("i-0a1b2c3d"),
+							DeviceIndex: awssdk.Int64(0),
+						},
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-elb"),
+						SubnetId:           awssdk.String("subnet-0a1b2c3d"),
+						InterfaceType:      awssdk.String(ec2.NetworkInterfaceTypeInterface),
+						RequesterManaged:   awssdk.Bool(true),
+						RequesterId:        awssdk.String("amazon-elb"),
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-nat"),
+						SubnetId:           awssdk.String("subnet-0a1b2c3d"),
+						InterfaceType:      awssdk.String(ec2.NetworkInterfaceTypeNatGateway),
+						RequesterManaged:   awssdk.Bool(true),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 4)
+				assert.Equal(t, "eni-standalone", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsNetworkInterfaceResourceType, got[0].ResourceType())
+				assert.Equal(t, "subnet-0a1b2c3d", *got[0].Attributes().GetString("subnet_id"))
+				assert.Nil(t, got[0].Attributes().GetString(aws.NetworkInterfaceManagedByAttribute))
+				assert.Equal(t, "eni-instance", got[1].ResourceId())
+				assert.Equal(t, "instance", *got[1].Attributes().GetString(aws.NetworkInterfaceManagedByAttribute))
+				assert.Equal(t, "eni-elb", got[2].ResourceId())
+				assert.Equal(t, "amazon-elb", *got[2].Attributes().GetString(aws.NetworkInterfaceManagedByAttribute))
+				assert.Equal(t, "eni-nat", got[3].ResourceId())
+				assert.Equal(t, "natGateway", *got[3].Attributes().GetString(aws.NetworkInterfaceManagedByAttribute))
+			},
+		},
+		{
+			test: "cannot list network interfaces (403)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllNetworkInterfaces", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsNetworkInterfaceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsNetworkInterfaceResourceType, resourceaws.AwsNetworkInterfaceResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list network interfaces (dummy error)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllNetworkInterfaces", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsNetworkInterfaceResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEC2NetworkInterfaceEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestVPCDhcpOptions(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no dhcp options",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDhcpOptions", mock.Anything).Return([]*ec2.DhcpOptions{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list dhcp options",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDhcpOptions", mock.Anything).Return([]*ec2.DhcpOptions{
+					{DhcpOptionsId: awssdk.String("dopt-0a1b2c3d")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "dopt-0a1b2c3d", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcDhcpOptionsResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list dhcp options (403)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllDhcpOptions", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsVpcDhcpOptionsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcDhcpOptionsResourceType, resourceaws.AwsVpcDhcpOptionsResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list dhcp options (dummy error)",
+			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllDhcpOptions", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsVpcDhcpOptionsResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EC2Repository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewVPCDhcpOptionsEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -39,7 +39,7 @@ func TestEC2EbsVolume(t *testing.T) {
 			test:    "no volumes",
 			dirName: "aws_ec2_ebs_volume_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVolumes").Return([]*ec2.Volume{}, nil)
+				repository.On("ListAllVolumes", mock.Anything).Return([]*ec2.Volume{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -49,7 +49,7 @@ func TestEC2EbsVolume(t *testing.T) {
 			test:    "multiple volumes",
 			dirName: "aws_ec2_ebs_volume_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVolumes").Return([]*ec2.Volume{
+				repository.On("ListAllVolumes", mock.Anything).Return([]*ec2.Volume{
 					{VolumeId: awssdk.String("vol-081c7272a57a09db1")},
 					{VolumeId: awssdk.String("vol-01ddc91d3d9d1318b")},
 				}, nil)
@@ -69,7 +69,7 @@ func TestEC2EbsVolume(t *testing.T) {
 			dirName: "aws_ec2_ebs_volume_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllVolumes").Return(nil, awsError)
+				repository.On("ListAllVolumes", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEbsVolumeResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEbsVolumeResourceType, resourceaws.AwsEbsVolumeResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -148,7 +148,7 @@ func TestEC2EbsSnapshot(t *testing.T) {
 			test:    "no snapshots",
 			dirName: "aws_ec2_ebs_snapshot_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSnapshots").Return([]*ec2.Snapshot{}, nil)
+				repository.On("ListAllSnapshots", mock.Anything).Return([]*ec2.Snapshot{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -158,7 +158,7 @@ func TestEC2EbsSnapshot(t *testing.T) {
 			test:    "multiple snapshots",
 			dirName: "aws_ec2_ebs_snapshot_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSnapshots").Return([]*ec2.Snapshot{
+				repository.On("ListAllSnapshots", mock.Anything).Return([]*ec2.Snapshot{
 					{SnapshotId: awssdk.String("snap-0c509a2a880d95a39")},
 					{SnapshotId: awssdk.String("snap-00672558cecd93a61")},
 				}, nil)
@@ -178,7 +178,7 @@ func TestEC2EbsSnapshot(t *testing.T) {
 			dirName: "aws_ec2_ebs_snapshot_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllSnapshots").Return(nil, awsError)
+				repository.On("ListAllSnapshots", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEbsSnapshotResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEbsSnapshotResourceType, resourceaws.AwsEbsSnapshotResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -257,7 +257,7 @@ func TestEC2Eip(t *testing.T) {
 			test:    "no eips",
 			dirName: "aws_ec2_eip_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddresses").Return([]*ec2.Address{
+				repository.On("ListAllAddresses", mock.Anything).Return([]*ec2.Address{
 					{}, // Test Eip without AllocationId because it can happen (seen in sentry)
 				}, nil)
 			},
@@ -269,7 +269,7 @@ func TestEC2Eip(t *testing.T) {
 			test:    "multiple eips",
 			dirName: "aws_ec2_eip_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddresses").Return([]*ec2.Address{
+				repository.On("ListAllAddresses", mock.Anything).Return([]*ec2.Address{
 					{AllocationId: awssdk.String("eipalloc-017d5267e4dda73f1")},
 					{AllocationId: awssdk.String("eipalloc-0cf714dc097c992cc")},
 				}, nil)
@@ -289,7 +289,7 @@ func TestEC2Eip(t *testing.T) {
 			dirName: "aws_ec2_eip_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllAddresses").Return(nil, awsError)
+				repository.On("ListAllAddresses", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEipResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEipResourceType, resourceaws.AwsEipResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -368,7 +368,7 @@ func TestEC2Ami(t *testing.T) {
 			test:    "no amis",
 			dirName: "aws_ec2_ami_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*ec2.Image{}, nil)
+				repository.On("ListAllImages", mock.Anything).Return([]*ec2.Image{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -378,7 +378,7 @@ func TestEC2Ami(t *testing.T) {
 			test:    "multiple amis",
 			dirName: "aws_ec2_ami_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*ec2.Image{
+				repository.On("ListAllImages", mock.Anything).Return([]*ec2.Image{
 					{ImageId: awssdk.String("ami-03a578b46f4c3081b")},
 					{ImageId: awssdk.String("ami-025962fd8b456731f")},
 				}, nil)
@@ -398,7 +398,7 @@ func TestEC2Ami(t *testing.T) {
 			dirName: "aws_ec2_ami_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllImages").Return(nil, awsError)
+				repository.On("ListAllImages", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsAmiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsAmiResourceType, resourceaws.AwsAmiResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -477,7 +477,7 @@ func TestEC2KeyPair(t *testing.T) {
 			test:    "no key pairs",
 			dirName: "aws_ec2_key_pair_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllKeyPairs").Return([]*ec2.KeyPairInfo{}, nil)
+				repository.On("ListAllKeyPairs", mock.Anything).Return([]*ec2.KeyPairInfo{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -487,7 +487,7 @@ func TestEC2KeyPair(t *testing.T) {
 			test:    "multiple key pairs",
 			dirName: "aws_ec2_key_pair_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllKeyPairs").Return([]*ec2.KeyPairInfo{
+				repository.On("ListAllKeyPairs", mock.Anything).Return([]*ec2.KeyPairInfo{
 					{KeyName: awssdk.String("test")},
 					{KeyName: awssdk.String("bar")},
 				}, nil)
@@ -507,7 +507,7 @@ func TestEC2KeyPair(t *testing.T) {
 			dirName: "aws_ec2_key_pair_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllKeyPairs").Return(nil, awsError)
+				repository.On("ListAllKeyPairs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsKeyPairResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKeyPairResourceType, resourceaws.AwsKeyPairResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -586,7 +586,7 @@ func TestEC2EipAssociation(t *testing.T) {
 			test:    "no eip associations",
 			dirName: "aws_ec2_eip_association_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddressesAssociation").Return([]*ec2.Address{}, nil)
+				repository.On("ListAllAddressesAssociation", mock.Anything).Return([]*ec2.Address{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -596,7 +596,7 @@ func TestEC2EipAssociation(t *testing.T) {
 			test:    "single eip association",
 			dirName: "aws_ec2_eip_association_single",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddressesAssociation").Return([]*ec2.Address{
+				repository.On("ListAllAddressesAssociation", mock.Anything).Return([]*ec2.Address{
 					{
 						AssociationId: awssdk.String("eipassoc-0e9a7356e30f0c3d1"),
 						AllocationId:  awssdk.String("eipalloc-017d5267e4dda73f1"),
@@ -615,7 +615,7 @@ func TestEC2EipAssociation(t *testing.T) {
 			dirName: "aws_ec2_eip_association_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllAddressesAssociation").Return(nil, awsError)
+				repository.On("ListAllAddressesAssociation", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEipAssociationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEipAssociationResourceType, resourceaws.AwsEipAssociationResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -694,7 +694,7 @@ func TestEC2Instance(t *testing.T) {
 			test:    "no instances",
 			dirName: "aws_ec2_instance_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInstances").Return([]*ec2.Instance{}, nil)
+				repository.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -704,7 +704,7 @@ func TestEC2Instance(t *testing.T) {
 			test:    "multiple instances",
 			dirName: "aws_ec2_instance_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInstances").Return([]*ec2.Instance{
+				repository.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{
 					{InstanceId: awssdk.String("i-0d3650a23f4e45dc0")},
 					{InstanceId: awssdk.String("i-010376047a71419f1")},
 				}, nil)
@@ -723,7 +723,7 @@ func TestEC2Instance(t *testing.T) {
 			test:    "terminated instances",
 			dirName: "aws_ec2_instance_terminated",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInstances").Return([]*ec2.Instance{
+				repository.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{
 					{InstanceId: awssdk.String("i-0e1543baf4f2cd990")},
 					{InstanceId: awssdk.String("i-0a3a7ed51ae2b4fa0")}, // Nil
 				}, nil)
@@ -743,7 +743,7 @@ func TestEC2Instance(t *testing.T) {
 			dirName: "aws_ec2_instance_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllInstances").Return(nil, awsError)
+				repository.On("ListAllInstances", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsInstanceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsInstanceResourceType, resourceaws.AwsInstanceResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -822,7 +822,7 @@ func TestEC2InternetGateway(t *testing.T) {
 			test:    "no internet gateways",
 			dirName: "aws_ec2_internet_gateway_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInternetGateways").Return([]*ec2.InternetGateway{}, nil)
+				repository.On("ListAllInternetGateways", mock.Anything).Return([]*ec2.InternetGateway{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -832,7 +832,7 @@ func TestEC2InternetGateway(t *testing.T) {
 			test:    "multiple internet gateways",
 			dirName: "aws_ec2_internet_gateway_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInternetGateways").Return([]*ec2.InternetGateway{
+				repository.On("ListAllInternetGateways", mock.Anything).Return([]*ec2.InternetGateway{
 					{InternetGatewayId: awssdk.String("igw-0184eb41aadc62d1c")},
 					{InternetGatewayId: awssdk.String("igw-047b487f5c60fca99")},
 				}, nil)
@@ -852,7 +852,7 @@ func TestEC2InternetGateway(t *testing.T) {
 			dirName: "aws_ec2_internet_gateway_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllInternetGateways").Return(nil, awsError)
+				repository.On("ListAllInternetGateways", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsInternetGatewayResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsInternetGatewayResourceType, resourceaws.AwsInternetGatewayResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -932,7 +932,7 @@ func TestVPC(t *testing.T) {
 			test:    "no VPC",
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -943,7 +943,7 @@ func TestVPC(t *testing.T) {
 			test:    "VPC results",
 			dirName: "aws_vpc",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{
 					{
 						VpcId: awssdk.String("vpc-0768e1fd0029e3fc3"),
 					},
@@ -981,7 +981,7 @@ func TestVPC(t *testing.T) {
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllVPCs").Once().Return(nil, nil, awsError)
+				client.On("ListAllVPCs", mock.Anything).Once().Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsVpcResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcResourceType, resourceaws.AwsVpcResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1061,7 +1061,7 @@ func TestDefaultVPC(t *testing.T) {
 			test:    "no VPC",
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1072,7 +1072,7 @@ func TestDefaultVPC(t *testing.T) {
 			test:    "default VPC results",
 			dirName: "aws_default_vpc",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{
 					{
 						VpcId:     awssdk.String("vpc-0768e1fd0029e3fc3"),
 						IsDefault: awssdk.Bool(false),
@@ -1101,7 +1101,7 @@ func TestDefaultVPC(t *testing.T) {
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllVPCs").Once().Return(nil, nil, awsError)
+				client.On("ListAllVPCs", mock.Anything).Once().Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultVpcResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultVpcResourceType, resourceaws.AwsDefaultVpcResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1180,7 +1180,7 @@ func TestEC2RouteTableAssociation(t *testing.T) {
 			test:    "no route table associations (test for nil values)",
 			dirName: "aws_ec2_route_table_association_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("assoc_with_nil"),
 						Associations: []*ec2.RouteTableAssociation{
@@ -1205,7 +1205,7 @@ func TestEC2RouteTableAssociation(t *testing.T) {
 			test:    "multiple route table associations (mixed subnet and gateway associations)",
 			dirName: "aws_ec2_route_table_association_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("rtb-05aa6c5673311a17b"), // route
 						Associations: []*ec2.RouteTableAssociation{
@@ -1285,7 +1285,7 @@ func TestEC2RouteTableAssociation(t *testing.T) {
 			dirName: "aws_ec2_route_table_association_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRouteTableAssociationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRouteTableAssociationResourceType, resourceaws.AwsRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1364,7 +1364,7 @@ func TestEC2Subnet(t *testing.T) {
 			test:    "no subnets",
 			dirName: "aws_ec2_subnet_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1374,7 +1374,7 @@ func TestEC2Subnet(t *testing.T) {
 			test:    "multiple subnets",
 			dirName: "aws_ec2_subnet_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{
 					{
 						SubnetId:     awssdk.String("subnet-05810d3f933925f6d"), // subnet1
 						DefaultForAz: awssdk.Bool(false),
@@ -1420,7 +1420,7 @@ func TestEC2Subnet(t *testing.T) {
 			dirName: "aws_ec2_subnet_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllSubnets").Return(nil, nil, awsError)
+				repository.On("ListAllSubnets", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSubnetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSubnetResourceType, resourceaws.AwsSubnetResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1499,7 +1499,7 @@ func TestEC2DefaultSubnet(t *testing.T) {
 			test:    "no default subnets",
 			dirName: "aws_ec2_default_subnet_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1509,7 +1509,7 @@ func TestEC2DefaultSubnet(t *testing.T) {
 			test:    "multiple default subnets",
 			dirName: "aws_ec2_default_subnet_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{
 					{
 						SubnetId:     awssdk.String("subnet-05810d3f933925f6d"), // subnet1
 						DefaultForAz: awssdk.Bool(false),
@@ -1555,7 +1555,7 @@ func TestEC2DefaultSubnet(t *testing.T) {
 			dirName: "aws_ec2_default_subnet_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllSubnets").Return(nil, nil, awsError)
+				repository.On("ListAllSubnets", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultSubnetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultSubnetResourceType, resourceaws.AwsDefaultSubnetResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1634,7 +1634,7 @@ func TestEC2RouteTable(t *testing.T) {
 			test:    "no route tables",
 			dirName: "aws_ec2_route_table_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1644,7 +1644,7 @@ func TestEC2RouteTable(t *testing.T) {
 			test:    "multiple route tables",
 			dirName: "aws_ec2_route_table_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{RouteTableId: awssdk.String("rtb-08b7b71af15e183ce")}, // table1
 					{RouteTableId: awssdk.String("rtb-0002ac731f6fdea55")}, // table2
 					{RouteTableId: awssdk.String("rtb-0c55d55593f33fbac")}, // table3
@@ -1677,7 +1677,7 @@ func TestEC2RouteTable(t *testing.T) {
 			dirName: "aws_ec2_route_table_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRouteTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRouteTableResourceType, resourceaws.AwsRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1756,7 +1756,7 @@ func TestEC2DefaultRouteTable(t *testing.T) {
 			test:    "no default route tables",
 			dirName: "aws_ec2_default_route_table_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1766,7 +1766,7 @@ func TestEC2DefaultRouteTable(t *testing.T) {
 			test:    "multiple default route tables",
 			dirName: "aws_ec2_default_route_table_single",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{RouteTableId: awssdk.String("rtb-08b7b71af15e183ce")}, // table1
 					{RouteTableId: awssdk.String("rtb-0002ac731f6fdea55")}, // table2
 					{RouteTableId: awssdk.String("rtb-0c55d55593f33fbac")}, // table3
@@ -1793,7 +1793,7 @@ func TestEC2DefaultRouteTable(t *testing.T) {
 			dirName: "aws_ec2_default_route_table_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultRouteTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultRouteTableResourceType, resourceaws.AwsDefaultRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1873,7 +1873,7 @@ func TestVpcSecurityGroup(t *testing.T) {
 			test:    "no security groups",
 			dirName: "aws_vpc_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1884,7 +1884,7 @@ func TestVpcSecurityGroup(t *testing.T) {
 			test:    "with security groups",
 			dirName: "aws_vpc_security_group_multiple",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:   awssdk.String("sg-0254c038e32f25530"),
 						GroupName: awssdk.String("foo"),
@@ -1909,7 +1909,7 @@ func TestVpcSecurityGroup(t *testing.T) {
 			dirName: "aws_vpc_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSecurityGroups").Return(nil, nil, awsError)
+				client.On("ListAllSecurityGroups", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSecurityGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecurityGroupResourceType, resourceaws.AwsSecurityGroupResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1989,7 +1989,7 @@ func TestVpcDefaultSecurityGroup(t *testing.T) {
 			test:    "no security groups",
 			dirName: "aws_vpc_default_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -2000,7 +2000,7 @@ func TestVpcDefaultSecurityGroup(t *testing.T) {
 			test:    "with security groups",
 			dirName: "aws_vpc_default_security_group_multiple",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:   awssdk.String("sg-0254c038e32f25530"),
 						GroupName: awssdk.String("foo"),
@@ -2025,7 +2025,7 @@ func TestVpcDefaultSecurityGroup(t *testing.T) {
 			dirName: "aws_vpc_default_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSecurityGroups").Return(nil, nil, awsError)
+				client.On("ListAllSecurityGroups", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultSecurityGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultSecurityGroupResourceType, resourceaws.AwsDefaultSecurityGroupResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -2104,7 +2104,7 @@ func TestEC2NatGateway(t *testing.T) {
 			test:    "no nat gateways",
 			dirName: "aws_ec2_nat_gateway_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNatGateways").Return([]*ec2.NatGateway{}, nil)
+				repository.On("ListAllNatGateways", mock.Anything).Return([]*ec2.NatGateway{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -2114,7 +2114,7 @@ func TestEC2NatGateway(t *testing.T) {
 			test:    "single nat gateway",
 			dirName: "aws_ec2_nat_gateway_single",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNatGateways").Return([]*ec2.NatGateway{
+				repository.On("ListAllNatGateways", mock.Anything).Return([]*ec2.NatGateway{
 					{NatGatewayId: awssdk.String("nat-0a5408508b19ef490")},
 				}, nil)
 			},
@@ -2130,7 +2130,7 @@ func TestEC2NatGateway(t *testing.T) {
 			dirName: "aws_ec2_nat_gateway_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNatGateways").Return(nil, awsError)
+				repository.On("ListAllNatGateways", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsNatGatewayResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsNatGatewayResourceType, resourceaws.AwsNatGatewayResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -2209,7 +2209,7 @@ func TestEC2NetworkACL(t *testing.T) {
 			test:    "no network ACL",
 			dirName: "aws_ec2_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{}, nil)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -2219,7 +2219,7 @@ func TestEC2NetworkACL(t *testing.T) {
 			test:    "network acl",
 			dirName: "aws_ec2_network_acl",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{
 					{
 						NetworkAclId: awssdk.String("acl-043880b4682d2366b"),
 						IsDefault:    awssdk.Bool(false),
@@ -2249,7 +2249,7 @@ func TestEC2NetworkACL(t *testing.T) {
 			dirName: "aws_ec2_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNetworkACLs").Return(nil, awsError)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert",
 					resourceaws.AwsNetworkACLResourceType,
@@ -2339,7 +2339,7 @@ func TestEC2NetworkACLRule(t *testing.T) {
 			test:    "no network ACL",
 			dirName: "aws_ec2_network_acl_rule_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{}, nil)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -2349,7 +2349,7 @@ func TestEC2NetworkACLRule(t *testing.T) {
 			test:    "network acl rules",
 			dirName: "aws_ec2_network_acl_rule",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{
 					{
 						NetworkAclId: awssdk.String("acl-0ad6d657494d17ee2"), // test
 						IsDefault:    awssdk.Bool(false),
@@ -2414,7 +2414,7 @@ func TestEC2NetworkACLRule(t *testing.T) {
 			dirName: "aws_ec2_network_acl_rule_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNetworkACLs").Return(nil, awsError)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert",
 					resourceaws.AwsNetworkACLRuleResourceType,
@@ -2498,7 +2498,7 @@ func TestEC2DefaultNetworkACL(t *testing.T) {
 			test:    "no network ACL",
 			dirName: "aws_ec2_default_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{}, nil)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -2508,7 +2508,7 @@ func TestEC2DefaultNetworkACL(t *testing.T) {
 			test:    "default network acl",
 			dirName: "aws_ec2_default_network_acl",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{
 					{
 						NetworkAclId: awssdk.String("acl-043880b4682d2366b"),
 						IsDefault:    awssdk.Bool(false),
@@ -2535,7 +2535,7 @@ func TestEC2DefaultNetworkACL(t *testing.T) {
 			dirName: "aws_ec2_default_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNetworkACLs").Return(nil, awsError)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert",
 					resourceaws.AwsDefaultNetworkACLResourceType,
@@ -2627,7 +2627,7 @@ func TestEC2Route(t *testing.T) {
 			test:    "no routes",
 			dirName: "aws_ec2_route_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -2637,7 +2637,7 @@ func TestEC2Route(t *testing.T) {
 			test:    "multiple routes (mixed default_route_table and route_table)",
 			dirName: "aws_ec2_route_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("rtb-096bdfb69309c54c3"), // table1
 						Routes: []*ec2.Route{
@@ -2727,7 +2727,7 @@ func TestEC2Route(t *testing.T) {
 			dirName: "aws_ec2_route_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRouteResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRouteResourceType, resourceaws.AwsRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -2807,7 +2807,7 @@ func TestVpcSecurityGroupRule(t *testing.T) {
 			test:    "no security group rules",
 			dirName: "aws_vpc_security_group_rule_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:             awssdk.String("sg-0254c038e32f25530"),
 						IpPermissions:       []*ec2.IpPermission{},
@@ -2824,7 +2824,7 @@ func TestVpcSecurityGroupRule(t *testing.T) {
 			test:    "with security group rules",
 			dirName: "aws_vpc_security_group_rule_multiple",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId: awssdk.String("sg-0254c038e32f25530"),
 						IpPermissions: []*ec2.IpPermission{
@@ -2930,7 +2930,7 @@ func TestVpcSecurityGroupRule(t *testing.T) {
 			dirName: "aws_vpc_security_group_rule_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSecurityGroups").Once().Return(nil, nil, awsError)
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSecurityGroupRuleResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecurityGroupRuleResourceType, resourceaws.AwsSecurityGroupResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -3009,7 +3009,7 @@ func TestEC2LaunchTemplate(t *testing.T) {
 			test:    "no launch template",
 			dirName: "aws_launch_template",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeLaunchTemplates").Return([]*ec2.LaunchTemplate{}, nil)
+				repository.On("DescribeLaunchTemplates", mock.Anything).Return([]*ec2.LaunchTemplate{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -3024,7 +3024,7 @@ func TestEC2LaunchTemplate(t *testing.T) {
 					{LaunchTemplateId: awssdk.String("lt-00b2d18c6cee7fe23"), LatestVersionNumber: awssdk.Int64(1)},
 				}
 
-				repository.On("DescribeLaunchTemplates").Return(launchTemplates, nil)
+				repository.On("DescribeLaunchTemplates", mock.Anything).Return(launchTemplates, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 2)
@@ -3041,7 +3041,7 @@ func TestEC2LaunchTemplate(t *testing.T) {
 			dirName: "aws_launch_template",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("DescribeLaunchTemplates").Return(nil, awsError)
+				repository.On("DescribeLaunchTemplates", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLaunchTemplateResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLaunchTemplateResourceType, resourceaws.AwsLaunchTemplateResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -3121,7 +3121,7 @@ func TestEC2EbsEncryptionByDefault(t *testing.T) {
 			test:    "no encryption by default resource",
 			dirName: "aws_ebs_encryption_by_default_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("IsEbsEncryptionEnabledByDefault").Return(false, nil)
+				repository.On("IsEbsEncryptionEnabledByDefault", mock.Anything).Return(false, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -3135,7 +3135,7 @@ func TestEC2EbsEncryptionByDefault(t *testing.T) {
 			dirName: "aws_ebs_encryption_by_default_error",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("IsEbsEncryptionEnabledByDefault").Return(false, awsError)
+				repository.On("IsEbsEncryptionEnabledByDefault", mock.Anything).Return(false, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEbsEncryptionByDefaultResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEbsEncryptionByDefaultResourceType, resourceaws.AwsEbsEncryptionByDefaultResourceType), alerts.EnumerationPhase)).Return()
 			},
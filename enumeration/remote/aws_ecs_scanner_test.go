@@ -0,0 +1,395 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestECSCluster(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no ecs clusters",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list active ecs clusters",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{
+					{
+						ClusterArn:  awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"),
+						ClusterName: awssdk.String("foo"),
+						Status:      awssdk.String("ACTIVE"),
+					},
+					{
+						ClusterArn:  awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/deleted"),
+						ClusterName: awssdk.String("deleted"),
+						Status:      awssdk.String("INACTIVE"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:cluster/foo", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEcsClusterResourceType, got[0].ResourceType())
+				assert.Equal(t, "foo", *got[0].Attributes().GetString("name"))
+			},
+		},
+		{
+			test: "cannot list ecs clusters (403)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEcsClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsClusterResourceType, resourceaws.AwsEcsClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list ecs clusters (dummy error)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEcsClusterResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ECSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewECSClusterEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestECSService(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	cluster := &ecs.Cluster{
+		ClusterArn:  awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"),
+		ClusterName: awssdk.String("foo"),
+		Status:      awssdk.String("ACTIVE"),
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no ecs services",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{cluster}, nil)
+				repository.On("ListAllServices", mock.Anything, "arn:aws:ecs:us-east-1:123456789012:cluster/foo").Return([]*ecs.Service{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list ecs services of active clusters",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{
+					cluster,
+					{
+						ClusterArn:  awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/deleted"),
+						ClusterName: awssdk.String("deleted"),
+						Status:      awssdk.String("INACTIVE"),
+					},
+				}, nil)
+				repository.On("ListAllServices", mock.Anything, "arn:aws:ecs:us-east-1:123456789012:cluster/foo").Return([]*ecs.Service{
+					{
+						ServiceArn:  awssdk.String("arn:aws:ecs:us-east-1:123456789012:service/foo/web"),
+						ServiceName: awssdk.String("web"),
+						Status:      awssdk.String("ACTIVE"),
+					},
+					{
+						ServiceArn:  awssdk.String("arn:aws:ecs:us-east-1:123456789012:service/foo/old"),
+						ServiceName: awssdk.String("old"),
+						Status:      awssdk.String("INACTIVE"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:service/foo/web", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEcsServiceResourceType, got[0].ResourceType())
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:cluster/foo", *got[0].Attributes().GetString("cluster"))
+			},
+		},
+		{
+			test: "cannot list ecs clusters (403)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEcsServiceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsServiceResourceType, resourceaws.AwsEcsClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list ecs services (dummy error)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{cluster}, nil)
+				repository.On("ListAllServices", mock.Anything, "arn:aws:ecs:us-east-1:123456789012:cluster/foo").Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEcsServiceResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ECSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewECSServiceEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestECSTaskDefinition(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no ecs task definitions",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllActiveTaskDefinitions", mock.Anything).Return([]string{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list the latest active revision of each family",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllActiveTaskDefinitions", mock.Anything).Return([]string{
+					"arn:aws:ecs:us-east-1:123456789012:task-definition/web:3",
+					"arn:aws:ecs:us-east-1:123456789012:task-definition/worker:1",
+					"arn:aws:ecs:us-east-1:123456789012:task-definition/web:12",
+					"not-an-arn",
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "web", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEcsTaskDefinitionResourceType, got[0].ResourceType())
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:task-definition/web:12", *got[0].Attributes().GetString("arn"))
+				assert.Equal(t, "worker", got[1].ResourceId())
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:task-definition/worker:1", *got[1].Attributes().GetString("arn"))
+			},
+		},
+		{
+			test: "cannot list ecs task definitions (403)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllActiveTaskDefinitions", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEcsTaskDefinitionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsTaskDefinitionResourceType, resourceaws.AwsEcsTaskDefinitionResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list ecs task definitions (dummy error)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllActiveTaskDefinitions", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEcsTaskDefinitionResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ECSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewECSTaskDefinitionEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestECSClusterCapacityProviders(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list capacity providers of clusters having some",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{
+					{
+						ClusterArn:        awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/foo"),
+						ClusterName:       awssdk.String("foo"),
+						Status:            awssdk.String("ACTIVE"),
+						CapacityProviders: []*string{awssdk.String("FARGATE"), awssdk.String("FARGATE_SPOT")},
+					},
+					{
+						ClusterArn:  awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/bar"),
+						ClusterName: awssdk.String("bar"),
+						Status:      awssdk.String("ACTIVE"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "foo", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEcsClusterCapacityProvidersResourceType, got[0].ResourceType())
+				assert.Equal(t, "foo", *got[0].Attributes().GetString("cluster_name"))
+			},
+		},
+		{
+			test: "cannot list ecs clusters (403)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEcsClusterCapacityProvidersResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsClusterCapacityProvidersResourceType, resourceaws.AwsEcsClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list ecs clusters (dummy error)",
+			mocks: func(repository *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsEcsClusterCapacityProvidersResourceType, resourceaws.AwsEcsClusterResourceType),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.ECSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewECSClusterCapacityProvidersEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,433 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEKSCluster(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEKSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no eks clusters",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list eks clusters with their security group",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{
+					{
+						Name: awssdk.String("foo"),
+						ResourcesVpcConfig: &eks.VpcConfigResponse{
+							ClusterSecurityGroupId: awssdk.String("sg-0a1b2c3d4e5f"),
+						},
+					},
+					{
+						Name: awssdk.String("bar"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "foo", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksClusterResourceType, got[0].ResourceType())
+				assert.Equal(t, "sg-0a1b2c3d4e5f", *got[0].Attributes().GetString(aws.EksClusterSecurityGroupAttribute))
+				assert.Equal(t, "bar", got[1].ResourceId())
+				assert.Nil(t, got[1].Attributes().GetString(aws.EksClusterSecurityGroupAttribute))
+			},
+		},
+		{
+			test: "cannot list eks clusters (403)",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEksClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksClusterResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list eks clusters (dummy error)",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEksClusterResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EKSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEKSClusterEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEKSNodeGroup(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	cluster := &eks.Cluster{
+		Name: awssdk.String("foo"),
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEKSRepository, *repository.MockAutoScalingRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no eks node groups",
+			mocks: func(repository *repository.MockEKSRepository, autoscalingRepository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllNodeGroups", mock.Anything, "foo").Return([]*eks.Nodegroup{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list eks node groups with their launch template",
+			mocks: func(repository *repository.MockEKSRepository, autoscalingRepository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllNodeGroups", mock.Anything, "foo").Return([]*eks.Nodegroup{
+					{
+						NodegroupName: awssdk.String("workers"),
+						Resources: &eks.NodegroupResources{
+							AutoScalingGroups: []*eks.AutoScalingGroup{
+								{Name: awssdk.String("eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f")},
+							},
+						},
+					},
+					{
+						NodegroupName: awssdk.String("legacy"),
+						Resources: &eks.NodegroupResources{
+							AutoScalingGroups: []*eks.AutoScalingGroup{
+								{Name: awssdk.String("eks-1eb8e6c2-6f4e-fb49-2cd5-4e9a0c3d8f2b")},
+							},
+						},
+					},
+					{
+						NodegroupName: awssdk.String("creating"),
+					},
+				}, nil)
+				autoscalingRepository.On("DescribeAutoScalingGroups", mock.Anything, []string{"eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f"}).Return([]*autoscaling.Group{
+					{
+						AutoScalingGroupName: awssdk.String("eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f"),
+						LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+							LaunchTemplateName: awssdk.String("eks-9d2f7b1a-0c5e-4f3a-8e6d-2b1c0a9f8e7d"),
+						},
+					},
+				}, nil)
+				autoscalingRepository.On("DescribeAutoScalingGroups", mock.Anything, []string{"eks-1eb8e6c2-6f4e-fb49-2cd5-4e9a0c3d8f2b"}).Return([]*autoscaling.Group{
+					{
+						AutoScalingGroupName: awssdk.String("eks-1eb8e6c2-6f4e-fb49-2cd5-4e9a0c3d8f2b"),
+						MixedInstancesPolicy: &autoscaling.MixedInstancesPolicy{
+							LaunchTemplate: &autoscaling.LaunchTemplate{
+								LaunchTemplateSpecification: &autoscaling.LaunchTemplateSpecification{
+									LaunchTemplateName: awssdk.String("eks-1eb8e6c2-6f4e-fb49-2cd5-4e9a0c3d8f2b"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+				assert.Equal(t, "foo:workers", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksNodeGroupResourceType, got[0].ResourceType())
+				assert.Equal(t, "foo", *got[0].Attributes().GetString("cluster_name"))
+				assert.Equal(t, "workers", *got[0].Attributes().GetString("node_group_name"))
+				assert.Equal(t, "eks-9d2f7b1a-0c5e-4f3a-8e6d-2b1c0a9f8e7d", *got[0].Attributes().GetString(aws.EksNodeGroupLaunchTemplateAttribute))
+				assert.Equal(t, []interface{}{"eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f"}, got[0].Attributes().GetSlice(aws.EksNodeGroupAutoscalingGroupsAttribute))
+				assert.Equal(t, "foo:legacy", got[1].ResourceId())
+				assert.Equal(t, "eks-1eb8e6c2-6f4e-fb49-2cd5-4e9a0c3d8f2b", *got[1].Attributes().GetString(aws.EksNodeGroupLaunchTemplateAttribute))
+				assert.Equal(t, "foo:creating", got[2].ResourceId())
+				assert.Nil(t, got[2].Attributes().GetString(aws.EksNodeGroupLaunchTemplateAttribute))
+				assert.Nil(t, got[2].Attributes().GetSlice(aws.EksNodeGroupAutoscalingGroupsAttribute))
+			},
+		},
+		{
+			test: "cannot list eks clusters (403)",
+			mocks: func(repository *repository.MockEKSRepository, autoscalingRepository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEksNodeGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksNodeGroupResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot describe autoscaling groups of eks node groups (403)",
+			mocks: func(repository *repository.MockEKSRepository, autoscalingRepository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDenied", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllNodeGroups", mock.Anything, "foo").Return([]*eks.Nodegroup{
+					{
+						NodegroupName: awssdk.String("workers"),
+						Resources: &eks.NodegroupResources{
+							AutoScalingGroups: []*eks.AutoScalingGroup{
+								{Name: awssdk.String("eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f")},
+							},
+						},
+					},
+				}, nil)
+				autoscalingRepository.On("DescribeAutoScalingGroups", mock.Anything, []string{"eks-workers-56c0a3c4-3c4e-0b8f-4a9c-1c2a4b7c7a9f"}).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEksNodeGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksNodeGroupResourceType, resourceaws.AwsAutoscalingGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list eks node groups (dummy error)",
+			mocks: func(repository *repository.MockEKSRepository, autoscalingRepository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllNodeGroups", mock.Anything, "foo").Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEksNodeGroupResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			fakeAutoscalingRepo := &repository.MockAutoScalingRepository{}
+			c.mocks(fakeRepo, fakeAutoscalingRepo, alerter)
+
+			var repo repository.EKSRepository = fakeRepo
+			var autoscalingRepo repository.AutoScalingRepository = fakeAutoscalingRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEKSNodeGroupEnumerator(repo, autoscalingRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			fakeAutoscalingRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEKSFargateProfile(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	cluster := &eks.Cluster{
+		Name: awssdk.String("foo"),
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEKSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list eks fargate profiles",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllFargateProfiles", mock.Anything, "foo").Return([]string{"default"}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "foo:default", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksFargateProfileResourceType, got[0].ResourceType())
+				assert.Equal(t, "default", *got[0].Attributes().GetString("fargate_profile_name"))
+			},
+		},
+		{
+			test: "cannot list eks clusters (403)",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEksFargateProfileResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksFargateProfileResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list eks fargate profiles (dummy error)",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllFargateProfiles", mock.Anything, "foo").Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEksFargateProfileResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EKSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEKSFargateProfileEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestEKSAddon(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	cluster := &eks.Cluster{
+		Name: awssdk.String("foo"),
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEKSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list eks add-ons",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllAddons", mock.Anything, "foo").Return([]string{"coredns", "vpc-cni"}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "foo:coredns", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksAddonResourceType, got[0].ResourceType())
+				assert.Equal(t, "coredns", *got[0].Attributes().GetString("addon_name"))
+				assert.Equal(t, "foo:vpc-cni", got[1].ResourceId())
+			},
+		},
+		{
+			test: "cannot list eks clusters (403)",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEksAddonResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksAddonResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list eks add-ons (dummy error)",
+			mocks: func(repository *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllClusters", mock.Anything).Return([]*eks.Cluster{cluster}, nil)
+				repository.On("ListAllAddons", mock.Anything, "foo").Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsEksAddonResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EKSRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewEKSAddonEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
@@ -35,7 +35,7 @@ func TestElastiCacheCluster(t *testing.T) {
 		{
 			test: "no elasticache clusters",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllCacheClusters").Return([]*elasticache.CacheCluster{}, nil)
+				repository.On("ListAllCacheClusters", mock.Anything).Return([]*elasticache.CacheCluster{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +44,7 @@ func TestElastiCacheCluster(t *testing.T) {
 		{
 			test: "should list elasticache clusters",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllCacheClusters").Return([]*elasticache.CacheCluster{
+				repository.On("ListAllCacheClusters", mock.Anything).Return([]*elasticache.CacheCluster{
 					{CacheClusterId: awssdk.String("cluster-foo")},
 				}, nil)
 			},
@@ -58,7 +58,7 @@ func TestElastiCacheCluster(t *testing.T) {
 			test: "cannot list elasticache clusters (403)",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllCacheClusters").Return(nil, awsError)
+				repository.On("ListAllCacheClusters", mock.Anything).Return(nil, awsError)
 				alerter.On("SendAlert", resourceaws.AwsElastiCacheClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsElastiCacheClusterResourceType, resourceaws.AwsElastiCacheClusterResourceType), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -68,7 +68,7 @@ func TestElastiCacheCluster(t *testing.T) {
 		{
 			test: "cannot list elasticache clusters (dummy error)",
 			mocks: func(repository *repository.MockElastiCacheRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllCacheClusters").Return(nil, dummyError)
+				repository.On("ListAllCacheClusters", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -35,7 +35,7 @@ func TestELB_LoadBalancer(t *testing.T) {
 		{
 			test: "no load balancer",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elb.LoadBalancerDescription{}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elb.LoadBalancerDescription{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +44,7 @@ func TestELB_LoadBalancer(t *testing.T) {
 		{
 			test: "should list load balancers",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elb.LoadBalancerDescription{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elb.LoadBalancerDescription{
 					{
 						LoadBalancerName: awssdk.String("acc-test-lb-tf"),
 					},
@@ -60,7 +60,7 @@ func TestELB_LoadBalancer(t *testing.T) {
 			test: "cannot list load balancers",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancers").Return(nil, awsError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsClassicLoadBalancerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsClassicLoadBalancerResourceType, resourceaws.AwsClassicLoadBalancerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -71,7 +71,7 @@ func TestELB_LoadBalancer(t *testing.T) {
 		{
 			test: "cannot list load balancers (dummy error)",
 			mocks: func(repository *repository.MockELBRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -0,0 +1,315 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudwatchEventBus(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	eventBuses := []*eventbridge.EventBus{
+		{Name: awssdk.String("default")},
+		{Name: awssdk.String("orders")},
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEventBridgeRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list event buses except the default one",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "orders", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventBusResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list event buses (403)",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllEventBuses", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventBusResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventBusResourceType, resourceaws.AwsCloudwatchEventBusResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list event buses (dummy error)",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllEventBuses", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsCloudwatchEventBusResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEventBridgeRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EventBridgeRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudwatchEventBusEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCloudwatchEventRule(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	eventBuses := []*eventbridge.EventBus{
+		{Name: awssdk.String("default")},
+		{Name: awssdk.String("orders")},
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEventBridgeRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list event rules of all event buses",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+				repository.On("ListAllRules", mock.Anything, "default").Return([]*eventbridge.Rule{
+					{
+						Name:               awssdk.String("nightly"),
+						State:              awssdk.String("DISABLED"),
+						ScheduleExpression: awssdk.String("cron(0 2 * * ? *)"),
+					},
+					{
+						Name:      awssdk.String("AutoScalingManagedRule"),
+						State:     awssdk.String("ENABLED"),
+						ManagedBy: awssdk.String("autoscaling.amazonaws.com"),
+					},
+				}, nil)
+				repository.On("ListAllRules", mock.Anything, "orders").Return([]*eventbridge.Rule{
+					{
+						Name:         awssdk.String("created"),
+						State:        awssdk.String("ENABLED"),
+						EventPattern: awssdk.String("{\"detail-type\":[\"OrderCreated\"]}"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "nightly", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventRuleResourceType, got[0].ResourceType())
+				assert.Equal(t, &resource.Attributes{
+					"name":                "nightly",
+					"event_bus_name":      "default",
+					"is_enabled":          false,
+					"schedule_expression": "cron(0 2 * * ? *)",
+				}, got[0].Attributes())
+				assert.Equal(t, "orders/created", got[1].ResourceId())
+				assert.Equal(t, &resource.Attributes{
+					"name":           "created",
+					"event_bus_name": "orders",
+					"is_enabled":     true,
+					"event_pattern":  "{\"detail-type\":[\"OrderCreated\"]}",
+				}, got[1].Attributes())
+			},
+		},
+		{
+			test: "cannot list event buses (403)",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllEventBuses", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventRuleResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventRuleResourceType, resourceaws.AwsCloudwatchEventBusResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list event rules (dummy error)",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+				repository.On("ListAllRules", mock.Anything, "default").Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsCloudwatchEventRuleResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEventBridgeRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EventBridgeRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudwatchEventRuleEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCloudwatchEventTarget(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	eventBuses := []*eventbridge.EventBus{
+		{Name: awssdk.String("default")},
+		{Name: awssdk.String("orders")},
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockEventBridgeRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list event targets of each rule",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+				repository.On("ListAllRules", mock.Anything, "default").Return([]*eventbridge.Rule{
+					{Name: awssdk.String("nightly")},
+					{Name: awssdk.String("AutoScalingManagedRule"), ManagedBy: awssdk.String("autoscaling.amazonaws.com")},
+				}, nil)
+				repository.On("ListAllRules", mock.Anything, "orders").Return([]*eventbridge.Rule{
+					{Name: awssdk.String("created")},
+				}, nil)
+				repository.On("ListAllTargets", mock.Anything, "default", "nightly").Return([]*eventbridge.Target{
+					{Id: awssdk.String("cleanup"), Arn: awssdk.String("arn:aws:lambda:us-east-1:123456789012:function:cleanup")},
+				}, nil)
+				repository.On("ListAllTargets", mock.Anything, "orders", "created").Return([]*eventbridge.Target{
+					{Id: awssdk.String("queue"), Arn: awssdk.String("arn:aws:sqs:us-east-1:123456789012:orders")},
+					{Id: awssdk.String("audit"), Arn: awssdk.String("arn:aws:lambda:us-east-1:123456789012:function:audit")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 3)
+				assert.Equal(t, "nightly-cleanup", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventTargetResourceType, got[0].ResourceType())
+				assert.Equal(t, "orders/created-queue", got[1].ResourceId())
+				assert.Equal(t, "created", *got[1].Attributes().GetString("rule"))
+				assert.Equal(t, "orders", *got[1].Attributes().GetString("event_bus_name"))
+				assert.Equal(t, "arn:aws:sqs:us-east-1:123456789012:orders", *got[1].Attributes().GetString("arn"))
+				assert.Equal(t, "orders/created-audit", got[2].ResourceId())
+			},
+		},
+		{
+			test: "cannot list event buses (403)",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllEventBuses", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventTargetResourceType, resourceaws.AwsCloudwatchEventBusResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list event rules (403)",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllRules", mock.Anything, "default").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventTargetResourceType, resourceaws.AwsCloudwatchEventRuleResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list event targets (dummy error)",
+			mocks: func(repository *repository.MockEventBridgeRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+				repository.On("ListAllRules", mock.Anything, "default").Return([]*eventbridge.Rule{{Name: awssdk.String("nightly")}}, nil)
+				repository.On("ListAllTargets", mock.Anything, "default", "nightly").Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsCloudwatchEventTargetResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEventBridgeRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.EventBridgeRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewCloudwatchEventTargetEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
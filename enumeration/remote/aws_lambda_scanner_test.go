@@ -41,7 +41,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			test:    "no lambda functions",
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, nil)
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -52,7 +52,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			test:    "with lambda functions",
 			dirName: "aws_lambda_function_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: awssdk.String("foo"),
 					},
@@ -76,7 +76,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			test:    "One lambda with signing",
 			dirName: "aws_lambda_function_signed",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: awssdk.String("foo"),
 					},
@@ -95,7 +95,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, awsError)
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLambdaFunctionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaFunctionResourceType, resourceaws.AwsLambdaFunctionResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -174,7 +174,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			test:    "no EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, nil)
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -185,7 +185,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			test:    "with 2 sqs EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_sqs_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: awssdk.String("13ff66f8-37eb-4ad6-a0a8-594fea72df4f"),
 					},
@@ -209,7 +209,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			test:    "with dynamo EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_dynamo_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: awssdk.String("1aa9c4a0-060b-41c1-a9ae-dc304ebcdb00"),
 					},
@@ -228,7 +228,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, awsError)
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLambdaEventSourceMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaEventSourceMappingResourceType, resourceaws.AwsLambdaEventSourceMappingResourceType), alerts.EnumerationPhase)).Return()
 			},
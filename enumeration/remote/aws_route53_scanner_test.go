@@ -42,7 +42,7 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			test:    "no health check",
 			dirName: "aws_route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{}, nil)
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -53,7 +53,7 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			test:    "Multiple health check",
 			dirName: "aws_route53_health_check_multiple",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{
 					{Id: awssdk.String("7001a9df-ded4-4802-9909-668eb80b972b")},
 					{Id: awssdk.String("84fc318a-2e0d-41d6-b638-280e2f0f4e26")},
 				}, nil)
@@ -74,7 +74,7 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			dirName: "aws_route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllHealthChecks").Return(nil, awsError)
+				client.On("ListAllHealthChecks", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRoute53HealthCheckResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRoute53HealthCheckResourceType, resourceaws.AwsRoute53HealthCheckResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -154,7 +154,7 @@ func TestRoute53_Zone(t *testing.T) {
 			test:    "no zones",
 			dirName: "aws_route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					nil,
 				)
@@ -168,7 +168,7 @@ func TestRoute53_Zone(t *testing.T) {
 			test:    "single zone",
 			dirName: "aws_route53_zone_single",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z08068311RGDXPHF8KE62"),
@@ -190,7 +190,7 @@ func TestRoute53_Zone(t *testing.T) {
 			test:    "multiples zone (test pagination)",
 			dirName: "aws_route53_zone_multiples",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z01809283VH9BBALZHO7B"),
@@ -227,7 +227,7 @@ func TestRoute53_Zone(t *testing.T) {
 			dirName: "aws_route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awsError,
 				)
@@ -310,7 +310,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "no records",
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -319,7 +319,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -330,7 +330,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "multiples records in multiples zones",
 			dirName: "aws_route53_record_multiples",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -343,7 +343,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("foo-0.com"),
 						Type: awssdk.String("NS"),
@@ -365,7 +365,7 @@ func TestRoute53_Record(t *testing.T) {
 						Type: awssdk.String("A"),
 					},
 				}, nil)
-				client.On("ListRecordsForZone", "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test2"),
 						Type: awssdk.String("A"),
@@ -387,7 +387,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "explicit subdomain records",
 			dirName: "aws_route53_record_explicit_subdomain",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -396,7 +396,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test0"),
 						Type: awssdk.String("TXT"),
@@ -439,7 +439,7 @@ func TestRoute53_Record(t *testing.T) {
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awsError)
 
@@ -454,7 +454,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "cannot list records",
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -463,7 +463,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return(
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return(
 					[]*route53.ResourceRecordSet{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRoute53RecordResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRoute53RecordResourceType, resourceaws.AwsRoute53RecordResourceType), alerts.EnumerationPhase)).Return()
@@ -44,7 +44,7 @@ func TestS3Bucket(t *testing.T) {
 			test: "multiple bucket", dirName: "aws_s3_bucket_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -52,7 +52,7 @@ func TestS3Bucket(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -60,7 +60,7 @@ func TestS3Bucket(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -68,7 +68,7 @@ func TestS3Bucket(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -86,7 +86,7 @@ func TestS3Bucket(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_list",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -167,7 +167,7 @@ func TestS3BucketInventory(t *testing.T) {
 			test: "multiple bucket with multiple inventories", dirName: "aws_s3_bucket_inventories_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -175,7 +175,7 @@ func TestS3BucketInventory(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -183,7 +183,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -191,7 +191,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"eu-west-1",
@@ -199,7 +199,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 
 				repository.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -224,7 +224,7 @@ func TestS3BucketInventory(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_inventories_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketInventoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketInventoryResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -236,14 +236,14 @@ func TestS3BucketInventory(t *testing.T) {
 		{
 			test: "cannot list bucket inventories", dirName: "aws_s3_bucket_inventories_list_inventories",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
 					nil,
 				)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -251,7 +251,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -339,13 +339,13 @@ func TestS3BucketNotification(t *testing.T) {
 			dirName: "aws_s3_bucket_notifications_no_notif",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-notifications")},
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"dritftctl-test-no-notifications",
 				).Return(
 					"eu-west-3",
@@ -353,7 +353,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketNotification",
+					"GetBucketNotification", mock.Anything,
 					"dritftctl-test-no-notifications",
 					"eu-west-3",
 				).Return(
@@ -369,7 +369,7 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "multiple bucket with notifications", dirName: "aws_s3_bucket_notifications_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -377,7 +377,7 @@ func TestS3BucketNotification(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -385,7 +385,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -393,7 +393,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketNotification",
+					"GetBucketNotification", mock.Anything,
 					"bucket-martin-test-drift2",
 					"eu-west-3",
 				).Return(
@@ -411,7 +411,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -429,19 +429,19 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "Cannot get bucket notification", dirName: "aws_s3_bucket_notifications_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-notifications-error")},
 				}, nil)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"dritftctl-test-notifications-error",
 				).Return(
 					"eu-west-3",
 					nil,
 				)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("GetBucketNotification", "dritftctl-test-notifications-error", "eu-west-3").Return(nil, awsError)
+				repository.On("GetBucketNotification", mock.Anything, "dritftctl-test-notifications-error", "eu-west-3").Return(nil, awsError)
 
 				alerter.On("SendAlert", "aws_s3_bucket_notification.dritftctl-test-notifications-error", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, "aws_s3_bucket_notification.dritftctl-test-notifications-error", resourceaws.AwsS3BucketNotificationResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -454,7 +454,7 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "Cannot list bucket", dirName: "aws_s3_bucket_notifications_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketNotificationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketNotificationResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -535,7 +535,7 @@ func TestS3BucketMetrics(t *testing.T) {
 			test: "multiple bucket with multiple metrics", dirName: "aws_s3_bucket_metrics_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -543,7 +543,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -551,7 +551,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -559,7 +559,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -567,7 +567,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				)
 
 				repository.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -592,7 +592,7 @@ func TestS3BucketMetrics(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_metrics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketMetricResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketMetricResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -604,14 +604,14 @@ func TestS3BucketMetrics(t *testing.T) {
 		{
 			test: "cannot list metrics", dirName: "aws_s3_bucket_metrics_list_metrics",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
 					nil,
 				)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -620,7 +620,7 @@ func TestS3BucketMetrics(t *testing.T) {
 
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -708,13 +708,13 @@ func TestS3BucketPolicy(t *testing.T) {
 			dirName: "aws_s3_bucket_policy_no_policy",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-policy")},
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"dritftctl-test-no-policy",
 				).Return(
 					"eu-west-3",
@@ -722,7 +722,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketPolicy",
+					"GetBucketPolicy", mock.Anything,
 					"dritftctl-test-no-policy",
 					"eu-west-3",
 				).Return(
@@ -738,7 +738,7 @@ func TestS3BucketPolicy(t *testing.T) {
 			test: "multiple bucket with policies", dirName: "aws_s3_bucket_policies_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -746,7 +746,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -754,7 +754,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -762,7 +762,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -770,7 +770,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketPolicy",
+					"GetBucketPolicy", mock.Anything,
 					"bucket-martin-test-drift2",
 					"eu-west-3",
 				).Return(
@@ -792,7 +792,7 @@ func TestS3BucketPolicy(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_policies_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketPolicyResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -873,23 +873,23 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 		{
 			test: "multiple bucket, one with access block",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return([]*s3.Bucket{
+				repository.On("ListAllBuckets", mock.Anything).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-with-public-access-block")},
 					{Name: awssdk.String("bucket-without-public-access-block")},
 				}, nil)
 
-				repository.On("GetBucketLocation", "bucket-with-public-access-block").
+				repository.On("GetBucketLocation", mock.Anything, "bucket-with-public-access-block").
 					Return("us-east-1", nil)
-				repository.On("GetBucketLocation", "bucket-without-public-access-block").
+				repository.On("GetBucketLocation", mock.Anything, "bucket-without-public-access-block").
 					Return("us-east-1", nil)
 
-				repository.On("GetBucketPublicAccessBlock", "bucket-with-public-access-block", "us-east-1").
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "bucket-with-public-access-block", "us-east-1").
 					Return(&s3.PublicAccessBlockConfiguration{
 						BlockPublicAcls:   awssdk.Bool(true),
 						BlockPublicPolicy: awssdk.Bool(false),
 					}, nil)
 
-				repository.On("GetBucketPublicAccessBlock", "bucket-without-public-access-block", "us-east-1").
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "bucket-without-public-access-block", "us-east-1").
 					Return(nil, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -907,16 +907,16 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 		{
 			test: "cannot list bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(nil, dummyError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, resourceaws.AwsS3BucketResourceType),
 		},
 		{
 			test: "cannot list public access block",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return([]*s3.Bucket{{Name: awssdk.String("foobar")}}, nil)
-				repository.On("GetBucketLocation", "foobar").Return("us-east-1", nil)
-				repository.On("GetBucketPublicAccessBlock", "foobar", "us-east-1").Return(nil, dummyError)
+				repository.On("ListAllBuckets", mock.Anything).Return([]*s3.Bucket{{Name: awssdk.String("foobar")}}, nil)
+				repository.On("GetBucketLocation", mock.Anything, "foobar").Return("us-east-1", nil)
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "foobar", "us-east-1").Return(nil, dummyError)
 				alerter.On("SendAlert", "aws_s3_bucket_public_access_block.foobar", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, "foobar"), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -975,7 +975,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 			dirName: "aws_s3_bucket_analytics_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -983,7 +983,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -991,7 +991,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -999,7 +999,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -1007,7 +1007,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				)
 
 				repository.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -1032,7 +1032,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_analytics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketAnalyticsConfigurationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketAnalyticsConfigurationResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1044,14 +1044,14 @@ func TestS3BucketAnalytic(t *testing.T) {
 		{
 			test: "cannot list Analytics", dirName: "aws_s3_bucket_analytics_list_analytics",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
 					nil,
 				)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -1060,7 +1060,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -1148,7 +1148,7 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 		{
 			test: "existing access block",
 			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeAccountPublicAccessBlock", accountID).Return(&s3control.PublicAccessBlockConfiguration{
+				repository.On("DescribeAccountPublicAccessBlock", mock.Anything, accountID).Return(&s3control.PublicAccessBlockConfiguration{
 					BlockPublicAcls:       awssdk.Bool(false),
 					BlockPublicPolicy:     awssdk.Bool(true),
 					IgnorePublicAcls:      awssdk.Bool(false),
@@ -1170,7 +1170,7 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 		{
 			test: "cannot list access block",
 			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeAccountPublicAccessBlock", accountID).Return(nil, dummyError)
+				repository.On("DescribeAccountPublicAccessBlock", mock.Anything, accountID).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsS3AccountPublicAccessBlock),
 		},
@@ -0,0 +1,279 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSecretsManagerSecret(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSecretsManagerRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no secrets",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets", mock.Anything).Return([]*secretsmanager.SecretListEntry{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list secrets without their value",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets", mock.Anything).Return([]*secretsmanager.SecretListEntry{
+					{
+						ARN:  awssdk.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf"),
+						Name: awssdk.String("foo"),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretResourceType, got[0].ResourceType())
+				assert.Equal(t, &resource.Attributes{"name": "foo"}, got[0].Attributes())
+			},
+		},
+		{
+			test: "cannot list secrets (403)",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllSecrets", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretResourceType, resourceaws.AwsSecretsManagerSecretResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list secrets (dummy error)",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsSecretsManagerSecretResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSecretsManagerRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SecretsManagerRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSecretsManagerSecretEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestSecretsManagerSecretRotation(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	secrets := []*secretsmanager.SecretListEntry{
+		{
+			ARN:  awssdk.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf"),
+			Name: awssdk.String("foo"),
+		},
+		{
+			ARN:             awssdk.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:bar-GhIjKl"),
+			Name:            awssdk.String("bar"),
+			RotationEnabled: awssdk.Bool(true),
+		},
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSecretsManagerRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list rotations of secrets with rotation enabled",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets", mock.Anything).Return(secrets, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:123456789012:secret:bar-GhIjKl", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretRotationResourceType, got[0].ResourceType())
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:123456789012:secret:bar-GhIjKl", *got[0].Attributes().GetString("secret_id"))
+			},
+		},
+		{
+			test: "cannot list secrets (403)",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllSecrets", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretRotationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretRotationResourceType, resourceaws.AwsSecretsManagerSecretResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list secrets (dummy error)",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsSecretsManagerSecretRotationResourceType, resourceaws.AwsSecretsManagerSecretResourceType),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSecretsManagerRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SecretsManagerRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSecretsManagerSecretRotationEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestSecretsManagerSecretPolicy(t *testing.T) {
+	dummyError := errors.New("dummy error")
+	secrets := []*secretsmanager.SecretListEntry{
+		{
+			ARN:  awssdk.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf"),
+			Name: awssdk.String("foo"),
+		},
+		{
+			ARN:             awssdk.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:bar-GhIjKl"),
+			Name:            awssdk.String("bar"),
+			RotationEnabled: awssdk.Bool(true),
+		},
+	}
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSecretsManagerRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "should list policies of secrets",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets", mock.Anything).Return(secrets, nil)
+				repository.On("GetSecretPolicy", mock.Anything, "arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf").Return(awssdk.String("{\"Version\":\"2012-10-17\"}"), nil)
+				repository.On("GetSecretPolicy", mock.Anything, "arn:aws:secretsmanager:us-east-1:123456789012:secret:bar-GhIjKl").Return(nil, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretPolicyResourceType, got[0].ResourceType())
+				assert.Equal(t, &resource.Attributes{"secret_arn": "arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf"}, got[0].Attributes())
+			},
+		},
+		{
+			test: "cannot list secrets (403)",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllSecrets", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretPolicyResourceType, resourceaws.AwsSecretsManagerSecretResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list secret policies (dummy error)",
+			mocks: func(repository *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllSecrets", mock.Anything).Return(secrets, nil)
+				repository.On("GetSecretPolicy", mock.Anything, "arn:aws:secretsmanager:us-east-1:123456789012:secret:foo-AbCdEf").Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsSecretsManagerSecretPolicyResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSecretsManagerRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SecretsManagerRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSecretsManagerSecretPolicyEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
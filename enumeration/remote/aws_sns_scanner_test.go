@@ -41,7 +41,7 @@ func TestScanSNSTopic(t *testing.T) {
 			test:    "no SNS Topic",
 			dirName: "aws_sns_topic_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -52,7 +52,7 @@ func TestScanSNSTopic(t *testing.T) {
 			test:    "Multiple SNSTopic",
 			dirName: "aws_sns_topic_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic")},
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic2")},
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic3")},
@@ -77,7 +77,7 @@ func TestScanSNSTopic(t *testing.T) {
 			dirName: "aws_sns_topic_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllTopics").Return(nil, awsError)
+				client.On("ListAllTopics", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicResourceType, resourceaws.AwsSnsTopicResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -156,7 +156,7 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			test:    "no SNS Topic policy",
 			dirName: "aws_sns_topic_policy_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -167,7 +167,7 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			test:    "Multiple SNSTopicPolicy",
 			dirName: "aws_sns_topic_policy_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy")},
 					{TopicArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy2")},
 				}, nil)
@@ -188,7 +188,7 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			dirName: "aws_sns_topic_policy_topic_list",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllTopics").Return(nil, awsError)
+				client.On("ListAllTopics", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicPolicyResourceType, resourceaws.AwsSnsTopicResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -267,7 +267,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			test:    "no SNS Topic Subscription",
 			dirName: "aws_sns_topic_subscription_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{}, nil)
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -278,7 +278,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			test:    "Multiple SNSTopic Subscription",
 			dirName: "aws_sns_topic_subscription_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic:b6e66147-2b31-4486-8d4b-2a2272264c8e")},
 				}, nil)
@@ -298,7 +298,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			test:    "Multiple SNSTopic Subscription with one pending and one incorrect",
 			dirName: "aws_sns_topic_subscription_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: awssdk.String("PendingConfirmation"), Endpoint: awssdk.String("TEST")},
 					{SubscriptionArn: awssdk.String("Incorrect"), Endpoint: awssdk.String("INCORRECT")},
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
@@ -325,7 +325,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			dirName: "aws_sns_topic_subscription_list",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSubscriptions").Return(nil, awsError)
+				client.On("ListAllSubscriptions", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicSubscriptionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicSubscriptionResourceType, resourceaws.AwsSnsTopicSubscriptionResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -39,7 +39,7 @@ func TestSQSQueue(t *testing.T) {
 			test:    "no sqs queues",
 			dirName: "aws_sqs_queue_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -50,7 +50,7 @@ func TestSQSQueue(t *testing.T) {
 			test:    "multiple sqs queues",
 			dirName: "aws_sqs_queue_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 				}, nil)
@@ -71,7 +71,7 @@ func TestSQSQueue(t *testing.T) {
 			dirName: "aws_sqs_queue_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllQueues").Return(nil, awsError)
+				client.On("ListAllQueues", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSqsQueueResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSqsQueueResourceType, resourceaws.AwsSqsQueueResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -151,7 +151,7 @@ func TestSQSQueuePolicy(t *testing.T) {
 			test:    "no sqs queue policies",
 			dirName: "aws_sqs_queue_policy_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -162,13 +162,13 @@ func TestSQSQueuePolicy(t *testing.T) {
 			test:    "multiple sqs queue policies (default or not)",
 			dirName: "aws_sqs_queue_policy_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/baz"),
 				}, nil)
 
-				client.On("GetQueueAttributes", mock.Anything).Return(
+				client.On("GetQueueAttributes", mock.Anything, mock.Anything).Return(
 					&sqs.GetQueueAttributesOutput{
 						Attributes: map[string]*string{
 							sqs.QueueAttributeNamePolicy: awssdk.String(""),
@@ -195,13 +195,13 @@ func TestSQSQueuePolicy(t *testing.T) {
 			test:    "multiple sqs queue policies (with nil attributes)",
 			dirName: "aws_sqs_queue_policy_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/baz"),
 				}, nil)
 
-				client.On("GetQueueAttributes", mock.Anything).Return(
+				client.On("GetQueueAttributes", mock.Anything, mock.Anything).Return(
 					&sqs.GetQueueAttributesOutput{},
 					nil,
 				)
@@ -225,7 +225,7 @@ func TestSQSQueuePolicy(t *testing.T) {
 			dirName: "aws_sqs_queue_policy_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllQueues").Return(nil, awsError)
+				client.On("ListAllQueues", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSqsQueuePolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSqsQueuePolicyResourceType, resourceaws.AwsSqsQueueResourceType), alerts.EnumerationPhase)).Return()
 			},
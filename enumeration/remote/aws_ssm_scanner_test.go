@@ -0,0 +1,116 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSSMParameter(t *testing.T) {
+	dummyError := errors.New("dummy error")
+
+	tests := []struct {
+		test           string
+		mocks          func(*repository.MockSSMRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+		wantErr        error
+	}{
+		{
+			test: "no parameters",
+			mocks: func(repository *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllParameters", mock.Anything).Return([]*ssm.ParameterMetadata{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "should list parameters without their value",
+			mocks: func(repository *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllParameters", mock.Anything).Return([]*ssm.ParameterMetadata{
+					{
+						Name: awssdk.String("/app/url"),
+						Type: awssdk.String(ssm.ParameterTypeString),
+					},
+					{
+						Name: awssdk.String("/app/password"),
+						Type: awssdk.String(ssm.ParameterTypeSecureString),
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "/app/url", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSsmParameterResourceType, got[0].ResourceType())
+				assert.Equal(t, &resource.Attributes{"name": "/app/url"}, got[0].Attributes())
+				assert.Equal(t, "/app/password", got[1].ResourceId())
+				assert.Equal(t, &resource.Attributes{"name": "/app/password"}, got[1].Attributes())
+			},
+		},
+		{
+			test: "cannot list parameters (403)",
+			mocks: func(repository *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				repository.On("ListAllParameters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSsmParameterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSsmParameterResourceType, resourceaws.AwsSsmParameterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list parameters (dummy error)",
+			mocks: func(repository *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repository.On("ListAllParameters", mock.Anything).Return(nil, dummyError)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+			wantErr: remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsSsmParameterResourceType, ""),
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSSMRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			var repo repository.SSMRepository = fakeRepo
+
+			remoteLibrary.AddEnumerator(aws.NewSSMParameterEnumerator(repo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(remoteLibrary, alerter, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, c.wantErr, err)
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+		})
+	}
+}
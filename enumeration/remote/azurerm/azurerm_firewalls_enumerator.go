@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermFirewallsEnumerator) SupportedType() resource.ResourceType {
 	return azurerm.AzureFirewallResourceType
 }
 
-func (e *AzurermFirewallsEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.ListAllFirewalls()
+func (e *AzurermFirewallsEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.ListAllFirewalls(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
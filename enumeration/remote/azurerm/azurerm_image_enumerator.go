@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -27,8 +29,8 @@ func (e *AzurermImageEnumerator) SupportedType() resource.ResourceType {
 	return azurerm.AzureImageResourceType
 }
 
-func (e *AzurermImageEnumerator) Enumerate() ([]*resource.Resource, error) {
-	images, err := e.repository.ListAllImages()
+func (e *AzurermImageEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	images, err := e.repository.ListAllImages(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermNetworkSecurityGroupEnumerator) SupportedType() resource.Resourc
 	return azurerm.AzureNetworkSecurityGroupResourceType
 }
 
-func (e *AzurermNetworkSecurityGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	securityGroups, err := e.repository.ListAllSecurityGroups()
+func (e *AzurermNetworkSecurityGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	securityGroups, err := e.repository.ListAllSecurityGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzureNetworkSecurityGroupResourceType)
 	}
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,15 +25,15 @@ func (e *AzurermPostgresqlDatabaseEnumerator) SupportedType() resource.ResourceT
 	return azurerm.AzurePostgresqlDatabaseResourceType
 }
 
-func (e *AzurermPostgresqlDatabaseEnumerator) Enumerate() ([]*resource.Resource, error) {
-	servers, err := e.repository.ListAllServers()
+func (e *AzurermPostgresqlDatabaseEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	servers, err := e.repository.ListAllServers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzurePostgresqlServerResourceType)
 	}
 
 	results := make([]*resource.Resource, 0)
 	for _, server := range servers {
-		databases, err := e.repository.ListAllDatabasesByServer(server)
+		databases, err := e.repository.ListAllDatabasesByServer(ctx, server)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
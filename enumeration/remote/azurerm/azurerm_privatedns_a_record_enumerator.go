@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,9 +25,9 @@ func (e *AzurermPrivateDNSARecordEnumerator) SupportedType() resource.ResourceTy
 	return azurerm.AzurePrivateDNSARecordResourceType
 }
 
-func (e *AzurermPrivateDNSARecordEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AzurermPrivateDNSARecordEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 
-	zones, err := e.repository.ListAllPrivateZones()
+	zones, err := e.repository.ListAllPrivateZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzurePrivateDNSZoneResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *AzurermPrivateDNSARecordEnumerator) Enumerate() ([]*resource.Resource,
 	results := make([]*resource.Resource, 0)
 
 	for _, zone := range zones {
-		records, err := e.repository.ListAllARecords(zone)
+		records, err := e.repository.ListAllARecords(ctx, zone)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,9 +25,9 @@ func (e *AzurermPrivateDNSAAAARecordEnumerator) SupportedType() resource.Resourc
 	return azurerm.AzurePrivateDNSAAAARecordResourceType
 }
 
-func (e *AzurermPrivateDNSAAAARecordEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AzurermPrivateDNSAAAARecordEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 
-	zones, err := e.repository.ListAllPrivateZones()
+	zones, err := e.repository.ListAllPrivateZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzurePrivateDNSZoneResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *AzurermPrivateDNSAAAARecordEnumerator) Enumerate() ([]*resource.Resourc
 	results := make([]*resource.Resource, 0)
 
 	for _, zone := range zones {
-		records, err := e.repository.ListAllAAAARecords(zone)
+		records, err := e.repository.ListAllAAAARecords(ctx, zone)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
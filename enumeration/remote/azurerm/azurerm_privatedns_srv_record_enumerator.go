@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,9 +25,9 @@ func (e *AzurermPrivateDNSSRVRecordEnumerator) SupportedType() resource.Resource
 	return azurerm.AzurePrivateDNSSRVRecordResourceType
 }
 
-func (e *AzurermPrivateDNSSRVRecordEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AzurermPrivateDNSSRVRecordEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 
-	zones, err := e.repository.ListAllPrivateZones()
+	zones, err := e.repository.ListAllPrivateZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzurePrivateDNSZoneResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *AzurermPrivateDNSSRVRecordEnumerator) Enumerate() ([]*resource.Resource
 	results := make([]*resource.Resource, 0)
 
 	for _, zone := range zones {
-		records, err := e.repository.ListAllSRVRecords(zone)
+		records, err := e.repository.ListAllSRVRecords(ctx, zone)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
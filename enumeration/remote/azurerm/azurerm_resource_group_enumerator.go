@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermResourceGroupEnumerator) SupportedType() resource.ResourceType {
 	return azurerm.AzureResourceGroupResourceType
 }
 
-func (e *AzurermResourceGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	groups, err := e.repository.ListAllResourceGroups()
+func (e *AzurermResourceGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	groups, err := e.repository.ListAllResourceGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
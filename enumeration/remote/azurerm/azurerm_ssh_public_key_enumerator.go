@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermSSHPublicKeyEnumerator) SupportedType() resource.ResourceType {
 	return azurerm.AzureSSHPublicKeyResourceType
 }
 
-func (e *AzurermSSHPublicKeyEnumerator) Enumerate() ([]*resource.Resource, error) {
-	keys, err := e.repository.ListAllSSHPublicKeys()
+func (e *AzurermSSHPublicKeyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	keys, err := e.repository.ListAllSSHPublicKeys(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,30 +1,42 @@
 package azurerm
 
 import (
+	"net/http"
+
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/terraform"
 )
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, opts common.RemoteOptions) error {
 
-	provider, err := NewAzureTerraformProvider(version, progress, configDir)
-	if err != nil {
-		return err
+	// A provider already initialized in the library is reused, its plugin keeps running between scans
+	provider, isInitialized := providerLibrary.Provider(terraform.AZURE).(*AzureTerraformProvider)
+	if !isInitialized {
+		var err error
+		provider, err = NewAzureTerraformProvider(version, progress, configDir)
+		if err != nil {
+			return err
+		}
 	}
-	err = provider.CheckCredentialsExist()
+	err := provider.CheckCredentialsExist()
 	if err != nil {
 		return err
 	}
-	err = provider.Init()
-	if err != nil {
-		return err
+	provider.SetConcurrency(opts.GetConcurrency())
+	if !isInitialized {
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
 	}
 
 	providerConfig := provider.GetConfig()
@@ -33,6 +45,16 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 		return err
 	}
 	clientOptions := &arm.ClientOptions{}
+	// Failed requests are retried like the SDK does by default, except throttled ones as the scanner
+	// retries throttled enumerations
+	clientOptions.Retry.StatusCodes = []int{
+		http.StatusRequestTimeout,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+	clientOptions.PerCallPolicies = []policy.Policy{rateLimitPolicy{throttle.NewLimiter(opts.RateLimits)}}
 
 	c := cache.New(100)
 
@@ -46,33 +68,35 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 
 	providerLibrary.AddProvider(terraform.AZURE, provider)
 
-	remoteLibrary.AddEnumerator(NewAzurermStorageAccountEnumerator(storageAccountRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermStorageContainerEnumerator(storageAccountRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermVirtualNetworkEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermRouteTableEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermRouteEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermResourceGroupEnumerator(resourcesRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermSubnetEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermContainerRegistryEnumerator(containerRegistryRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermFirewallsEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPostgresqlServerEnumerator(postgresqlRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPublicIPEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPostgresqlDatabaseEnumerator(postgresqlRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermNetworkSecurityGroupEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermLoadBalancerEnumerator(networkRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermLoadBalancerRuleEnumerator(networkRepo, factory))
+	library := common.WithCache(remoteLibrary, opts.Cache, "azurerm/"+providerConfig.SubscriptionID, factory)
+
+	library.AddEnumerator(NewAzurermStorageAccountEnumerator(storageAccountRepo, factory))
+	library.AddEnumerator(NewAzurermStorageContainerEnumerator(storageAccountRepo, factory))
+	library.AddEnumerator(NewAzurermVirtualNetworkEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermRouteTableEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermRouteEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermResourceGroupEnumerator(resourcesRepo, factory))
+	library.AddEnumerator(NewAzurermSubnetEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermContainerRegistryEnumerator(containerRegistryRepo, factory))
+	library.AddEnumerator(NewAzurermFirewallsEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermPostgresqlServerEnumerator(postgresqlRepo, factory))
+	library.AddEnumerator(NewAzurermPublicIPEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermPostgresqlDatabaseEnumerator(postgresqlRepo, factory))
+	library.AddEnumerator(NewAzurermNetworkSecurityGroupEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermLoadBalancerEnumerator(networkRepo, factory))
+	library.AddEnumerator(NewAzurermLoadBalancerRuleEnumerator(networkRepo, factory))
 
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSZoneEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSARecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSAAAARecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSMXRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSCNameRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSPTRRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSSRVRecordEnumerator(privateDNSRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermPrivateDNSTXTRecordEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSZoneEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSARecordEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSAAAARecordEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSMXRecordEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSCNameRecordEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSPTRRecordEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSSRVRecordEnumerator(privateDNSRepo, factory))
+	library.AddEnumerator(NewAzurermPrivateDNSTXTRecordEnumerator(privateDNSRepo, factory))
 
-	remoteLibrary.AddEnumerator(NewAzurermImageEnumerator(computeRepo, factory))
-	remoteLibrary.AddEnumerator(NewAzurermSSHPublicKeyEnumerator(computeRepo, factory))
+	library.AddEnumerator(NewAzurermImageEnumerator(computeRepo, factory))
+	library.AddEnumerator(NewAzurermSSHPublicKeyEnumerator(computeRepo, factory))
 
 	return nil
 }
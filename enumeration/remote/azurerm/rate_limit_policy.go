@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
+)
+
+// rateLimitPolicy waits for the rate limit of the Azure service a request is sent to
+type rateLimitPolicy struct {
+	limiter *throttle.Limiter
+}
+
+func (p rateLimitPolicy) Do(req *policy.Request) (*http.Response, error) {
+	if err := p.limiter.Wait(req.Raw().Context(), azureService(req.Raw().URL.Path)); err != nil {
+		return nil, err
+	}
+	return req.Next()
+}
+
+// azureService returns the service of an Azure Resource Manager request from the resource provider
+// in its path, e.g. network for /subscriptions/ID/providers/Microsoft.Network/virtualNetworks
+func azureService(path string) string {
+	parts := strings.Split(strings.ToLower(path), "/")
+	for i := len(parts) - 2; i >= 0; i-- {
+		if parts[i] == "providers" && strings.HasPrefix(parts[i+1], "microsoft.") {
+			return strings.TrimPrefix(parts[i+1], "microsoft.")
+		}
+	}
+	// Resource groups are the only resources listed outside of a resource provider
+	return "resources"
+}
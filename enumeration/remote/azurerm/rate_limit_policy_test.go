@@ -0,0 +1,18 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureService(t *testing.T) {
+	cases := map[string]string{
+		"/subscriptions/008b5f48/providers/Microsoft.Network/virtualNetworks":                                   "network",
+		"/subscriptions/008b5f48/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa/blobServices": "storage",
+		"/subscriptions/008b5f48/resourcegroups":                                                                "resources",
+	}
+	for path, want := range cases {
+		assert.Equal(t, want, azureService(path), path)
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 type ContainerRegistryRepository interface {
-	ListAllContainerRegistries() ([]*armcontainerregistry.Registry, error)
+	ListAllContainerRegistries(ctx context.Context) ([]*armcontainerregistry.Registry, error)
 }
 
 type registryClient interface {
@@ -43,7 +43,7 @@ func NewContainerRegistryRepository(cred azcore.TokenCredential, options *arm.Cl
 	}
 }
 
-func (s *containerRegistryRepository) ListAllContainerRegistries() ([]*armcontainerregistry.Registry, error) {
+func (s *containerRegistryRepository) ListAllContainerRegistries(ctx context.Context) ([]*armcontainerregistry.Registry, error) {
 
 	if v := s.cache.Get("ListAllContainerRegistries"); v != nil {
 		return v.([]*armcontainerregistry.Registry), nil
@@ -51,7 +51,7 @@ func (s *containerRegistryRepository) ListAllContainerRegistries() ([]*armcontai
 
 	pager := s.registryClient.List(nil)
 	results := make([]*armcontainerregistry.Registry, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armprivatedns "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockPrivateDNSRepository struct {
 	mock.Mock
 }
 
-// ListAllAAAARecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllAAAARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllAAAARecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllAAAARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
-		return rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
+		return rf(ctx, zone)
 	}
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockPrivateDNSRepository) ListAllAAAARecords(zone *armprivatedns.Priva
 	return r0, r1
 }
 
-// ListAllARecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllARecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
-		return rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
+		return rf(ctx, zone)
 	}
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,25 +66,25 @@ func (_m *MockPrivateDNSRepository) ListAllARecords(zone *armprivatedns.PrivateZ
 	return r0, r1
 }
 
-// ListAllCNAMERecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllCNAMERecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllCNAMERecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllCNAMERecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
-		return rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
+		return rf(ctx, zone)
 	}
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,25 +92,25 @@ func (_m *MockPrivateDNSRepository) ListAllCNAMERecords(zone *armprivatedns.Priv
 	return r0, r1
 }
 
-// ListAllMXRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllMXRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllMXRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllMXRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
-		return rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
+		return rf(ctx, zone)
 	}
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,25 +118,25 @@ func (_m *MockPrivateDNSRepository) ListAllMXRecords(zone *armprivatedns.Private
 	return r0, r1
 }
 
-// ListAllPTRRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllPTRRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllPTRRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllPTRRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
-		return rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
+		return rf(ctx, zone)
 	}
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -142,25 +144,25 @@ func (_m *MockPrivateDNSRepository) ListAllPTRRecords(zone *armprivatedns.Privat
 	return r0, r1
 }
 
-// ListAllPrivateZones provides a mock function with given fields:
-func (_m *MockPrivateDNSRepository) ListAllPrivateZones() ([]*armprivatedns.PrivateZone, error) {
-	ret := _m.Called()
+// ListAllPrivateZones provides a mock function with given fields: ctx
+func (_m *MockPrivateDNSRepository) ListAllPrivateZones(ctx context.Context) ([]*armprivatedns.PrivateZone, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armprivatedns.PrivateZone
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armprivatedns.PrivateZone, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armprivatedns.PrivateZone, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armprivatedns.PrivateZone); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armprivatedns.PrivateZone); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.PrivateZone)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,25 +170,25 @@ func (_m *MockPrivateDNSRepository) ListAllPrivateZones() ([]*armprivatedns.Priv
 	return r0, r1
 }
 
-// ListAllSRVRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllSRVRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllSRVRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllSRVRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
-		return rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
+		return rf(ctx, zone)
 	}
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -194,25 +196,25 @@ func (_m *MockPrivateDNSRepository) ListAllSRVRecords(zone *armprivatedns.Privat
 	return r0, r1
 }
 
-// ListAllTXTRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllTXTRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllTXTRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllTXTRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
-		return rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)); ok {
+		return rf(ctx, zone)
 	}
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
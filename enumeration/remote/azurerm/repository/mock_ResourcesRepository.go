@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armresources "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockResourcesRepository struct {
 	mock.Mock
 }
 
-// ListAllResourceGroups provides a mock function with given fields:
-func (_m *MockResourcesRepository) ListAllResourceGroups() ([]*armresources.ResourceGroup, error) {
-	ret := _m.Called()
+// ListAllResourceGroups provides a mock function with given fields: ctx
+func (_m *MockResourcesRepository) ListAllResourceGroups(ctx context.Context) ([]*armresources.ResourceGroup, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armresources.ResourceGroup
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armresources.ResourceGroup, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armresources.ResourceGroup, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armresources.ResourceGroup); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armresources.ResourceGroup); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armresources.ResourceGroup)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
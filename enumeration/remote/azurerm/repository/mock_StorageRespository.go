@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armstorage "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,25 +14,25 @@ type MockStorageRespository struct {
 	mock.Mock
 }
 
-// ListAllStorageAccount provides a mock function with given fields:
-func (_m *MockStorageRespository) ListAllStorageAccount() ([]*armstorage.StorageAccount, error) {
-	ret := _m.Called()
+// ListAllStorageAccount provides a mock function with given fields: ctx
+func (_m *MockStorageRespository) ListAllStorageAccount(ctx context.Context) ([]*armstorage.StorageAccount, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armstorage.StorageAccount
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*armstorage.StorageAccount, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*armstorage.StorageAccount, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*armstorage.StorageAccount); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armstorage.StorageAccount); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armstorage.StorageAccount)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,25 +40,25 @@ func (_m *MockStorageRespository) ListAllStorageAccount() ([]*armstorage.Storage
 	return r0, r1
 }
 
-// ListAllStorageContainer provides a mock function with given fields: account
-func (_m *MockStorageRespository) ListAllStorageContainer(account *armstorage.StorageAccount) ([]string, error) {
-	ret := _m.Called(account)
+// ListAllStorageContainer provides a mock function with given fields: ctx, account
+func (_m *MockStorageRespository) ListAllStorageContainer(ctx context.Context, account *armstorage.StorageAccount) ([]string, error) {
+	ret := _m.Called(ctx, account)
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*armstorage.StorageAccount) ([]string, error)); ok {
-		return rf(account)
+	if rf, ok := ret.Get(0).(func(context.Context, *armstorage.StorageAccount) ([]string, error)); ok {
+		return rf(ctx, account)
 	}
-	if rf, ok := ret.Get(0).(func(*armstorage.StorageAccount) []string); ok {
-		r0 = rf(account)
+	if rf, ok := ret.Get(0).(func(context.Context, *armstorage.StorageAccount) []string); ok {
+		r0 = rf(ctx, account)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*armstorage.StorageAccount) error); ok {
-		r1 = rf(account)
+	if rf, ok := ret.Get(1).(func(context.Context, *armstorage.StorageAccount) error); ok {
+		r1 = rf(ctx, account)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -13,8 +13,8 @@ import (
 )
 
 type PostgresqlRespository interface {
-	ListAllServers() ([]*armpostgresql.Server, error)
-	ListAllDatabasesByServer(server *armpostgresql.Server) ([]*armpostgresql.Database, error)
+	ListAllServers(ctx context.Context) ([]*armpostgresql.Server, error)
+	ListAllDatabasesByServer(ctx context.Context, server *armpostgresql.Server) ([]*armpostgresql.Database, error)
 }
 
 type postgresqlServersClientImpl struct {
@@ -55,7 +55,7 @@ func NewPostgresqlRepository(cred azcore.TokenCredential, options *arm.ClientOpt
 	}
 }
 
-func (s *postgresqlRepository) ListAllServers() ([]*armpostgresql.Server, error) {
+func (s *postgresqlRepository) ListAllServers(ctx context.Context) ([]*armpostgresql.Server, error) {
 	cacheKey := "postgresqlListAllServers"
 
 	defer s.cache.Unlock(cacheKey)
@@ -63,7 +63,7 @@ func (s *postgresqlRepository) ListAllServers() ([]*armpostgresql.Server, error)
 		return v.([]*armpostgresql.Server), nil
 	}
 
-	res, err := s.serversClient.List(context.Background(), nil)
+	res, err := s.serversClient.List(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +72,7 @@ func (s *postgresqlRepository) ListAllServers() ([]*armpostgresql.Server, error)
 	return res.Value, nil
 }
 
-func (s *postgresqlRepository) ListAllDatabasesByServer(server *armpostgresql.Server) ([]*armpostgresql.Database, error) {
+func (s *postgresqlRepository) ListAllDatabasesByServer(ctx context.Context, server *armpostgresql.Server) ([]*armpostgresql.Database, error) {
 	res, err := azure.ParseResourceID(*server.ID)
 	if err != nil {
 		return nil, err
@@ -83,7 +83,7 @@ func (s *postgresqlRepository) ListAllDatabasesByServer(server *armpostgresql.Se
 		return v.([]*armpostgresql.Database), nil
 	}
 
-	result, err := s.databaseClient.ListByServer(context.Background(), res.ResourceGroup, *server.Name, nil)
+	result, err := s.databaseClient.ListByServer(ctx, res.ResourceGroup, *server.Name, nil)
 	if err != nil {
 		return nil, err
 	}
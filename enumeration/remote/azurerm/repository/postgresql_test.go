@@ -85,7 +85,7 @@ func Test_Postgresql_ListAllServers(t *testing.T) {
 				serversClient: fakeClient,
 				cache:         mockCache,
 			}
-			got, err := s.ListAllServers()
+			got, err := s.ListAllServers(context.Background())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -171,7 +171,7 @@ func Test_Postgresql_ListAllDatabases(t *testing.T) {
 				databaseClient: fakeClient,
 				cache:          mockCache,
 			}
-			got, err := s.ListAllDatabasesByServer(&armpostgresql.Server{
+			got, err := s.ListAllDatabasesByServer(context.Background(), &armpostgresql.Server{
 				TrackedResource: armpostgresql.TrackedResource{
 					Resource: armpostgresql.Resource{
 						ID:   to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res-group/providers/Microsoft.DBforPostgreSQL/servers/postgresql-server-1"),
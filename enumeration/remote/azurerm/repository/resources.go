@@ -11,7 +11,7 @@ import (
 )
 
 type ResourcesRepository interface {
-	ListAllResourceGroups() ([]*armresources.ResourceGroup, error)
+	ListAllResourceGroups(ctx context.Context) ([]*armresources.ResourceGroup, error)
 }
 
 type resourcesListPager interface {
@@ -43,7 +43,7 @@ func NewResourcesRepository(cred azcore.TokenCredential, options *arm.ClientOpti
 	}
 }
 
-func (s *resourcesRepository) ListAllResourceGroups() ([]*armresources.ResourceGroup, error) {
+func (s *resourcesRepository) ListAllResourceGroups(ctx context.Context) ([]*armresources.ResourceGroup, error) {
 	cacheKey := "resourcesListAllResourceGroups"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armresources.ResourceGroup), nil
@@ -51,7 +51,7 @@ func (s *resourcesRepository) ListAllResourceGroups() ([]*armresources.ResourceG
 
 	pager := s.client.List(nil)
 	results := make([]*armresources.ResourceGroup, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -38,7 +38,7 @@ func TestAzurermCompute_Image(t *testing.T) {
 		{
 			test: "no images",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*armcompute.Image{}, nil)
+				repository.On("ListAllImages", mock.Anything).Return([]*armcompute.Image{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -47,14 +47,14 @@ func TestAzurermCompute_Image(t *testing.T) {
 		{
 			test: "error listing images",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return(nil, dummyError)
+				repository.On("ListAllImages", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzureImageResourceType),
 		},
 		{
 			test: "multiple images including an invalid ID",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*armcompute.Image{
+				repository.On("ListAllImages", mock.Anything).Return([]*armcompute.Image{
 					{
 						Resource: armcompute.Resource{
 							ID:   to.StringPtr("/subscriptions/4e411884-65b0-4911-bc80-52f9a21942a2/resourceGroups/testgroup/providers/Microsoft.Compute/images/image1"),
@@ -131,7 +131,7 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			test:    "no public key",
 			dirName: "azurerm_ssh_public_key_empty",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return([]*armcompute.SSHPublicKeyResource{}, nil)
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return([]*armcompute.SSHPublicKeyResource{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -141,7 +141,7 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			test:    "error listing public keys",
 			dirName: "azurerm_ssh_public_key_empty",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return(nil, dummyError)
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -152,7 +152,7 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			test:    "multiple public keys",
 			dirName: "azurerm_ssh_public_key_multiple",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return([]*armcompute.SSHPublicKeyResource{
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return([]*armcompute.SSHPublicKeyResource{
 					{
 						Resource: armcompute.Resource{
 							ID:   to.StringPtr("/subscriptions/7bfb2c5c-7308-46ed-8ae4-fffa356eb406/resourceGroups/TESTRESGROUP/providers/Microsoft.Compute/sshPublicKeys/example-key"),
@@ -34,7 +34,7 @@ func TestAzurermContainerRegistry(t *testing.T) {
 		{
 			test: "no container registry",
 			mocks: func(repository *repository.MockContainerRegistryRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllContainerRegistries").Return([]*armcontainerregistry.Registry{}, nil)
+				repository.On("ListAllContainerRegistries", mock.Anything).Return([]*armcontainerregistry.Registry{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,14 +43,14 @@ func TestAzurermContainerRegistry(t *testing.T) {
 		{
 			test: "error listing container registry",
 			mocks: func(repository *repository.MockContainerRegistryRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllContainerRegistries").Return(nil, dummyError)
+				repository.On("ListAllContainerRegistries", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureContainerRegistryResourceType),
 		},
 		{
 			test: "multiple container registries",
 			mocks: func(repository *repository.MockContainerRegistryRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllContainerRegistries").Return([]*armcontainerregistry.Registry{
+				repository.On("ListAllContainerRegistries", mock.Anything).Return([]*armcontainerregistry.Registry{
 					{
 						Resource: armcontainerregistry.Resource{
 							ID: to.StringPtr("registry1"),
@@ -38,7 +38,7 @@ func TestAzurermVirtualNetwork(t *testing.T) {
 		{
 			test: "no virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return([]*armnetwork.VirtualNetwork{}, nil)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return([]*armnetwork.VirtualNetwork{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -47,14 +47,14 @@ func TestAzurermVirtualNetwork(t *testing.T) {
 		{
 			test: "error listing virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(nil, dummyError)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureVirtualNetworkResourceType),
 		},
 		{
 			test: "multiple virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return([]*armnetwork.VirtualNetwork{
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return([]*armnetwork.VirtualNetwork{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("network1"),
@@ -125,7 +125,7 @@ func TestAzurermRouteTables(t *testing.T) {
 		{
 			test: "no route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -134,14 +134,14 @@ func TestAzurermRouteTables(t *testing.T) {
 		{
 			test: "error listing route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return(nil, dummyError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureRouteTableResourceType),
 		},
 		{
 			test: "multiple route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("route1"),
@@ -212,7 +212,7 @@ func TestAzurermRoutes(t *testing.T) {
 		{
 			test: "no route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -221,7 +221,7 @@ func TestAzurermRoutes(t *testing.T) {
 		{
 			test: "no routes",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{
 					{
 						Properties: &armnetwork.RouteTablePropertiesFormat{
 							Routes: []*armnetwork.Route{},
@@ -241,14 +241,14 @@ func TestAzurermRoutes(t *testing.T) {
 		{
 			test: "error listing route tables",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return(nil, dummyError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureRouteResourceType, resourceazure.AzureRouteTableResourceType),
 		},
 		{
 			test: "multiple routes",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*armnetwork.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*armnetwork.RouteTable{
 					{
 						Resource: armnetwork.Resource{
 							Name: to.StringPtr("table1"),
@@ -368,9 +368,9 @@ func TestAzurermSubnets(t *testing.T) {
 		{
 			test: "no subnets",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(networks, nil)
-				repository.On("ListAllSubnets", networks[0]).Return([]*armnetwork.Subnet{}, nil).Times(1)
-				repository.On("ListAllSubnets", networks[1]).Return([]*armnetwork.Subnet{}, nil).Times(1)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(networks, nil)
+				repository.On("ListAllSubnets", mock.Anything, networks[0]).Return([]*armnetwork.Subnet{}, nil).Times(1)
+				repository.On("ListAllSubnets", mock.Anything, networks[1]).Return([]*armnetwork.Subnet{}, nil).Times(1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -379,23 +379,23 @@ func TestAzurermSubnets(t *testing.T) {
 		{
 			test: "error listing virtual network",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(nil, dummyError)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureSubnetResourceType, resourceazure.AzureVirtualNetworkResourceType),
 		},
 		{
 			test: "error listing subnets",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(networks, nil)
-				repository.On("ListAllSubnets", networks[0]).Return(nil, dummyError).Times(1)
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(networks, nil)
+				repository.On("ListAllSubnets", mock.Anything, networks[0]).Return(nil, dummyError).Times(1)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureSubnetResourceType),
 		},
 		{
 			test: "multiple subnets",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVirtualNetworks").Return(networks, nil)
-				repository.On("ListAllSubnets", networks[0]).Return([]*armnetwork.Subnet{
+				repository.On("ListAllVirtualNetworks", mock.Anything).Return(networks, nil)
+				repository.On("ListAllSubnets", mock.Anything, networks[0]).Return([]*armnetwork.Subnet{
 					{
 						SubResource: armnetwork.SubResource{
 							ID: to.StringPtr("subnet1"),
@@ -407,7 +407,7 @@ func TestAzurermSubnets(t *testing.T) {
 						},
 					},
 				}, nil).Times(1)
-				repository.On("ListAllSubnets", networks[1]).Return([]*armnetwork.Subnet{
+				repository.On("ListAllSubnets", mock.Anything, networks[1]).Return([]*armnetwork.Subnet{
 					{
 						SubResource: armnetwork.SubResource{
 							ID: to.StringPtr("subnet3"),
@@ -482,7 +482,7 @@ func TestAzurermFirewalls(t *testing.T) {
 		{
 			test: "no firewall",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllFirewalls").Return([]*armnetwork.AzureFirewall{}, nil)
+				repository.On("ListAllFirewalls", mock.Anything).Return([]*armnetwork.AzureFirewall{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -491,14 +491,14 @@ func TestAzurermFirewalls(t *testing.T) {
 		{
 			test: "error listing firewalls",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllFirewalls").Return(nil, dummyError)
+				repository.On("ListAllFirewalls", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureFirewallResourceType),
 		},
 		{
 			test: "multiple firewalls",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllFirewalls").Return([]*armnetwork.AzureFirewall{
+				repository.On("ListAllFirewalls", mock.Anything).Return([]*armnetwork.AzureFirewall{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("firewall1"), // Here we don't care to have a valid ID, it is for testing purpose only
@@ -569,7 +569,7 @@ func TestAzurermPublicIP(t *testing.T) {
 		{
 			test: "no public IP",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPublicIPAddresses").Return([]*armnetwork.PublicIPAddress{}, nil)
+				repository.On("ListAllPublicIPAddresses", mock.Anything).Return([]*armnetwork.PublicIPAddress{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -578,14 +578,14 @@ func TestAzurermPublicIP(t *testing.T) {
 		{
 			test: "error listing public IPs",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPublicIPAddresses").Return(nil, dummyError)
+				repository.On("ListAllPublicIPAddresses", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzurePublicIPResourceType),
 		},
 		{
 			test: "multiple public IP",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPublicIPAddresses").Return([]*armnetwork.PublicIPAddress{
+				repository.On("ListAllPublicIPAddresses", mock.Anything).Return([]*armnetwork.PublicIPAddress{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("ip1"), // Here we don't care to have a valid ID, it is for testing purpose only
@@ -658,7 +658,7 @@ func TestAzurermSecurityGroups(t *testing.T) {
 			test:    "no security group",
 			dirName: "azurerm_network_security_group_empty",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSecurityGroups").Return([]*armnetwork.NetworkSecurityGroup{}, nil)
+				repository.On("ListAllSecurityGroups", mock.Anything).Return([]*armnetwork.NetworkSecurityGroup{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -668,7 +668,7 @@ func TestAzurermSecurityGroups(t *testing.T) {
 			test:    "error listing security groups",
 			dirName: "azurerm_network_security_group_empty",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSecurityGroups").Return(nil, dummyError)
+				repository.On("ListAllSecurityGroups", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -679,7 +679,7 @@ func TestAzurermSecurityGroups(t *testing.T) {
 			test:    "multiple security groups",
 			dirName: "azurerm_network_security_group_multiple",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSecurityGroups").Return([]*armnetwork.NetworkSecurityGroup{
+				repository.On("ListAllSecurityGroups", mock.Anything).Return([]*armnetwork.NetworkSecurityGroup{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("/subscriptions/7bfb2c5c-7308-46ed-8ae4-fffa356eb406/resourceGroups/example-resources/providers/Microsoft.Network/networkSecurityGroups/acceptanceTestSecurityGroup1"),
@@ -775,7 +775,7 @@ func TestAzurermLoadBalancers(t *testing.T) {
 		{
 			test: "no load balancer",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -784,14 +784,14 @@ func TestAzurermLoadBalancers(t *testing.T) {
 		{
 			test: "error listing load balancers",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureLoadBalancerResourceType),
 		},
 		{
 			test: "multiple load balancers",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{
 					{
 						Resource: armnetwork.Resource{
 							ID:   to.StringPtr("lb-1"), // Here we don't care to have a valid ID, it is for testing purpose only
@@ -871,9 +871,9 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 					},
 				}
 
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
 
-				repository.On("ListLoadBalancerRules", loadbalancer).Return([]*armnetwork.LoadBalancingRule{}, nil)
+				repository.On("ListLoadBalancerRules", mock.Anything, loadbalancer).Return([]*armnetwork.LoadBalancingRule{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -883,7 +883,7 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 			test:    "error listing load balancer rules",
 			dirName: "azurerm_lb_rule_empty",
 			mocks: func(repository *repository.MockNetworkRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -901,9 +901,9 @@ func TestAzurermLoadBalancerRules(t *testing.T) {
 					},
 				}
 
-				repository.On("ListAllLoadBalancers").Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*armnetwork.LoadBalancer{loadbalancer}, nil)
 
-				repository.On("ListLoadBalancerRules", loadbalancer).Return([]*armnetwork.LoadBalancingRule{
+				repository.On("ListLoadBalancerRules", mock.Anything, loadbalancer).Return([]*armnetwork.LoadBalancingRule{
 					{
 						SubResource: armnetwork.SubResource{
 							ID: to.StringPtr("/subscriptions/8cb43347-a79f-4bb2-a8b4-c838b41fa5a5/resourceGroups/raphael-dev/providers/Microsoft.Network/loadBalancers/TestLoadBalancer/loadBalancingRules/LBRule"),
@@ -34,7 +34,7 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 		{
 			test: "no postgres server",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{}, nil)
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,14 +43,14 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 		{
 			test: "error listing postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return(nil, dummyError)
+				repository.On("ListAllServers", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlServerResourceType),
 		},
 		{
 			test: "multiple postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -126,7 +126,7 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 		{
 			test: "no postgres database",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{}, nil)
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -135,14 +135,14 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 		{
 			test: "error listing postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return(nil, dummyError)
+				repository.On("ListAllServers", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType, resourceazure.AzurePostgresqlServerResourceType),
 		},
 		{
 			test: "error listing postgres databases",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -153,14 +153,14 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 					},
 				}, nil).Once()
 
-				repository.On("ListAllDatabasesByServer", mock.IsType(&armpostgresql.Server{})).Return(nil, dummyError).Once()
+				repository.On("ListAllDatabasesByServer", mock.Anything, mock.IsType(&armpostgresql.Server{})).Return(nil, dummyError).Once()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType),
 		},
 		{
 			test: "multiple postgres databases",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -171,7 +171,7 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 					},
 				}, nil).Once()
 
-				repository.On("ListAllDatabasesByServer", mock.IsType(&armpostgresql.Server{})).Return([]*armpostgresql.Database{
+				repository.On("ListAllDatabasesByServer", mock.Anything, mock.IsType(&armpostgresql.Server{})).Return([]*armpostgresql.Database{
 					{
 						ProxyResource: armpostgresql.ProxyResource{
 							Resource: armpostgresql.Resource{
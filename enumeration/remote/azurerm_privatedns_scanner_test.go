@@ -41,7 +41,7 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			test:    "no private zone",
 			dirName: "azurerm_private_dns_private_zone_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -51,7 +51,7 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			test:    "error listing private zones",
 			dirName: "azurerm_private_dns_private_zone_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -62,7 +62,7 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			test:    "multiple private zones",
 			dirName: "azurerm_private_dns_private_zone_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -176,7 +176,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "no private a record",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -186,7 +186,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -197,7 +197,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "error listing private a records",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -207,7 +207,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllARecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllARecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -218,7 +218,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "multiple private a records",
 			dirName: "azurerm_private_dns_a_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -229,7 +229,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllARecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllARecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -343,7 +343,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "no private aaaa record",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -353,7 +353,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -364,7 +364,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "error listing private aaaa records",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -374,7 +374,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllAAAARecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllAAAARecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -385,7 +385,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "multiple private aaaaa records",
 			dirName: "azurerm_private_dns_aaaaa_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -396,7 +396,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllAAAARecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllAAAARecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -511,7 +511,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "no private cname record",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -521,7 +521,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -532,7 +532,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "error listing private cname records",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -542,7 +542,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllCNAMERecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllCNAMERecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -553,7 +553,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "multiple private cname records",
 			dirName: "azurerm_private_dns_cname_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -564,7 +564,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllCNAMERecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllCNAMERecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -667,7 +667,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "no private ptr record",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -677,7 +677,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -688,7 +688,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "error listing private ptr records",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -698,7 +698,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllPTRRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllPTRRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -709,7 +709,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "multiple private ptra records",
 			dirName: "azurerm_private_dns_ptr_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -720,7 +720,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllPTRRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllPTRRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -834,7 +834,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "no private mx record",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -844,7 +844,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -855,7 +855,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "error listing private mx records",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -865,7 +865,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllMXRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllMXRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -876,7 +876,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "multiple private mx records",
 			dirName: "azurerm_private_dns_mx_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -887,7 +887,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllMXRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllMXRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -1002,7 +1002,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "no private srv record",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1012,7 +1012,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1023,7 +1023,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "error listing private srv records",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1033,7 +1033,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllSRVRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllSRVRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1044,7 +1044,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "multiple private srv records",
 			dirName: "azurerm_private_dns_srv_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1055,7 +1055,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllSRVRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllSRVRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -1169,7 +1169,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "no private txt record",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1179,7 +1179,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1190,7 +1190,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "error listing private txt records",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1200,7 +1200,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllTXTRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllTXTRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1211,7 +1211,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "multiple private txt records",
 			dirName: "azurerm_private_dns_txt_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1222,7 +1222,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllTXTRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllTXTRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -33,7 +33,7 @@ func TestAzurermStorageAccount(t *testing.T) {
 		{
 			test: "no storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{}, nil)
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -42,14 +42,14 @@ func TestAzurermStorageAccount(t *testing.T) {
 		{
 			test: "error listing storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return(nil, dummyError)
+				repository.On("ListAllStorageAccount", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureStorageAccountResourceType),
 		},
 		{
 			test: "multiple storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{
 					{
 						TrackedResource: armstorage.TrackedResource{
 							Resource: armstorage.Resource{
@@ -123,7 +123,7 @@ func TestAzurermStorageContainer(t *testing.T) {
 		{
 			test: "no storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{}, nil)
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -146,12 +146,12 @@ func TestAzurermStorageContainer(t *testing.T) {
 						},
 					},
 				}
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{
 					account1,
 					account2,
 				}, nil)
-				repository.On("ListAllStorageContainer", account1).Return([]string{}, nil)
-				repository.On("ListAllStorageContainer", account2).Return([]string{}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account1).Return([]string{}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account2).Return([]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -160,7 +160,7 @@ func TestAzurermStorageContainer(t *testing.T) {
 		{
 			test: "error listing storage accounts",
 			mocks: func(repository *repository.MockStorageRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStorageAccount").Return(nil, dummyError)
+				repository.On("ListAllStorageAccount", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingErrorWithType(dummyError, resourceazure.AzureStorageContainerResourceType, resourceazure.AzureStorageAccountResourceType),
 		},
@@ -174,8 +174,8 @@ func TestAzurermStorageContainer(t *testing.T) {
 						},
 					},
 				}
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{account}, nil)
-				repository.On("ListAllStorageContainer", account).Return(nil, dummyError)
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{account}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureStorageContainerResourceType),
 		},
@@ -196,12 +196,12 @@ func TestAzurermStorageContainer(t *testing.T) {
 						},
 					},
 				}
-				repository.On("ListAllStorageAccount").Return([]*armstorage.StorageAccount{
+				repository.On("ListAllStorageAccount", mock.Anything).Return([]*armstorage.StorageAccount{
 					account1,
 					account2,
 				}, nil)
-				repository.On("ListAllStorageContainer", account1).Return([]string{"https://testeliedriftctl1.blob.core.windows.net/container1", "https://testeliedriftctl1.blob.core.windows.net/container2"}, nil)
-				repository.On("ListAllStorageContainer", account2).Return([]string{"https://testeliedriftctl2.blob.core.windows.net/container3", "https://testeliedriftctl2.blob.core.windows.net/container4"}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account1).Return([]string{"https://testeliedriftctl1.blob.core.windows.net/container1", "https://testeliedriftctl1.blob.core.windows.net/container2"}, nil)
+				repository.On("ListAllStorageContainer", mock.Anything, account2).Return([]string{"https://testeliedriftctl2.blob.core.windows.net/container3", "https://testeliedriftctl2.blob.core.windows.net/container4"}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 4)
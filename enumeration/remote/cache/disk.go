@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// TTL is how long enumerated resources stay in the disk cache
+type TTL struct {
+	// Default applies to resource types without a TTL of their own
+	Default time.Duration
+	// ByType overrides the default TTL for some resource types
+	ByType map[string]time.Duration
+}
+
+// For returns the TTL of a resource type, resources are not cached when it is zero
+func (t TTL) For(ty string) time.Duration {
+	if ttl, exists := t.ByType[ty]; exists {
+		return ttl
+	}
+	return t.Default
+}
+
+// IsEnabled returns whether any resource type is cached
+func (t TTL) IsEnabled() bool {
+	if t.Default > 0 {
+		return true
+	}
+	for _, ttl := range t.ByType {
+		if ttl > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTTL parses TTL values given either as a duration, applying to every
+// resource type, or as TYPE=DURATION
+func ParseTTL(values []string) (TTL, error) {
+	ttl := TTL{ByType: make(map[string]time.Duration)}
+	for _, value := range values {
+		ty, rawDuration, hasType := strings.Cut(value, "=")
+		if !hasType {
+			rawDuration = value
+		}
+		duration, err := time.ParseDuration(rawDuration)
+		if err != nil || duration < 0 {
+			return TTL{}, errors.Errorf("invalid cache TTL '%s', expected a duration (e.g. 10m) or TYPE=DURATION (e.g. aws_s3_bucket=1h)", value)
+		}
+		if hasType {
+			ttl.ByType[ty] = duration
+		} else {
+			ttl.Default = duration
+		}
+	}
+	return ttl, nil
+}
+
+type diskCacheEntry struct {
+	CreatedAt time.Time            `json:"created_at"`
+	Resources []diskCachedResource `json:"resources"`
+}
+
+type diskCachedResource struct {
+	Id    string                 `json:"id"`
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// DiskCache persists the resources listed for a resource type in a scope, such
+// as an account and a region, so that later scans can skip listing them again
+type DiskCache struct {
+	dir string
+	ttl TTL
+	now func() time.Time
+}
+
+func NewDiskCache(dir string, ttl TTL) *DiskCache {
+	return &DiskCache{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// Get returns the resources cached for the given scope and type, unless they expired
+func (c *DiskCache) Get(scope, ty string) ([]*resource.Resource, bool) {
+	ttl := c.ttl.For(ty)
+	if ttl <= 0 {
+		return nil, false
+	}
+
+	content, err := os.ReadFile(c.path(scope, ty))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logrus.WithFields(logrus.Fields{"type": ty, "scope": scope, "error": err}).Debug("Unable to read cached resources")
+		}
+		return nil, false
+	}
+
+	var entry diskCacheEntry
+	if err := json.Unmarshal(content, &entry); err != nil {
+		logrus.WithFields(logrus.Fields{"type": ty, "scope": scope, "error": err}).Debug("Ignoring invalid cached resources")
+		return nil, false
+	}
+	if c.now().Sub(entry.CreatedAt) > ttl {
+		return nil, false
+	}
+
+	resources := make([]*resource.Resource, 0, len(entry.Resources))
+	for _, cached := range entry.Resources {
+		attrs := resource.Attributes(cached.Attrs)
+		if attrs == nil {
+			attrs = resource.Attributes{}
+		}
+		resources = append(resources, &resource.Resource{
+			Id:    cached.Id,
+			Type:  cached.Type,
+			Attrs: &attrs,
+		})
+	}
+	return resources, true
+}
+
+// Put stores the resources listed for the given scope and type
+func (c *DiskCache) Put(scope, ty string, resources []*resource.Resource) error {
+	if c.ttl.For(ty) <= 0 {
+		return nil
+	}
+
+	entry := diskCacheEntry{
+		CreatedAt: c.now(),
+		Resources: make([]diskCachedResource, 0, len(resources)),
+	}
+	for _, res := range resources {
+		if res == nil {
+			continue
+		}
+		cached := diskCachedResource{Id: res.Id, Type: res.Type}
+		if res.Attrs != nil {
+			cached.Attrs = *res.Attrs
+		}
+		entry.Resources = append(entry.Resources, cached)
+	}
+
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	path := c.path(scope, ty)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	// The entry is written aside and renamed so that a concurrent scan never reads a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+var unsafePathChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// path returns the file of a cache entry, each part of the scope being a directory
+func (c *DiskCache) path(scope, ty string) string {
+	parts := []string{c.dir}
+	for _, part := range strings.Split(scope, "/") {
+		part = unsafePathChars.ReplaceAllString(part, "_")
+		if part == "" || part == "." || part == ".." {
+			part = "_"
+		}
+		parts = append(parts, part)
+	}
+	parts = append(parts, unsafePathChars.ReplaceAllString(ty, "_")+".json")
+	return filepath.Join(parts...)
+}
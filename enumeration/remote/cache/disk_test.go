@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTTL(t *testing.T) {
+	ttl, err := ParseTTL([]string{"10m", "aws_s3_bucket=1h", "aws_iam_user=0s"})
+	assert.NoError(t, err)
+	assert.True(t, ttl.IsEnabled())
+	assert.Equal(t, 10*time.Minute, ttl.For("aws_vpc"))
+	assert.Equal(t, time.Hour, ttl.For("aws_s3_bucket"))
+	assert.Equal(t, time.Duration(0), ttl.For("aws_iam_user"))
+
+	ttl, err = ParseTTL([]string{})
+	assert.NoError(t, err)
+	assert.False(t, ttl.IsEnabled())
+
+	ttl, err = ParseTTL([]string{"aws_s3_bucket=0s"})
+	assert.NoError(t, err)
+	assert.False(t, ttl.IsEnabled())
+
+	for _, value := range []string{"tomorrow", "aws_s3_bucket=", "-1m"} {
+		_, err = ParseTTL([]string{value})
+		assert.EqualError(t, err, "invalid cache TTL '"+value+"', expected a duration (e.g. 10m) or TYPE=DURATION (e.g. aws_s3_bucket=1h)")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2022, time.March, 15, 10, 0, 0, 0, time.UTC)
+
+	c := NewDiskCache(dir, TTL{
+		Default: 10 * time.Minute,
+		ByType:  map[string]time.Duration{"aws_s3_bucket": time.Hour, "aws_iam_user": 0},
+	})
+	c.now = func() time.Time { return now }
+
+	_, found := c.Get("aws/123456789012/us-east-1", "aws_vpc")
+	assert.False(t, found)
+
+	assert.NoError(t, c.Put("aws/123456789012/us-east-1", "aws_vpc", []*resource.Resource{
+		{Id: "vpc-1", Type: "aws_vpc", Attrs: &resource.Attributes{"cidr_block": "10.0.0.0/16"}},
+		{Id: "vpc-2", Type: "aws_vpc"},
+	}))
+	assert.NoError(t, c.Put("aws/123456789012/us-east-1", "aws_s3_bucket", []*resource.Resource{{Id: "bucket", Type: "aws_s3_bucket"}}))
+	assert.NoError(t, c.Put("aws/123456789012/us-east-1", "aws_iam_user", []*resource.Resource{{Id: "user", Type: "aws_iam_user"}}))
+
+	resources, found := c.Get("aws/123456789012/us-east-1", "aws_vpc")
+	assert.True(t, found)
+	assert.Equal(t, []*resource.Resource{
+		{Id: "vpc-1", Type: "aws_vpc", Attrs: &resource.Attributes{"cidr_block": "10.0.0.0/16"}},
+		{Id: "vpc-2", Type: "aws_vpc", Attrs: &resource.Attributes{}},
+	}, resources)
+
+	// Resources are cached by scope
+	_, found = c.Get("aws/123456789012/eu-west-3", "aws_vpc")
+	assert.False(t, found)
+
+	// Types with a zero TTL are never cached
+	_, found = c.Get("aws/123456789012/us-east-1", "aws_iam_user")
+	assert.False(t, found)
+	_, err := os.Stat(filepath.Join(dir, "aws", "123456789012", "us-east-1", "aws_iam_user.json"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Entries expire according to the TTL of their type
+	now = now.Add(30 * time.Minute)
+	_, found = c.Get("aws/123456789012/us-east-1", "aws_vpc")
+	assert.False(t, found)
+	_, found = c.Get("aws/123456789012/us-east-1", "aws_s3_bucket")
+	assert.True(t, found)
+}
+
+func TestDiskCache_InvalidEntry(t *testing.T) {
+	dir := t.TempDir()
+	c := NewDiskCache(dir, TTL{Default: time.Hour})
+
+	path := filepath.Join(dir, "github", "my-org", "github_team.json")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	assert.NoError(t, os.WriteFile(path, []byte("not json"), 0600))
+
+	_, found := c.Get("github/my-org", "github_team")
+	assert.False(t, found)
+}
+
+func TestDiskCache_Path(t *testing.T) {
+	c := NewDiskCache("/cache", TTL{})
+	assert.Equal(t, "/cache/google/_/google_storage_bucket.json", c.path("google/", "google_storage_bucket"))
+	assert.Equal(t, "/cache/azurerm/_/_/azurerm_resource_group.json", c.path("azurerm/../..", "azurerm_resource_group"))
+	assert.Equal(t, "/cache/github/my_org/github_team.json", c.path("github/my org", "github_team"))
+}
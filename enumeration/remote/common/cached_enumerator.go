@@ -0,0 +1,80 @@
+package common
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// EnumerationCache keeps the resources listed by enumerators between scans
+type EnumerationCache interface {
+	Get(scope, ty string) ([]*resource.Resource, bool)
+	Put(scope, ty string, resources []*resource.Resource) error
+}
+
+// EnumeratorLibrary is implemented by the remote library and by the wrappers registering enumerators in it
+type EnumeratorLibrary interface {
+	AddEnumerator(enumerator Enumerator)
+}
+
+// CachedEnumerator wraps an enumerator and reads its resources from the cache when they have
+// been listed recently, scope tells apart resources of different accounts, projects or regions.
+type CachedEnumerator struct {
+	Enumerator
+	cache   EnumerationCache
+	scope   string
+	factory resource.ResourceFactory
+}
+
+func NewCachedEnumerator(enumerator Enumerator, cache EnumerationCache, scope string, factory resource.ResourceFactory) *CachedEnumerator {
+	return &CachedEnumerator{
+		Enumerator: enumerator,
+		cache:      cache,
+		scope:      scope,
+		factory:    factory,
+	}
+}
+
+func (e *CachedEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ty := string(e.SupportedType())
+
+	if cached, found := e.cache.Get(e.scope, ty); found {
+		logrus.WithFields(logrus.Fields{"type": ty, "scope": e.scope}).Debug("Using cached resources")
+		// Resources are created again so that they get their schema back
+		resources := make([]*resource.Resource, 0, len(cached))
+		for _, res := range cached {
+			resources = append(resources, e.factory.CreateAbstractResource(res.Type, res.Id, *res.Attrs))
+		}
+		return resources, nil
+	}
+
+	resources, err := e.Enumerator.Enumerate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.cache.Put(e.scope, ty, resources); err != nil {
+		logrus.WithFields(logrus.Fields{"type": ty, "scope": e.scope, "error": err}).Debug("Unable to cache resources")
+	}
+	return resources, nil
+}
+
+type cachedLibrary struct {
+	library EnumeratorLibrary
+	cache   EnumerationCache
+	scope   string
+	factory resource.ResourceFactory
+}
+
+func (l cachedLibrary) AddEnumerator(enumerator Enumerator) {
+	l.library.AddEnumerator(NewCachedEnumerator(enumerator, l.cache, l.scope, l.factory))
+}
+
+// WithCache returns a library registering enumerators whose resources are cached under the
+// given scope, the library is returned as is when no cache is used
+func WithCache(library EnumeratorLibrary, cache EnumerationCache, scope string, factory resource.ResourceFactory) EnumeratorLibrary {
+	if cache == nil {
+		return library
+	}
+	return cachedLibrary{library, cache, scope, factory}
+}
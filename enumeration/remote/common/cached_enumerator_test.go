@@ -0,0 +1,53 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCachedEnumerator(t *testing.T) {
+	diskCache := cache.NewDiskCache(t.TempDir(), cache.TTL{Default: time.Hour})
+	factory := terraform.NewTerraformResourceFactory()
+
+	fakeEnumerator := &MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{
+		{Id: "id", Type: "FakeType", Attrs: &resource.Attributes{"name": "fake"}},
+	}, nil).Once()
+
+	library := NewRemoteLibrary()
+	WithCache(library, diskCache, "fake/account", factory).AddEnumerator(fakeEnumerator)
+	enumerator := library.Enumerators()[0]
+	assert.Equal(t, resource.ResourceType("FakeType"), enumerator.SupportedType())
+
+	for i := 0; i < 2; i++ {
+		resources, err := enumerator.Enumerate(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []*resource.Resource{
+			{Id: "id", Type: "FakeType", Attrs: &resource.Attributes{"name": "fake"}},
+		}, resources)
+	}
+	// Resources are listed once, then read from the cache
+	fakeEnumerator.AssertNumberOfCalls(t, "Enumerate", 1)
+
+	// Another scope does not share cached resources
+	otherEnumerator := &MockEnumerator{}
+	otherEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	otherEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{}, nil)
+	resources, err := NewCachedEnumerator(otherEnumerator, diskCache, "fake/other-account", factory).Enumerate(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, resources)
+	otherEnumerator.AssertNumberOfCalls(t, "Enumerate", 1)
+}
+
+func TestWithCache_NoCache(t *testing.T) {
+	library := NewRemoteLibrary()
+	assert.Same(t, library, WithCache(library, nil, "fake/account", terraform.NewTerraformResourceFactory()))
+}
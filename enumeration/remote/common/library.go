@@ -1,12 +1,14 @@
 package common
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/resource"
 )
 
 type Enumerator interface {
 	SupportedType() resource.ResourceType
-	Enumerate() ([]*resource.Resource, error)
+	Enumerate(ctx context.Context) ([]*resource.Resource, error)
 }
 
 type RemoteLibrary struct {
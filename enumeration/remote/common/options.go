@@ -0,0 +1,70 @@
+package common
+
+import (
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
+)
+
+// RemoteOptions holds user provided settings used to initialize a remote.
+// Each remote only reads the fields that are relevant to it.
+type RemoteOptions struct {
+	// AWSRegions is the list of AWS regions to enumerate, use AWSAllRegions to enumerate every enabled region.
+	// When empty, only the region of the current AWS session is enumerated.
+	AWSRegions []string
+	// AWSAccounts and AWSOrganizationalUnit select the AWS accounts to enumerate by assuming AWSAssumeRoleName
+	// in each of them. When both are empty, only the account of the current AWS session is enumerated.
+	AWSAccounts             []string
+	AWSOrganizationalUnit   string
+	AWSAssumeRoleName       string
+	AWSAssumeRoleExternalID string
+	// Cache keeps enumerated resources between scans, resources are always listed from the
+	// cloud provider when nil
+	Cache EnumerationCache
+	// Concurrency is the number of enumerators running at the same time, DefaultConcurrency is used when zero
+	Concurrency int
+	// MaxRetries is the number of times listing a resource type is retried when the cloud provider throttles
+	// requests, DefaultMaxRetries is used when nil. Enumerations are only retried once the clients of the cloud
+	// providers gave up retrying the throttled request, the Terraform provider retries as many times its throttled
+	// requests when reading resources.
+	MaxRetries *int
+	// RateLimits caps the number of requests per second sent to each service of the cloud provider
+	RateLimits throttle.RateLimits
+	// BestEffort keeps scanning when a resource type cannot be listed, the type is reported as failed
+	// instead of aborting the scan
+	BestEffort bool
+	// Timeout bounds the time spent listing resources, types still being listed when it expires are
+	// reported as timed out and left out of the results. Zero means no timeout.
+	Timeout time.Duration
+	// EnumeratorTimeouts bounds the time spent listing each resource type, a type that cannot be listed
+	// in time is reported as timed out and left out of the results
+	EnumeratorTimeouts Timeouts
+}
+
+const AWSAllRegions = "all"
+
+const (
+	DefaultConcurrency = 10
+	DefaultMaxRetries  = 10
+)
+
+// GetConcurrency returns the number of enumerators running at the same time
+func (o RemoteOptions) GetConcurrency() int {
+	if o.Concurrency <= 0 {
+		return DefaultConcurrency
+	}
+	return o.Concurrency
+}
+
+// GetMaxRetries returns the number of times a throttled enumeration is retried
+func (o RemoteOptions) GetMaxRetries() int {
+	if o.MaxRetries == nil {
+		return DefaultMaxRetries
+	}
+	return *o.MaxRetries
+}
+
+// IsAWSMultiAccount returns true when accounts other than the one of the current AWS session have to be enumerated
+func (o RemoteOptions) IsAWSMultiAccount() bool {
+	return len(o.AWSAccounts) > 0 || o.AWSOrganizationalUnit != ""
+}
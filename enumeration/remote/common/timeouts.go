@@ -0,0 +1,46 @@
+package common
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Timeouts bounds the time spent listing resource types
+type Timeouts struct {
+	// Default applies to resource types without a timeout of their own
+	Default time.Duration
+	// ByType overrides the default timeout for some resource types
+	ByType map[string]time.Duration
+}
+
+// For returns the timeout of a resource type, listing it is not bounded when it is zero
+func (t Timeouts) For(ty string) time.Duration {
+	if timeout, exists := t.ByType[ty]; exists {
+		return timeout
+	}
+	return t.Default
+}
+
+// ParseTimeouts parses timeouts given either as a duration, applying to every
+// resource type, or as TYPE=DURATION
+func ParseTimeouts(values []string) (Timeouts, error) {
+	timeouts := Timeouts{ByType: make(map[string]time.Duration)}
+	for _, value := range values {
+		ty, rawDuration, hasType := strings.Cut(value, "=")
+		if !hasType {
+			rawDuration = value
+		}
+		duration, err := time.ParseDuration(rawDuration)
+		if err != nil || duration < 0 {
+			return Timeouts{}, errors.Errorf("invalid enumerator timeout '%s', expected a duration (e.g. 5m) or TYPE=DURATION (e.g. aws_s3_bucket=30s)", value)
+		}
+		if hasType {
+			timeouts.ByType[ty] = duration
+		} else {
+			timeouts.Default = duration
+		}
+	}
+	return timeouts, nil
+}
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeouts(t *testing.T) {
+	timeouts, err := ParseTimeouts([]string{"5m", "aws_s3_bucket=30s", "aws_iam_user=0s"})
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, timeouts.For("aws_vpc"))
+	assert.Equal(t, 30*time.Second, timeouts.For("aws_s3_bucket"))
+	assert.Equal(t, time.Duration(0), timeouts.For("aws_iam_user"))
+
+	timeouts, err = ParseTimeouts([]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeouts.For("aws_vpc"))
+
+	for _, value := range []string{"soon", "aws_s3_bucket=", "-1m"} {
+		_, err = ParseTimeouts([]string{value})
+		assert.EqualError(t, err, "invalid enumerator timeout '"+value+"', expected a duration (e.g. 5m) or TYPE=DURATION (e.g. aws_s3_bucket=30s)")
+	}
+}
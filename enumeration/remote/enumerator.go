@@ -0,0 +1,357 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	tfterraform "github.com/hashicorp/terraform/terraform"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/diagnostic"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// EnumeratorConfig selects the cloud provider an Enumerator lists resources from
+type EnumeratorConfig struct {
+	// To is the cloud provider to enumerate, e.g. aws+tf, see GetSupportedRemotes
+	To string
+	// ProviderVersion is the version of the Terraform provider used to read resources
+	ProviderVersion string
+	// ConfigDir is where Terraform providers are downloaded to
+	ConfigDir string
+	// Options holds the settings of the cloud provider, e.g. AWS regions or accounts, and
+	// how many requests are sent to it at the same time
+	Options common.RemoteOptions
+	// Progress is incremented for each resource read from the cloud provider, it may be nil
+	Progress enumeration.ProgressCounter
+}
+
+type noopProgress struct{}
+
+func (noopProgress) Inc() {}
+
+// Enumerator lists resources from a cloud provider and reads their details.
+// It implements enumeration.Enumerator and enumeration.Refresher so that
+// driftctl's enumeration can be used as a library:
+//
+//	enumerator, err := remote.NewEnumerator(remote.EnumeratorConfig{To: "aws+tf", ProviderVersion: "3.19.0"})
+//	if err != nil {
+//		return err
+//	}
+//	defer enumerator.Close()
+//
+//	out, err := enumerator.Enumerate(&enumeration.EnumerateInput{ResourceTypes: []string{"aws_s3_bucket"}})
+//
+// Credentials of the cloud provider are read from the environment, the same
+// way the driftctl CLI does. Calls are not meant to run concurrently.
+type Enumerator struct {
+	config          EnumeratorConfig
+	providerLibrary *terraform.ProviderLibrary
+	factory         resource.ResourceFactory
+	mu              sync.Mutex
+	// activate registers the enumerators of the cloud provider in the library
+	activate func(remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInterface) error
+}
+
+// NewEnumerator returns an enumerator of the given cloud provider, the Terraform
+// provider is started on first use and stopped by Close
+func NewEnumerator(config EnumeratorConfig) (*Enumerator, error) {
+	if !IsSupported(config.To) {
+		return nil, errors.Errorf("unsupported cloud provider '%s'", config.To)
+	}
+	if config.Progress == nil {
+		config.Progress = noopProgress{}
+	}
+
+	e := &Enumerator{
+		config:          config,
+		providerLibrary: terraform.NewProviderLibrary(),
+		factory:         terraform.NewTerraformResourceFactory(),
+	}
+	e.activate = func(remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInterface) error {
+		return Activate(config.To, config.ProviderVersion, alerter, e.providerLibrary, remoteLibrary, config.Progress, e.factory, config.ConfigDir, config.Options)
+	}
+	return e, nil
+}
+
+// Enumerate lists resources of the given types, every supported type is listed
+// when none is given. Access denied errors and unsupported types are reported
+// as diagnostics, other errors abort the enumeration unless Options.BestEffort
+// is set, in which case they are reported as diagnostics too. Types not listed
+// in time when Options.Timeout or Options.EnumeratorTimeouts are set are
+// reported as diagnostics as well.
+func (e *Enumerator) Enumerate(input *enumeration.EnumerateInput) (*enumeration.EnumerateOutput, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// Enumerators and the caches of their repositories are created for each call so that
+	// resources are always listed again, the Terraform provider is reused though
+	remoteLibrary := common.NewRemoteLibrary()
+	alerts := alerter.NewAlerter()
+	if err := e.activate(remoteLibrary, alerts); err != nil {
+		return nil, err
+	}
+
+	supportedTypes := make(map[string]struct{})
+	for _, enumerator := range remoteLibrary.Enumerators() {
+		supportedTypes[string(enumerator.SupportedType())] = struct{}{}
+	}
+
+	types := make(map[string]struct{})
+	if input != nil {
+		for _, ty := range input.ResourceTypes {
+			types[ty] = struct{}{}
+		}
+	}
+	if len(types) == 0 {
+		types = supportedTypes
+	}
+
+	output := &enumeration.EnumerateOutput{
+		Resources: make(map[string][]*resource.Resource, len(types)),
+		Timings:   make(map[string]time.Duration, len(types)),
+	}
+	for ty := range types {
+		if _, supported := supportedTypes[ty]; !supported {
+			alerts.SendAlert(ty, alerter.NewUnsupportedResourcetypeAlert(ty))
+			output.Resources[ty] = nil
+			continue
+		}
+		output.Resources[ty] = []*resource.Resource{}
+	}
+
+	scanner := NewScannerWithOptions(remoteLibrary, alerts, typeFilter(types), e.config.Options)
+	resources, err := scanner.Resources()
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range resources {
+		output.Resources[res.ResourceType()] = append(output.Resources[res.ResourceType()], res)
+	}
+	for ty := range output.Resources {
+		sortResources(output.Resources[ty])
+	}
+	for ty, duration := range scanner.Timings() {
+		output.Timings[ty] = duration
+	}
+	output.Diagnostics = sortDiagnostics(diagnostic.FromAlerts(alerts.Retrieve()))
+
+	return output, nil
+}
+
+// Refresh reads the details of the given resources from the cloud provider, the
+// resources are expected to come from Enumerate. Resources that no longer exist
+// are left out and resources that cannot be read, or not in time when Options.Timeout
+// is set, are reported as diagnostics. Resources the provider must not read, e.g.
+// secrets, are returned as they were listed.
+func (e *Enumerator) Refresh(input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error) {
+	ctx := context.Background()
+	if e.config.Options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.config.Options.Timeout)
+		defer cancel()
+	}
+
+	provider, err := e.provider()
+	if err != nil {
+		return nil, err
+	}
+
+	output := &enumeration.RefreshOutput{
+		Resources: make(map[string][]*resource.Resource, len(input.Resources)),
+	}
+
+	type result struct {
+		res *resource.Resource
+		err error
+	}
+	results := make([]result, 0)
+	resultsMu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, e.config.Options.GetConcurrency())
+
+	for ty, resources := range input.Resources {
+		output.Resources[ty] = []*resource.Resource{}
+		for _, res := range resources {
+			res := res
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				refreshed, err := e.refresh(ctx, provider, res)
+				resultsMu.Lock()
+				defer resultsMu.Unlock()
+				if err != nil {
+					results = append(results, result{res: res, err: err})
+					return
+				}
+				if refreshed != nil {
+					results = append(results, result{res: refreshed})
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		if r.err != nil {
+			output.Diagnostics = append(output.Diagnostics, diagnostic.FromError(r.err, r.res))
+			continue
+		}
+		output.Resources[r.res.ResourceType()] = append(output.Resources[r.res.ResourceType()], r.res)
+	}
+	for ty := range output.Resources {
+		sortResources(output.Resources[ty])
+	}
+	output.Diagnostics = sortDiagnostics(output.Diagnostics)
+
+	return output, nil
+}
+
+// refresh reads a resource, nil is returned when it does not exist anymore
+func (e *Enumerator) refresh(ctx context.Context, provider terraform.TerraformProvider, res *resource.Resource) (*resource.Resource, error) {
+	if skipper, ok := provider.(terraform.ResourceReadSkipper); ok && skipper.SkipReadResource(resource.ResourceType(res.ResourceType())) {
+		data := map[string]interface{}{"id": res.ResourceId()}
+		if res.Attrs != nil {
+			for key, value := range *res.Attrs {
+				data[key] = value
+			}
+		}
+		return e.located(res, data), nil
+	}
+
+	attributes := make(map[string]string)
+	if res.Attrs != nil {
+		for key, value := range *res.Attrs {
+			if str, isString := value.(string); isString {
+				attributes[key] = str
+			}
+		}
+	}
+	args := terraform.ReadResourceArgs{
+		Ty:         resource.ResourceType(res.ResourceType()),
+		ID:         res.ResourceId(),
+		Attributes: attributes,
+	}
+	if preparer, ok := provider.(terraform.ReadResourceArgsPreparer); ok {
+		preparer.PrepareReadResourceArgs(res, &args)
+	}
+
+	value, err := provider.ReadResource(ctx, args)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read %s.%s", res.ResourceType(), res.ResourceId())
+	}
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+
+	content, err := ctyjson.Marshal(*value, value.Type())
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+	// Sensitive attributes, e.g. the value of a SSM parameter, are never returned
+	if schema, exists := provider.Schema()[res.ResourceType()]; exists && schema.Block != nil {
+		for name, attribute := range schema.Block.Attributes {
+			if attribute.Sensitive {
+				delete(data, name)
+			}
+		}
+	}
+	return e.located(res, data), nil
+}
+
+// located creates a resource with the given attributes in the location of a listed resource
+func (e *Enumerator) located(res *resource.Resource, data map[string]interface{}) *resource.Resource {
+	located := e.factory.CreateAbstractResource(res.ResourceType(), res.ResourceId(), data)
+	located.Account = res.Account
+	located.Region = res.Region
+	return located
+}
+
+// GetSchema returns the schema of the Terraform provider
+func (e *Enumerator) GetSchema() (*enumeration.GetSchemasOutput, error) {
+	provider, err := e.provider()
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &tfterraform.ProviderSchema{
+		ResourceTypes:              make(map[string]*configschema.Block),
+		ResourceTypeSchemaVersions: make(map[string]uint64),
+	}
+	for ty, s := range provider.Schema() {
+		schema.ResourceTypes[ty] = s.Block
+		schema.ResourceTypeSchemaVersions[ty] = uint64(s.Version)
+	}
+	return &enumeration.GetSchemasOutput{Schema: schema}, nil
+}
+
+// Close stops the Terraform provider
+func (e *Enumerator) Close() {
+	e.providerLibrary.Cleanup()
+}
+
+// provider returns the Terraform provider, starting it when needed
+func (e *Enumerator) provider() (terraform.TerraformProvider, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	name := common.RemoteParameter(e.config.To).GetProviderAddress().Type
+	if provider := e.providerLibrary.Provider(name); provider != nil {
+		return provider, nil
+	}
+
+	if err := e.activate(common.NewRemoteLibrary(), alerter.NewAlerter()); err != nil {
+		return nil, err
+	}
+	provider := e.providerLibrary.Provider(name)
+	if provider == nil {
+		return nil, errors.Errorf("%s provider was not initialized", name)
+	}
+	return provider, nil
+}
+
+// typeFilter only keeps the enumerators of some resource types
+type typeFilter map[string]struct{}
+
+func (f typeFilter) IsTypeIgnored(ty resource.ResourceType) bool {
+	_, exists := f[string(ty)]
+	return !exists
+}
+
+func (f typeFilter) IsResourceIgnored(res *resource.Resource) bool {
+	return f.IsTypeIgnored(resource.ResourceType(res.ResourceType()))
+}
+
+func sortResources(resources []*resource.Resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].ResourceId() < resources[j].ResourceId()
+	})
+}
+
+func sortDiagnostics(diagnostics diagnostic.Diagnostics) diagnostic.Diagnostics {
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		return fmt.Sprintf("%s %s", diagnostics[i].ResourceType(), diagnostics[i].Message()) <
+			fmt.Sprintf("%s %s", diagnostics[j].ResourceType(), diagnostics[j].Message())
+	})
+	return diagnostics
+}
+
+var _ enumeration.Enumerator = (*Enumerator)(nil)
+var _ enumeration.Refresher = (*Enumerator)(nil)
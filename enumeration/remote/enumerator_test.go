@@ -0,0 +1,313 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/diagnostic"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// fakeProvider reads resources from a map of values indexed by resource ID
+type fakeProvider struct {
+	values  map[string]cty.Value
+	args    []terraform.ReadResourceArgs
+	prepare func(res *resource.Resource, args *terraform.ReadResourceArgs)
+	skip    func(ty resource.ResourceType) bool
+}
+
+func (p *fakeProvider) Schema() map[string]providers.Schema {
+	return map[string]providers.Schema{
+		"aws_s3_bucket": {
+			Version: 1,
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id":     {Type: cty.String, Computed: true},
+					"bucket": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		"aws_ssm_parameter": {
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id":    {Type: cty.String, Computed: true},
+					"name":  {Type: cty.String, Required: true},
+					"value": {Type: cty.String, Required: true, Sensitive: true},
+				},
+			},
+		},
+	}
+}
+
+func (p *fakeProvider) ReadResource(_ context.Context, args terraform.ReadResourceArgs) (*cty.Value, error) {
+	p.args = append(p.args, args)
+	if args.ID == "broken" {
+		return nil, errors.New("read failed")
+	}
+	value, exists := p.values[args.ID]
+	if !exists {
+		value = cty.NullVal(cty.DynamicPseudoType)
+	}
+	return &value, nil
+}
+
+func (p *fakeProvider) PrepareReadResourceArgs(res *resource.Resource, args *terraform.ReadResourceArgs) {
+	if p.prepare != nil {
+		p.prepare(res, args)
+	}
+}
+
+func (p *fakeProvider) SkipReadResource(ty resource.ResourceType) bool {
+	return p.skip != nil && p.skip(ty)
+}
+
+func (p *fakeProvider) Cleanup()        {}
+func (p *fakeProvider) Name() string    { return terraform.AWS }
+func (p *fakeProvider) Version() string { return "3.19.0" }
+
+func newFakeEnumerator(ty string, resources []*resource.Resource, err error) *common.MockEnumerator {
+	enumerator := &common.MockEnumerator{}
+	enumerator.On("SupportedType").Return(resource.ResourceType(ty))
+	enumerator.On("Enumerate", mock.Anything).Return(resources, err)
+	return enumerator
+}
+
+func newTestEnumerator(t *testing.T, provider terraform.TerraformProvider, enumerators ...common.Enumerator) *Enumerator {
+	e, err := NewEnumerator(EnumeratorConfig{To: common.RemoteAWSTerraform})
+	assert.NoError(t, err)
+	e.activate = func(remoteLibrary *common.RemoteLibrary, _ alerter.AlerterInterface) error {
+		for _, enumerator := range enumerators {
+			remoteLibrary.AddEnumerator(enumerator)
+		}
+		if provider != nil {
+			e.providerLibrary.AddProvider(terraform.AWS, provider)
+		}
+		return nil
+	}
+	return e
+}
+
+func TestNewEnumerator_Unsupported(t *testing.T) {
+	_, err := NewEnumerator(EnumeratorConfig{To: "foobar"})
+	assert.EqualError(t, err, "unsupported cloud provider 'foobar'")
+}
+
+func TestEnumerator_Enumerate(t *testing.T) {
+	accessDenied := remoteerr.NewResourceListingError(awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, ""), "aws_iam_user")
+
+	e := newTestEnumerator(t, nil,
+		newFakeEnumerator("aws_s3_bucket", []*resource.Resource{
+			{Id: "bucket-2", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}},
+			{Id: "bucket-1", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}},
+		}, nil),
+		newFakeEnumerator("aws_iam_user", nil, accessDenied),
+		newFakeEnumerator("aws_sqs_queue", []*resource.Resource{
+			{Id: "queue", Type: "aws_sqs_queue", Attrs: &resource.Attributes{}},
+		}, nil),
+	)
+
+	got, err := e.Enumerate(&enumeration.EnumerateInput{
+		ResourceTypes: []string{"aws_s3_bucket", "aws_iam_user", "aws_unknown"},
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, got.Resources, 3)
+	assert.Len(t, got.Resources["aws_s3_bucket"], 2)
+	assert.Equal(t, "bucket-1", got.Resources["aws_s3_bucket"][0].ResourceId())
+	assert.Empty(t, got.Resources["aws_iam_user"])
+	assert.Empty(t, got.Resources["aws_unknown"])
+	assert.NotContains(t, got.Resources, "aws_sqs_queue")
+
+	assert.Contains(t, got.Timings, "aws_s3_bucket")
+	assert.Contains(t, got.Timings, "aws_iam_user")
+	assert.NotContains(t, got.Timings, "aws_sqs_queue")
+
+	assert.Len(t, got.Diagnostics, 2)
+	assert.Equal(t, diagnostic.CodeAccessDenied, got.Diagnostics[0].Code())
+	assert.Equal(t, "aws_iam_user", got.Diagnostics[0].ResourceType())
+	assert.Equal(t, diagnostic.CodeUnsupportedResourceType, got.Diagnostics[1].Code())
+	assert.Equal(t, "aws_unknown", got.Diagnostics[1].ResourceType())
+}
+
+func TestEnumerator_Enumerate_AllTypes(t *testing.T) {
+	e := newTestEnumerator(t, nil,
+		newFakeEnumerator("aws_s3_bucket", []*resource.Resource{
+			{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}},
+		}, nil),
+		newFakeEnumerator("aws_sqs_queue", []*resource.Resource{}, nil),
+	)
+
+	got, err := e.Enumerate(&enumeration.EnumerateInput{})
+	assert.NoError(t, err)
+	assert.Len(t, got.Resources["aws_s3_bucket"], 1)
+	assert.Contains(t, got.Resources, "aws_sqs_queue")
+	assert.Empty(t, got.Diagnostics)
+}
+
+func TestEnumerator_Enumerate_Error(t *testing.T) {
+	e := newTestEnumerator(t, nil, newFakeEnumerator("aws_s3_bucket", nil, errors.New("unexpected")))
+
+	_, err := e.Enumerate(&enumeration.EnumerateInput{ResourceTypes: []string{"aws_s3_bucket"}})
+	assert.EqualError(t, err, "unexpected")
+}
+
+func TestEnumerator_Refresh(t *testing.T) {
+	provider := &fakeProvider{values: map[string]cty.Value{
+		"bucket": cty.ObjectVal(map[string]cty.Value{
+			"id":     cty.StringVal("bucket"),
+			"bucket": cty.StringVal("bucket"),
+		}),
+	}}
+	provider.prepare = func(res *resource.Resource, args *terraform.ReadResourceArgs) {
+		args.Attributes["alias"] = res.Region
+		delete(args.Attributes, "tag")
+	}
+	e := newTestEnumerator(t, provider)
+
+	got, err := e.Refresh(&enumeration.RefreshInput{
+		Resources: map[string][]*resource.Resource{
+			"aws_s3_bucket": {
+				{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"region": "eu-west-3", "tag": "foo"}, Region: "eu-west-3"},
+				{Id: "deleted", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}},
+				{Id: "broken", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, got.Resources["aws_s3_bucket"], 1)
+	assert.Equal(t, &resource.Attributes{"id": "bucket", "bucket": "bucket"}, got.Resources["aws_s3_bucket"][0].Attributes())
+	assert.Equal(t, "eu-west-3", got.Resources["aws_s3_bucket"][0].Region)
+
+	assert.Len(t, got.Diagnostics, 1)
+	assert.Equal(t, diagnostic.CodeUnknownError, got.Diagnostics[0].Code())
+	assert.Equal(t, "unable to read aws_s3_bucket.broken: read failed", got.Diagnostics[0].Message())
+	assert.Equal(t, "broken", got.Diagnostics[0].Resource().ResourceId())
+
+	for _, args := range provider.args {
+		if args.ID == "bucket" {
+			assert.Equal(t, map[string]string{"region": "eu-west-3", "alias": "eu-west-3"}, args.Attributes)
+		}
+	}
+}
+
+func TestEnumerator_Refresh_SensitiveAttributes(t *testing.T) {
+	provider := &fakeProvider{values: map[string]cty.Value{
+		"/app/password": cty.ObjectVal(map[string]cty.Value{
+			"id":    cty.StringVal("/app/password"),
+			"name":  cty.StringVal("/app/password"),
+			"value": cty.StringVal("hunter2"),
+		}),
+	}}
+	e := newTestEnumerator(t, provider)
+
+	got, err := e.Refresh(&enumeration.RefreshInput{
+		Resources: map[string][]*resource.Resource{
+			"aws_ssm_parameter": {
+				{Id: "/app/password", Type: "aws_ssm_parameter", Attrs: &resource.Attributes{"name": "/app/password"}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, got.Resources["aws_ssm_parameter"], 1)
+	assert.Equal(t, &resource.Attributes{"id": "/app/password", "name": "/app/password"}, got.Resources["aws_ssm_parameter"][0].Attributes())
+}
+
+func TestEnumerator_Refresh_Secrets(t *testing.T) {
+	provider := &fakeProvider{values: map[string]cty.Value{
+		"/app/password": cty.ObjectVal(map[string]cty.Value{
+			"id":    cty.StringVal("/app/password"),
+			"name":  cty.StringVal("/app/password"),
+			"value": cty.StringVal("hunter2"),
+		}),
+	}}
+	provider.skip = (&aws.AWSTerraformProvider{}).SkipReadResource
+	e := newTestEnumerator(t, provider)
+
+	secretArn := "arn:aws:secretsmanager:eu-west-3:123456789012:secret:app-password"
+	got, err := e.Refresh(&enumeration.RefreshInput{
+		Resources: map[string][]*resource.Resource{
+			"aws_ssm_parameter": {
+				{Id: "/app/password", Type: "aws_ssm_parameter", Attrs: &resource.Attributes{"name": "/app/password"}, Region: "eu-west-3"},
+			},
+			"aws_secretsmanager_secret": {
+				{Id: secretArn, Type: "aws_secretsmanager_secret", Attrs: &resource.Attributes{"name": "app-password"}},
+			},
+			"aws_secretsmanager_secret_rotation": {
+				{Id: secretArn, Type: "aws_secretsmanager_secret_rotation", Attrs: &resource.Attributes{"secret_id": secretArn}},
+			},
+			"aws_secretsmanager_secret_policy": {
+				{Id: secretArn, Type: "aws_secretsmanager_secret_policy", Attrs: &resource.Attributes{"secret_arn": secretArn}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, got.Diagnostics)
+
+	// Reading those resources with the Terraform provider would fetch the values of parameters and secrets
+	assert.Empty(t, provider.args)
+
+	assert.Len(t, got.Resources["aws_ssm_parameter"], 1)
+	assert.Equal(t, &resource.Attributes{"id": "/app/password", "name": "/app/password"}, got.Resources["aws_ssm_parameter"][0].Attributes())
+	assert.Equal(t, "eu-west-3", got.Resources["aws_ssm_parameter"][0].Region)
+	assert.Equal(t, &resource.Attributes{"id": secretArn, "name": "app-password"}, got.Resources["aws_secretsmanager_secret"][0].Attributes())
+	assert.Equal(t, &resource.Attributes{"id": secretArn, "secret_id": secretArn}, got.Resources["aws_secretsmanager_secret_rotation"][0].Attributes())
+	assert.Equal(t, &resource.Attributes{"id": secretArn, "secret_arn": secretArn}, got.Resources["aws_secretsmanager_secret_policy"][0].Attributes())
+}
+
+func TestEnumerator_Refresh_MultiAccount(t *testing.T) {
+	provider := &fakeProvider{values: map[string]cty.Value{
+		"foo": cty.ObjectVal(map[string]cty.Value{
+			"id":     cty.StringVal("foo"),
+			"bucket": cty.StringVal("foo"),
+		}),
+		"bar": cty.ObjectVal(map[string]cty.Value{
+			"id":     cty.StringVal("bar"),
+			"bucket": cty.StringVal("bar"),
+		}),
+	}}
+	provider.prepare = (&aws.AWSTerraformProvider{}).PrepareReadResourceArgs
+	e := newTestEnumerator(t, provider)
+	e.config.Options = common.RemoteOptions{AWSAccounts: []string{"111111111111", "222222222222"}}
+
+	got, err := e.Refresh(&enumeration.RefreshInput{
+		Resources: map[string][]*resource.Resource{
+			"aws_s3_bucket": {
+				{Id: "foo", Type: "aws_s3_bucket", Attrs: &resource.Attributes{aws.NetworkInterfaceManagedByAttribute: "lambda"}, Account: "222222222222", Region: "eu-west-3"},
+				{Id: "bar", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}, Account: "222222222222"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got.Resources["aws_s3_bucket"], 2)
+
+	aliases := map[string]string{}
+	for _, args := range provider.args {
+		aliases[args.ID] = args.Attributes["alias"]
+		assert.NotContains(t, args.Attributes, aws.NetworkInterfaceManagedByAttribute)
+	}
+	assert.Equal(t, map[string]string{"foo": "222222222222/eu-west-3", "bar": "222222222222/"}, aliases)
+}
+
+func TestEnumerator_GetSchema(t *testing.T) {
+	e := newTestEnumerator(t, &fakeProvider{})
+
+	got, err := e.GetSchema()
+	assert.NoError(t, err)
+	assert.Contains(t, got.Schema.ResourceTypes, "aws_s3_bucket")
+	assert.Equal(t, uint64(1), got.Schema.ResourceTypeSchemaVersions["aws_s3_bucket"])
+}
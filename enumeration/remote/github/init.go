@@ -14,31 +14,39 @@ import (
  * Required to use Scanner
  */
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
-
-	provider, err := NewGithubTerraformProvider(version, progress, configDir)
-	if err != nil {
-		return err
-	}
-	err = provider.Init()
-	if err != nil {
-		return err
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, opts common.RemoteOptions) error {
+
+	// A provider already initialized in the library is reused, its plugin keeps running between scans
+	provider, isInitialized := providerLibrary.Provider(terraform.GITHUB).(*GithubTerraformProvider)
+	if !isInitialized {
+		var err error
+		provider, err = NewGithubTerraformProvider(version, progress, configDir)
+		if err != nil {
+			return err
+		}
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
 	}
+	provider.SetConcurrency(opts.GetConcurrency())
 
 	repositoryCache := cache.New(100)
 
 	repository := NewGithubRepository(provider.GetConfig(), repositoryCache)
 	providerLibrary.AddProvider(terraform.GITHUB, provider)
 
-	remoteLibrary.AddEnumerator(NewGithubTeamEnumerator(repository, factory))
+	library := common.WithCache(remoteLibrary, opts.Cache, "github/"+provider.GetConfig().getDefaultOwner(), factory)
+
+	library.AddEnumerator(NewGithubTeamEnumerator(repository, factory))
 
-	remoteLibrary.AddEnumerator(NewGithubRepositoryEnumerator(repository, factory))
+	library.AddEnumerator(NewGithubRepositoryEnumerator(repository, factory))
 
-	remoteLibrary.AddEnumerator(NewGithubMembershipEnumerator(repository, factory))
+	library.AddEnumerator(NewGithubMembershipEnumerator(repository, factory))
 
-	remoteLibrary.AddEnumerator(NewGithubTeamMembershipEnumerator(repository, factory))
+	library.AddEnumerator(NewGithubTeamMembershipEnumerator(repository, factory))
 
-	remoteLibrary.AddEnumerator(NewGithubBranchProtectionEnumerator(repository, factory))
+	library.AddEnumerator(NewGithubBranchProtectionEnumerator(repository, factory))
 
 	return nil
 }
@@ -10,11 +10,11 @@ import (
 )
 
 type GithubRepository interface {
-	ListRepositories() ([]string, error)
-	ListTeams() ([]Team, error)
-	ListMembership() ([]string, error)
-	ListTeamMemberships() ([]string, error)
-	ListBranchProtection() ([]string, error)
+	ListRepositories(ctx context.Context) ([]string, error)
+	ListTeams(ctx context.Context) ([]Team, error)
+	ListMembership(ctx context.Context) ([]string, error)
+	ListTeamMemberships(ctx context.Context) ([]string, error)
+	ListBranchProtection(ctx context.Context) ([]string, error)
 }
 
 type GithubGraphQLClient interface {
@@ -23,7 +23,6 @@ type GithubGraphQLClient interface {
 
 type githubRepository struct {
 	client GithubGraphQLClient
-	ctx    context.Context
 	config githubConfig
 	cache  cache.Cache
 }
@@ -37,7 +36,6 @@ func NewGithubRepository(config githubConfig, c cache.Cache) *githubRepository {
 
 	repo := &githubRepository{
 		client: githubv4.NewClient(oauthClient),
-		ctx:    context.Background(),
 		config: config,
 		cache:  c,
 	}
@@ -45,13 +43,13 @@ func NewGithubRepository(config githubConfig, c cache.Cache) *githubRepository {
 	return repo
 }
 
-func (r *githubRepository) ListRepositories() ([]string, error) {
+func (r *githubRepository) ListRepositories(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListRepositories"); v != nil {
 		return v.([]string), nil
 	}
 
 	if r.config.Organization != "" {
-		results, err := r.listRepoForOrg()
+		results, err := r.listRepoForOrg(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +57,7 @@ func (r *githubRepository) ListRepositories() ([]string, error) {
 		return results, nil
 	}
 
-	results, err := r.listRepoForOwner()
+	results, err := r.listRepoForOwner(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +81,7 @@ type listRepoForOrgQuery struct {
 	} `graphql:"organization(login: $org)"`
 }
 
-func (r *githubRepository) listRepoForOrg() ([]string, error) {
+func (r *githubRepository) listRepoForOrg(ctx context.Context) ([]string, error) {
 	query := listRepoForOrgQuery{}
 	variables := map[string]interface{}{
 		"org":    (githubv4.String)(r.config.Organization),
@@ -91,7 +89,7 @@ func (r *githubRepository) listRepoForOrg() ([]string, error) {
 	}
 	var results []string
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -120,14 +118,14 @@ type listRepoForOwnerQuery struct {
 	}
 }
 
-func (r githubRepository) listRepoForOwner() ([]string, error) {
+func (r githubRepository) listRepoForOwner(ctx context.Context) ([]string, error) {
 	query := listRepoForOwnerQuery{}
 	variables := map[string]interface{}{
 		"cursor": (*githubv4.String)(nil),
 	}
 	var results []string
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -162,7 +160,7 @@ type Team struct {
 	Slug       string
 }
 
-func (r githubRepository) ListTeams() ([]Team, error) {
+func (r githubRepository) ListTeams(ctx context.Context) ([]Team, error) {
 	if v := r.cache.Get("githubListTeams"); v != nil {
 		return v.([]Team), nil
 	}
@@ -178,7 +176,7 @@ func (r githubRepository) ListTeams() ([]Team, error) {
 		"login":  (githubv4.String)(r.config.Organization),
 	}
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -212,7 +210,7 @@ type listMembership struct {
 	} `graphql:"organization(login: $login)"`
 }
 
-func (r *githubRepository) ListMembership() ([]string, error) {
+func (r *githubRepository) ListMembership(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListMembership"); v != nil {
 		return v.([]string), nil
 	}
@@ -228,7 +226,7 @@ func (r *githubRepository) ListMembership() ([]string, error) {
 		"login":  (githubv4.String)(r.config.Organization),
 	}
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -261,12 +259,12 @@ type listTeamMembershipsQuery struct {
 	} `graphql:"organization(login: $login)"`
 }
 
-func (r githubRepository) ListTeamMemberships() ([]string, error) {
+func (r githubRepository) ListTeamMemberships(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListTeamMemberships"); v != nil {
 		return v.([]string), nil
 	}
 
-	teamList, err := r.ListTeams()
+	teamList, err := r.ListTeams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +283,7 @@ func (r githubRepository) ListTeamMemberships() ([]string, error) {
 		variables["slug"] = (githubv4.String)(team.Slug)
 		variables["cursor"] = (*githubv4.String)(nil)
 		for {
-			err := r.client.Query(r.ctx, &query, variables)
+			err := r.client.Query(ctx, &query, variables)
 			if err != nil {
 				return nil, err
 			}
@@ -317,12 +315,12 @@ type listBranchProtectionQuery struct {
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
-func (r *githubRepository) ListBranchProtection() ([]string, error) {
+func (r *githubRepository) ListBranchProtection(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListBranchProtection"); v != nil {
 		return v.([]string), nil
 	}
 
-	repoList, err := r.ListRepositories()
+	repoList, err := r.ListRepositories(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +337,7 @@ func (r *githubRepository) ListBranchProtection() ([]string, error) {
 		variables["name"] = (githubv4.String)(repo)
 		variables["cursor"] = (*githubv4.String)(nil)
 		for {
-			err := r.client.Query(r.ctx, &query, variables)
+			err := r.client.Query(ctx, &query, variables)
 			if err != nil {
 				return nil, err
 			}
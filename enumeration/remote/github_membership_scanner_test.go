@@ -35,7 +35,7 @@ func TestScanGithubMembership(t *testing.T) {
 			test:    "no members",
 			dirName: "github_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListMembership").Return([]string{}, nil)
+				client.On("ListMembership", mock.Anything).Return([]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -46,7 +46,7 @@ func TestScanGithubMembership(t *testing.T) {
 			test:    "Multiple membership with admin and member roles",
 			dirName: "github_membership_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListMembership").Return([]string{
+				client.On("ListMembership", mock.Anything).Return([]string{
 					"driftctl-test:driftctl-acceptance-tester",
 					"driftctl-test:eliecharra",
 				}, nil)
@@ -66,7 +66,7 @@ func TestScanGithubMembership(t *testing.T) {
 			test:    "cannot list membership",
 			dirName: "github_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListMembership").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListMembership", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubMembershipResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubMembershipResourceType, githubres.GithubMembershipResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -35,7 +35,7 @@ func TestScanGithubRepository(t *testing.T) {
 			test:    "no github repos",
 			dirName: "github_repository_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return([]string{}, nil)
+				client.On("ListRepositories", mock.Anything).Return([]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -46,7 +46,7 @@ func TestScanGithubRepository(t *testing.T) {
 			test:    "Multiple github repos Table",
 			dirName: "github_repository_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return([]string{
+				client.On("ListRepositories", mock.Anything).Return([]string{
 					"driftctl",
 					"driftctl-demos",
 				}, nil)
@@ -66,7 +66,7 @@ func TestScanGithubRepository(t *testing.T) {
 			test:    "cannot list repositories",
 			dirName: "github_repository_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListRepositories", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubRepositoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubRepositoryResourceType, githubres.GithubRepositoryResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -35,7 +35,7 @@ func TestScanGithubTeamMembership(t *testing.T) {
 			test:    "no github team memberships",
 			dirName: "github_team_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeamMemberships").Return([]string{}, nil)
+				client.On("ListTeamMemberships", mock.Anything).Return([]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -46,7 +46,7 @@ func TestScanGithubTeamMembership(t *testing.T) {
 			test:    "multiple github team memberships",
 			dirName: "github_team_membership_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeamMemberships").Return([]string{
+				client.On("ListTeamMemberships", mock.Anything).Return([]string{
 					"4570529:driftctl-acceptance-tester",
 					"4570529:wbeuil",
 				}, nil)
@@ -66,7 +66,7 @@ func TestScanGithubTeamMembership(t *testing.T) {
 			test:    "cannot list team membership",
 			dirName: "github_team_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeamMemberships").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListTeamMemberships", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubTeamMembershipResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubTeamMembershipResourceType, githubres.GithubTeamMembershipResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -35,7 +35,7 @@ func TestScanGithubTeam(t *testing.T) {
 			test:    "no github teams",
 			dirName: "github_teams_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return([]github.Team{}, nil)
+				client.On("ListTeams", mock.Anything).Return([]github.Team{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -46,7 +46,7 @@ func TestScanGithubTeam(t *testing.T) {
 			test:    "Multiple github teams with parent",
 			dirName: "github_teams_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return([]github.Team{
+				client.On("ListTeams", mock.Anything).Return([]github.Team{
 					{DatabaseId: 4556811}, // github_team.team1
 					{DatabaseId: 4556812}, // github_team.team2
 					{DatabaseId: 4556814}, // github_team.with_parent
@@ -70,7 +70,7 @@ func TestScanGithubTeam(t *testing.T) {
 			test:    "cannot list teams",
 			dirName: "github_teams_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListTeams", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubTeamResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubTeamResourceType, githubres.GithubTeamResourceType), alerts.EnumerationPhase)).Return()
 			},
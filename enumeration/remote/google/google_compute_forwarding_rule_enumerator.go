@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeForwardingRuleEnumerator) SupportedType() resource.Resourc
 	return google.GoogleComputeForwardingRuleResourceType
 }
 
-func (e *GoogleComputeForwardingRuleEnumerator) Enumerate() ([]*resource.Resource, error) {
-	forwardingRules, err := e.repository.SearchAllForwardingRules()
+func (e *GoogleComputeForwardingRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	forwardingRules, err := e.repository.SearchAllForwardingRules(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
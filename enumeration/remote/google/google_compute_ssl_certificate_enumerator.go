@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeSslCertificateEnumerator) SupportedType() resource.Resourc
 	return google.GoogleComputeSslCertificateResourceType
 }
 
-func (e *GoogleComputeSslCertificateEnumerator) Enumerate() ([]*resource.Resource, error) {
-	sslCertificates, err := e.repository.SearchAllSslCertificates()
+func (e *GoogleComputeSslCertificateEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	sslCertificates, err := e.repository.SearchAllSslCertificates(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeSubnetworkEnumerator) SupportedType() resource.ResourceTyp
 	return google.GoogleComputeSubnetworkResourceType
 }
 
-func (e *GoogleComputeSubnetworkEnumerator) Enumerate() ([]*resource.Resource, error) {
-	subnets, err := e.repository.SearchAllSubnetworks()
+func (e *GoogleComputeSubnetworkEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	subnets, err := e.repository.SearchAllSubnetworks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"strings"
@@ -26,8 +28,8 @@ func (e *GoogleDNSManagedZoneEnumerator) SupportedType() resource.ResourceType {
 	return google.GoogleDNSManagedZoneResourceType
 }
 
-func (e *GoogleDNSManagedZoneEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllDNSManagedZones()
+func (e *GoogleDNSManagedZoneEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllDNSManagedZones(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
@@ -9,93 +9,91 @@ import (
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	"github.com/snyk/driftctl/enumeration/terraform"
 
-	asset "cloud.google.com/go/asset/apiv1"
-	"cloud.google.com/go/storage"
 	"github.com/snyk/driftctl/enumeration/resource"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, opts common.RemoteOptions) error {
 
-	provider, err := NewGCPTerraformProvider(version, progress, configDir)
-	if err != nil {
-		return err
+	// A provider already initialized in the library is reused, its plugin keeps running between scans
+	provider, isInitialized := providerLibrary.Provider(terraform.GOOGLE).(*GCPTerraformProvider)
+	if !isInitialized {
+		var err error
+		provider, err = NewGCPTerraformProvider(version, progress, configDir)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = provider.CheckCredentialsExist()
+	err := provider.CheckCredentialsExist()
 	if err != nil {
 		return err
 	}
+	provider.SetConcurrency(opts.GetConcurrency())
 
-	err = provider.Init()
-	if err != nil {
-		return err
+	if !isInitialized {
+		err = provider.Init()
+		if err != nil {
+			return err
+		}
 	}
 
-	repositoryCache := cache.New(100)
-
-	ctx := context.Background()
-	assetClient, err := asset.NewClient(ctx)
-	if err != nil {
-		return err
-	}
+	// Added before the clients are created so that they are closed with the provider whatever happens
+	providerLibrary.AddProvider(terraform.GOOGLE, provider)
 
-	storageClient, err := storage.NewClient(ctx)
+	clients, err := provider.clients(context.Background(), throttle.NewLimiter(opts.RateLimits))
 	if err != nil {
 		return err
 	}
 
-	crmService, err := cloudresourcemanager.NewService(ctx)
-	if err != nil {
-		return err
-	}
+	repositoryCache := cache.New(100)
 
-	assetRepository := repository.NewAssetRepository(assetClient, provider.GetConfig(), repositoryCache)
-	storageRepository := repository.NewStorageRepository(storageClient, repositoryCache)
-	iamRepository := repository.NewCloudResourceManagerRepository(crmService, provider.GetConfig(), repositoryCache)
+	assetRepository := repository.NewAssetRepository(clients.asset, provider.GetConfig(), repositoryCache)
+	storageRepository := repository.NewStorageRepository(clients.storage, repositoryCache)
+	iamRepository := repository.NewCloudResourceManagerRepository(clients.crm, provider.GetConfig(), repositoryCache)
 
-	providerLibrary.AddProvider(terraform.GOOGLE, provider)
+	library := common.WithCache(remoteLibrary, opts.Cache, "google/"+provider.GetConfig().Project, factory)
 
-	remoteLibrary.AddEnumerator(NewGoogleStorageBucketEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleStorageBucketEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeFirewallEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeFirewallEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeRouterEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeRouterEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeInstanceEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeInstanceEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleProjectIamMemberEnumerator(iamRepository, factory))
+	library.AddEnumerator(NewGoogleProjectIamMemberEnumerator(iamRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleStorageBucketIamMemberEnumerator(assetRepository, storageRepository, factory))
+	library.AddEnumerator(NewGoogleStorageBucketIamMemberEnumerator(assetRepository, storageRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeNetworkEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeNetworkEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeSubnetworkEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeSubnetworkEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleDNSManagedZoneEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleDNSManagedZoneEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeInstanceGroupEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeInstanceGroupEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleBigqueryDatasetEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleBigqueryTableEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleBigqueryDatasetEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleBigqueryTableEnumerator(assetRepository, factory))
 
-	remoteLibrary.AddEnumerator(NewGoogleComputeAddressEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeGlobalAddressEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleCloudFunctionsFunctionEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeDiskEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeImageEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleBigTableInstanceEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleBigtableTableEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleSQLDatabaseInstanceEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeHealthCheckEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleCloudRunServiceEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeNodeGroupEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeForwardingRuleEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeInstanceGroupManagerEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeGlobalForwardingRuleEnumerator(assetRepository, factory))
-	remoteLibrary.AddEnumerator(NewGoogleComputeSslCertificateEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeAddressEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeGlobalAddressEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleCloudFunctionsFunctionEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeDiskEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeImageEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleBigTableInstanceEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleBigtableTableEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleSQLDatabaseInstanceEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeHealthCheckEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleCloudRunServiceEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeNodeGroupEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeForwardingRuleEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeInstanceGroupManagerEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeGlobalForwardingRuleEnumerator(assetRepository, factory))
+	library.AddEnumerator(NewGoogleComputeSslCertificateEnumerator(assetRepository, factory))
 
 	return nil
 }
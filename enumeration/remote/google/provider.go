@@ -4,19 +4,38 @@ import (
 	"context"
 	"errors"
 	"os"
+	"sync/atomic"
 
+	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/remote/google/config"
 	"github.com/snyk/driftctl/enumeration/remote/terraform"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 
 	asset "cloud.google.com/go/asset/apiv1"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
 )
 
 type GCPTerraformProvider struct {
 	*terraform.TerraformProvider
 	name    string
 	version string
+	// apiClients are created by the first scan and reused by the next ones like the provider plugin,
+	// they are closed on cleanup
+	apiClients *apiClients
+	// limiter is the rate limiter of the running scan, requests of the asset client wait for it
+	limiter atomic.Value
+}
+
+// apiClients are the clients of the Google APIs resources are listed from
+type apiClients struct {
+	asset   *asset.Client
+	storage *storage.Client
+	crm     *cloudresourcemanager.Service
 }
 
 func NewGCPTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*GCPTerraformProvider, error) {
@@ -76,3 +95,60 @@ func (p *GCPTerraformProvider) CheckCredentialsExist() error {
 	_ = client.Close()
 	return nil
 }
+
+// clients returns the clients of the Google APIs, they are created on first use. Requests of the
+// asset client wait for the given limiter until the next call
+func (p *GCPTerraformProvider) clients(ctx context.Context, limiter *throttle.Limiter) (*apiClients, error) {
+	p.limiter.Store(limiter)
+	if p.apiClients != nil {
+		return p.apiClients, nil
+	}
+
+	// Resources are mostly listed through the Cloud Asset API, its requests wait for the rate limit of the cloudasset service
+	assetClient, err := asset.NewClient(ctx, option.WithGRPCDialOption(grpc.WithUnaryInterceptor(
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if err := p.limiter.Load().(*throttle.Limiter).Wait(ctx, "cloudasset"); err != nil {
+				return err
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		},
+	)))
+	if err != nil {
+		return nil, err
+	}
+
+	storageClient, err := storage.NewClient(ctx)
+	if err != nil {
+		_ = assetClient.Close()
+		return nil, err
+	}
+
+	crmService, err := cloudresourcemanager.NewService(ctx)
+	if err != nil {
+		_ = assetClient.Close()
+		_ = storageClient.Close()
+		return nil, err
+	}
+
+	p.apiClients = &apiClients{
+		asset:   assetClient,
+		storage: storageClient,
+		crm:     crmService,
+	}
+	return p.apiClients, nil
+}
+
+// Cleanup closes the clients of the Google APIs and stops the provider plugin
+func (p *GCPTerraformProvider) Cleanup() {
+	if p.apiClients != nil {
+		logrus.Debug("Closing Google API clients")
+		if err := p.apiClients.asset.Close(); err != nil {
+			logrus.WithField("error", err).Debug("Unable to close asset client")
+		}
+		if err := p.apiClients.storage.Close(); err != nil {
+			logrus.WithField("error", err).Debug("Unable to close storage client")
+		}
+		p.apiClients = nil
+	}
+	p.TerraformProvider.Cleanup()
+}
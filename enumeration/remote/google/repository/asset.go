@@ -41,31 +41,31 @@ const (
 )
 
 type AssetRepository interface {
-	SearchAllBuckets() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllFirewalls() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllRouters() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllInstances() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllNetworks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllDisks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllImages() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllDNSManagedZones() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllInstanceGroups() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllDatasets() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllTables() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllAddresses() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllGlobalAddresses() ([]*assetpb.Asset, error)
-	SearchAllFunctions() ([]*assetpb.Asset, error)
-	SearchAllSubnetworks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllBigtableInstances() ([]*assetpb.Asset, error)
-	SearchAllBigtableTables() ([]*assetpb.Asset, error)
-	SearchAllSQLDatabaseInstances() ([]*assetpb.Asset, error)
-	SearchAllHealthChecks() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllCloudRunServices() ([]*assetpb.ResourceSearchResult, error)
-	SearchAllNodeGroups() ([]*assetpb.Asset, error)
-	SearchAllForwardingRules() ([]*assetpb.Asset, error)
-	SearchAllInstanceGroupManagers() ([]*assetpb.Asset, error)
-	SearchAllGlobalForwardingRules() ([]*assetpb.Asset, error)
-	SearchAllSslCertificates() ([]*assetpb.Asset, error)
+	SearchAllBuckets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllFirewalls(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllRouters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllInstances(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllNetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllDisks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllImages(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllDNSManagedZones(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllInstanceGroups(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllDatasets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllTables(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllAddresses(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllGlobalAddresses(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllFunctions(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllSubnetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllBigtableInstances(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllBigtableTables(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllSQLDatabaseInstances(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllHealthChecks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllCloudRunServices(ctx context.Context) ([]*assetpb.ResourceSearchResult, error)
+	SearchAllNodeGroups(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllForwardingRules(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllInstanceGroupManagers(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllGlobalForwardingRules(ctx context.Context) ([]*assetpb.Asset, error)
+	SearchAllSslCertificates(ctx context.Context) ([]*assetpb.Asset, error)
 }
 
 type assetRepository struct {
@@ -82,7 +82,7 @@ func NewAssetRepository(client *asset.Client, config config.GCPTerraformConfig,
 	}
 }
 
-func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
+func (s assetRepository) listAllResources(ctx context.Context, ty string) ([]*assetpb.Asset, error) {
 	req := &assetpb.ListAssetsRequest{
 		Parent:      fmt.Sprintf("projects/%s", s.config.Project),
 		ContentType: assetpb.ContentType_RESOURCE,
@@ -109,7 +109,7 @@ func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 	}
 
 	if results == nil {
-		it := s.client.ListAssets(context.Background(), req)
+		it := s.client.ListAssets(ctx, req)
 		for {
 			resource, err := it.Next()
 			if err == iterator.Done {
@@ -133,7 +133,7 @@ func (s assetRepository) listAllResources(ty string) ([]*assetpb.Asset, error) {
 	return filteredResults, nil
 }
 
-func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearchResult, error) {
+func (s assetRepository) searchAllResources(ctx context.Context, ty string) ([]*assetpb.ResourceSearchResult, error) {
 	req := &assetpb.SearchAllResourcesRequest{
 		Scope: fmt.Sprintf("projects/%s", s.config.Project),
 		AssetTypes: []string{
@@ -164,7 +164,7 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 	}
 
 	if results == nil {
-		it := s.client.SearchAllResources(context.Background(), req)
+		it := s.client.SearchAllResources(ctx, req)
 		for {
 			resource, err := it.Next()
 			if err == iterator.Done {
@@ -188,102 +188,102 @@ func (s assetRepository) searchAllResources(ty string) ([]*assetpb.ResourceSearc
 	return filteredResults, nil
 }
 
-func (s assetRepository) SearchAllBuckets() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(storageBucketAssetType)
+func (s assetRepository) SearchAllBuckets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, storageBucketAssetType)
 }
 
-func (s assetRepository) SearchAllFirewalls() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeFirewallAssetType)
+func (s assetRepository) SearchAllFirewalls(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeFirewallAssetType)
 }
 
-func (s assetRepository) SearchAllRouters() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeRouterAssetType)
+func (s assetRepository) SearchAllRouters(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeRouterAssetType)
 }
 
-func (s assetRepository) SearchAllInstances() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeInstanceAssetType)
+func (s assetRepository) SearchAllInstances(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeInstanceAssetType)
 }
 
-func (s assetRepository) SearchAllNetworks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeNetworkAssetType)
+func (s assetRepository) SearchAllNetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeNetworkAssetType)
 }
 
-func (s assetRepository) SearchAllDNSManagedZones() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(dnsManagedZoneAssetType)
+func (s assetRepository) SearchAllDNSManagedZones(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, dnsManagedZoneAssetType)
 }
 
-func (s assetRepository) SearchAllInstanceGroups() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeInstanceGroupAssetType)
+func (s assetRepository) SearchAllInstanceGroups(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeInstanceGroupAssetType)
 }
 
-func (s assetRepository) SearchAllDatasets() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(bigqueryDatasetAssetType)
+func (s assetRepository) SearchAllDatasets(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, bigqueryDatasetAssetType)
 }
 
-func (s assetRepository) SearchAllTables() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(bigqueryTableAssetType)
+func (s assetRepository) SearchAllTables(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, bigqueryTableAssetType)
 }
 
-func (s assetRepository) SearchAllAddresses() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeAddressAssetType)
+func (s assetRepository) SearchAllAddresses(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeAddressAssetType)
 }
 
-func (s assetRepository) SearchAllGlobalAddresses() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeGlobalAddressAssetType)
+func (s assetRepository) SearchAllGlobalAddresses(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, computeGlobalAddressAssetType)
 }
 
-func (s assetRepository) SearchAllFunctions() ([]*assetpb.Asset, error) {
-	return s.listAllResources(cloudFunctionsFunction)
+func (s assetRepository) SearchAllFunctions(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, cloudFunctionsFunction)
 }
 
-func (s assetRepository) SearchAllSubnetworks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeSubnetworkAssetType)
+func (s assetRepository) SearchAllSubnetworks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeSubnetworkAssetType)
 }
 
-func (s assetRepository) SearchAllDisks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeDiskAssetType)
+func (s assetRepository) SearchAllDisks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeDiskAssetType)
 }
 
-func (s assetRepository) SearchAllImages() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(computeImageAssetType)
+func (s assetRepository) SearchAllImages(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, computeImageAssetType)
 }
 
-func (s assetRepository) SearchAllBigtableInstances() ([]*assetpb.Asset, error) {
-	return s.listAllResources(bigtableInstanceAssetType)
+func (s assetRepository) SearchAllBigtableInstances(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, bigtableInstanceAssetType)
 }
 
-func (s assetRepository) SearchAllBigtableTables() ([]*assetpb.Asset, error) {
-	return s.listAllResources(bigtableTableAssetType)
+func (s assetRepository) SearchAllBigtableTables(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, bigtableTableAssetType)
 }
 
-func (s assetRepository) SearchAllSQLDatabaseInstances() ([]*assetpb.Asset, error) {
-	return s.listAllResources(sqlDatabaseInstanceAssetType)
+func (s assetRepository) SearchAllSQLDatabaseInstances(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, sqlDatabaseInstanceAssetType)
 }
 
-func (s assetRepository) SearchAllHealthChecks() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(healthCheckAssetType)
+func (s assetRepository) SearchAllHealthChecks(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, healthCheckAssetType)
 }
 
-func (s assetRepository) SearchAllCloudRunServices() ([]*assetpb.ResourceSearchResult, error) {
-	return s.searchAllResources(cloudRunServiceAssetType)
+func (s assetRepository) SearchAllCloudRunServices(ctx context.Context) ([]*assetpb.ResourceSearchResult, error) {
+	return s.searchAllResources(ctx, cloudRunServiceAssetType)
 }
 
-func (s assetRepository) SearchAllNodeGroups() ([]*assetpb.Asset, error) {
-	return s.listAllResources(nodeGroupAssetType)
+func (s assetRepository) SearchAllNodeGroups(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, nodeGroupAssetType)
 }
 
-func (s assetRepository) SearchAllForwardingRules() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeForwardingRuleAssetType)
+func (s assetRepository) SearchAllForwardingRules(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, computeForwardingRuleAssetType)
 }
 
-func (s assetRepository) SearchAllInstanceGroupManagers() ([]*assetpb.Asset, error) {
-	return s.listAllResources(instanceGroupManagerAssetType)
+func (s assetRepository) SearchAllInstanceGroupManagers(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, instanceGroupManagerAssetType)
 }
 
-func (s assetRepository) SearchAllGlobalForwardingRules() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeGlobalForwardingRuleAssetType)
+func (s assetRepository) SearchAllGlobalForwardingRules(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, computeGlobalForwardingRuleAssetType)
 }
 
-func (s assetRepository) SearchAllSslCertificates() ([]*assetpb.Asset, error) {
-	return s.listAllResources(computeSslCertificateAssetType)
+func (s assetRepository) SearchAllSslCertificates(ctx context.Context) ([]*assetpb.Asset, error) {
+	return s.listAllResources(ctx, computeSslCertificateAssetType)
 }
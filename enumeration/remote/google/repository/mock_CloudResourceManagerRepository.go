@@ -2,32 +2,36 @@
 
 package repository
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockCloudResourceManagerRepository is an autogenerated mock type for the CloudResourceManagerRepository type
 type MockCloudResourceManagerRepository struct {
 	mock.Mock
 }
 
-// ListProjectsBindings provides a mock function with given fields:
-func (_m *MockCloudResourceManagerRepository) ListProjectsBindings() (map[string]map[string][]string, error) {
-	ret := _m.Called()
+// ListProjectsBindings provides a mock function with given fields: ctx
+func (_m *MockCloudResourceManagerRepository) ListProjectsBindings(ctx context.Context) (map[string]map[string][]string, error) {
+	ret := _m.Called(ctx)
 
 	var r0 map[string]map[string][]string
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (map[string]map[string][]string, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]map[string][]string, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() map[string]map[string][]string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]map[string][]string); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]map[string][]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,7 +37,7 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			test:    "no bindings",
 			dirName: "google_project_member_empty",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(map[string]map[string][]string{}, nil)
+				repository.On("ListProjectsBindings", mock.Anything).Return(map[string]map[string][]string{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -45,7 +45,7 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			test:    "Cannot list bindings",
 			dirName: "google_project_member_listing_error",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(
+				repository.On("ListProjectsBindings", mock.Anything).Return(
 					map[string]map[string][]string{},
 					errors.New("googleapi: Error 403: driftctl-acc-circle@driftctl-qa-1.iam.gserviceaccount.com does not have project.getIamPolicy access., forbidden"))
 			},
@@ -69,7 +69,7 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			test:    "multiples storage buckets, multiple bindings",
 			dirName: "google_project_member_listing_multiple",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(map[string]map[string][]string{
+				repository.On("ListProjectsBindings", mock.Anything).Return(map[string]map[string][]string{
 					"": {
 						"roles/editor": {
 							"user:martin.guibert@cloudskiff.com",
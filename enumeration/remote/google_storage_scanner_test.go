@@ -185,7 +185,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "no storage buckets",
 			dirName: "google_storage_bucket_member_empty",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{}, nil)
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -196,7 +196,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "multiples storage buckets, no bindings",
 			dirName: "google_storage_bucket_member_empty",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -208,8 +208,8 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(map[string][]string{}, nil)
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-2").Return(map[string][]string{}, nil)
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(map[string][]string{}, nil)
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-2").Return(map[string][]string{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -220,7 +220,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "Cannot list bindings",
 			dirName: "google_storage_bucket_member_listing_error",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -228,7 +228,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(
 					map[string][]string{},
 					errors.New("googleapi: Error 403: driftctl-acc-circle@driftctl-qa-1.iam.gserviceaccount.com does not have storage.buckets.getIamPolicy access to the Google Cloud Storage bucket., forbidden"))
 			},
@@ -255,7 +255,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "multiples storage buckets, multiple bindings",
 			dirName: "google_storage_bucket_member_listing_multiple",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -267,12 +267,12 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(map[string][]string{
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(map[string][]string{
 					"roles/storage.admin":        {"user:elie.charra@cloudskiff.com"},
 					"roles/storage.objectViewer": {"user:william.beuil@cloudskiff.com"},
 				}, nil)
 
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-2").Return(map[string][]string{
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-2").Return(map[string][]string{
 					"roles/storage.admin":        {"user:william.beuil@cloudskiff.com"},
 					"roles/storage.objectViewer": {"user:elie.charra@cloudskiff.com"},
 				}, nil)
@@ -29,16 +29,16 @@ func IsSupported(remote string) bool {
 	return false
 }
 
-func Activate(remote, version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Activate(remote, version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, opts common.RemoteOptions) error {
 	switch remote {
 	case common.RemoteAWSTerraform:
-		return aws.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return aws.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, opts)
 	case common.RemoteGithubTerraform:
-		return github.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return github.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, opts)
 	case common.RemoteGoogleTerraform:
-		return google.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return google.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, opts)
 	case common.RemoteAzureTerraform:
-		return azurerm.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return azurerm.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, opts)
 
 	default:
 		return errors.Errorf("unsupported remote '%s'", remote)
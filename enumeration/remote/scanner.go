@@ -2,30 +2,71 @@ package remote
 
 import (
 	"context"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/parallel"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	"github.com/snyk/driftctl/enumeration/resource"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+// Delays applied to the enumerators of a resource type once the cloud provider starts throttling its requests
+const (
+	throttlingBaseDelay = 1 * time.Second
+	throttlingMaxDelay  = 30 * time.Second
+)
+
 type Scanner struct {
+	ctx              context.Context
+	cancel           context.CancelFunc
 	enumeratorRunner *parallel.ParallelRunner
 	remoteLibrary    *common.RemoteLibrary
 	alerter          alerter.AlerterInterface
 	filter           enumeration.Filter
+	timingsMu        sync.Mutex
+	timings          map[string]time.Duration
+	maxRetries       int
+	backoffs         *throttle.Backoffs
+	bestEffort       bool
+	failedTypesMu    sync.Mutex
+	failedTypes      map[string]struct{}
+	timeout          time.Duration
+	timeouts         common.Timeouts
 }
 
 func NewScanner(remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInterface, filter enumeration.Filter) *Scanner {
+	return NewScannerWithOptions(remoteLibrary, alerter, filter, common.RemoteOptions{})
+}
+
+// NewScannerWithOptions returns a scanner running opts.Concurrency enumerators at the same time and
+// retrying up to opts.MaxRetries times the enumerations still throttled by the cloud provider once its clients
+// gave up retrying the request. With
+// opts.BestEffort, resource types failing to be listed are reported instead of aborting the scan.
+// Resource types not listed before opts.Timeout or their opts.EnumeratorTimeouts are reported too.
+func NewScannerWithOptions(remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInterface, filter enumeration.Filter, opts common.RemoteOptions) *Scanner {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &Scanner{
-		enumeratorRunner: parallel.NewParallelRunner(context.TODO(), 10),
+		ctx:              ctx,
+		cancel:           cancel,
+		enumeratorRunner: parallel.NewParallelRunner(ctx, int64(opts.GetConcurrency())),
 		remoteLibrary:    remoteLibrary,
 		alerter:          alerter,
 		filter:           filter,
+		timings:          make(map[string]time.Duration),
+		maxRetries:       opts.GetMaxRetries(),
+		backoffs:         throttle.NewBackoffs(throttlingBaseDelay, throttlingMaxDelay),
+		bestEffort:       opts.BestEffort,
+		failedTypes:      make(map[string]struct{}),
+		timeout:          opts.Timeout,
+		timeouts:         opts.EnumeratorTimeouts,
 	}
 }
 
@@ -52,6 +93,15 @@ loop:
 }
 
 func (s *Scanner) scan() ([]*resource.Resource, error) {
+	// The scan timeout only cancels enumerations, the runner is kept running so
+	// that resources listed in time are still returned
+	ctx := s.ctx
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
 	for _, enum := range s.remoteLibrary.Enumerators() {
 		if s.filter.IsTypeIgnored(enum.SupportedType()) {
 			logrus.WithFields(logrus.Fields{
@@ -61,12 +111,22 @@ func (s *Scanner) scan() ([]*resource.Resource, error) {
 		}
 		enumerator := enum
 		s.enumeratorRunner.Run(func() (interface{}, error) {
-			resources, err := enumerator.Enumerate()
+			start := time.Now()
+			resources, err := s.enumerate(ctx, enumerator)
+			s.addTiming(string(enumerator.SupportedType()), time.Since(start))
+			if errors.Is(err, context.DeadlineExceeded) {
+				s.addTimeout(string(enumerator.SupportedType()))
+				return []*resource.Resource{}, nil
+			}
 			if err != nil {
 				err := HandleResourceEnumerationError(err, s.alerter)
 				if err == nil {
 					return []*resource.Resource{}, nil
 				}
+				if s.bestEffort {
+					s.addFailure(string(enumerator.SupportedType()), err)
+					return []*resource.Resource{}, nil
+				}
 				return nil, err
 			}
 			for _, res := range resources {
@@ -90,6 +150,73 @@ func (s *Scanner) scan() ([]*resource.Resource, error) {
 	return enumerationResult, nil
 }
 
+// enumerate retries the enumerations throttled by the cloud provider, enumerators of a resource
+// type wait longer once its requests start being throttled and less as they succeed again
+func (s *Scanner) enumerate(ctx context.Context, enumerator common.Enumerator) ([]*resource.Resource, error) {
+	if timeout := s.timeouts.For(string(enumerator.SupportedType())); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	backoff := s.backoffs.For(string(enumerator.SupportedType()))
+	for attempt := 1; ; attempt++ {
+		// Enumerations are not started once the scan or the type timed out, the runner may
+		// only run them long after they were queued
+		if err := backoff.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resources, err := enumerateWithContext(ctx, enumerator)
+		if err == nil {
+			backoff.Succeeded()
+			return resources, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if attempt > s.maxRetries || !throttle.IsThrottlingError(err) {
+			return nil, err
+		}
+		delay := backoff.Throttled()
+		logrus.WithFields(logrus.Fields{
+			"type":    enumerator.SupportedType(),
+			"attempt": attempt,
+			"delay":   delay,
+		}).Debug("Enumeration throttled by the cloud provider, retrying")
+	}
+}
+
+// enumerateWithContext returns as soon as ctx is done, even when the enumerator does not
+// watch ctx, in which case the enumeration keeps running in the background until it returns
+func enumerateWithContext(ctx context.Context, enumerator common.Enumerator) ([]*resource.Resource, error) {
+	type result struct {
+		resources []*resource.Resource
+		err       error
+		panic     interface{}
+	}
+	done := make(chan result, 1)
+	go func() {
+		// Panics are raised again by the caller so that the runner recovers from them
+		defer func() {
+			if r := recover(); r != nil {
+				done <- result{panic: r}
+			}
+		}()
+		resources, err := enumerator.Enumerate(ctx)
+		done <- result{resources: resources, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.panic != nil {
+			panic(r.panic)
+		}
+		return r.resources, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 func (s *Scanner) Resources() ([]*resource.Resource, error) {
 	resources, err := s.scan()
 	if err != nil {
@@ -98,7 +225,69 @@ func (s *Scanner) Resources() ([]*resource.Resource, error) {
 	return resources, err
 }
 
+// addTiming accumulates the time spent listing a resource type, a type can be
+// listed by several enumerators when scanning multiple regions or accounts
+func (s *Scanner) addTiming(ty string, duration time.Duration) {
+	s.timingsMu.Lock()
+	defer s.timingsMu.Unlock()
+	s.timings[ty] += duration
+}
+
+// addFailure records a resource type that could not be listed
+func (s *Scanner) addFailure(ty string, err error) {
+	logrus.WithFields(logrus.Fields{
+		"type":  ty,
+		"error": err,
+	}).Warn("Unable to list resources, they are left out of the results")
+	s.addFailedType(ty, alerts.NewEnumerationFailedAlert(ty, err))
+}
+
+// addTimeout records a resource type that could not be listed in time
+func (s *Scanner) addTimeout(ty string) {
+	logrus.WithFields(logrus.Fields{
+		"type": ty,
+	}).Warn("Listing resources timed out, they are left out of the results")
+	s.addFailedType(ty, alerts.NewEnumerationTimeoutAlert(ty))
+}
+
+// addFailedType sends an alert the first time a resource type fails, the alert makes
+// the analysis leave out resources of that type
+func (s *Scanner) addFailedType(ty string, alert alerter.Alert) {
+	s.failedTypesMu.Lock()
+	defer s.failedTypesMu.Unlock()
+	if _, exists := s.failedTypes[ty]; exists {
+		return
+	}
+	s.failedTypes[ty] = struct{}{}
+	s.alerter.SendAlert(ty, alert)
+}
+
+// FailedTypes returns the sorted resource types that could not be listed, either in
+// best effort mode or because listing them timed out
+func (s *Scanner) FailedTypes() []string {
+	s.failedTypesMu.Lock()
+	defer s.failedTypesMu.Unlock()
+	types := make([]string, 0, len(s.failedTypes))
+	for ty := range s.failedTypes {
+		types = append(types, ty)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Timings returns the time spent listing each resource type
+func (s *Scanner) Timings() map[string]time.Duration {
+	s.timingsMu.Lock()
+	defer s.timingsMu.Unlock()
+	timings := make(map[string]time.Duration, len(s.timings))
+	for ty, duration := range s.timings {
+		timings[ty] = duration
+	}
+	return timings
+}
+
 func (s *Scanner) Stop() {
 	logrus.Debug("Stopping scanner")
 	s.enumeratorRunner.Stop(errors.New("interrupted"))
+	s.cancel()
 }
@@ -1,15 +1,24 @@
 package remote
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
 	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 
 	"github.com/snyk/driftctl/enumeration/resource"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestScannerShouldIgnoreType(t *testing.T) {
@@ -31,3 +40,287 @@ func TestScannerShouldIgnoreType(t *testing.T) {
 	assert.Nil(t, err)
 	fakeEnumerator.AssertExpectations(t)
 }
+
+func TestScannerShouldTagRegionalResources(t *testing.T) {
+	alerter := alerter.NewAlerter()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	for _, region := range []string{"us-east-1", "eu-west-3"} {
+		fakeEnumerator := &common.MockEnumerator{}
+		fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+		fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{
+			{
+				Id:    "id-" + region,
+				Type:  "FakeType",
+				Attrs: &resource.Attributes{},
+			},
+		}, nil)
+		remoteLibrary.AddEnumerator(aws.NewRegionalEnumerator(fakeEnumerator, region))
+	}
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", resource.ResourceType("FakeType")).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter, testFilter)
+	got, err := s.Resources()
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	for _, res := range got {
+		assert.Equal(t, "id-"+res.Region, res.ResourceId())
+		assert.Empty(t, *res.Attributes())
+	}
+}
+
+func TestScannerShouldNotLocateResourcesOnDefaultRegionScans(t *testing.T) {
+	remoteLibrary := common.NewRemoteLibrary()
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{
+		{
+			Id:    "bucket",
+			Type:  "aws_s3_bucket",
+			Attrs: &resource.Attributes{"region": "us-east-1"},
+		},
+	}, nil)
+	aws.NewRegionalLibrary(remoteLibrary, "us-east-1", []string{"us-east-1"}, "us-east-1").AddEnumerator(fakeEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter.NewAlerter(), testFilter)
+	got, err := s.Resources()
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Empty(t, got[0].Region)
+	assert.Equal(t, &resource.Attributes{"region": "us-east-1"}, got[0].Attributes())
+
+	serialized, err := json.Marshal(resource.NewSerializableResource(got[0]))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"bucket","type":"aws_s3_bucket"}`, string(serialized))
+}
+
+func TestScannerShouldTagAccountResources(t *testing.T) {
+	alerter := alerter.NewAlerter()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	for _, accountId := range []string{"111111111111", "222222222222"} {
+		fakeEnumerator := &common.MockEnumerator{}
+		fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+		fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{
+			{
+				Id:    "id-" + accountId,
+				Type:  "FakeType",
+				Attrs: &resource.Attributes{},
+			},
+		}, nil)
+		remoteLibrary.AddEnumerator(aws.NewAccountEnumerator(aws.NewRegionalEnumerator(fakeEnumerator, "us-east-1"), accountId))
+	}
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", resource.ResourceType("FakeType")).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter, testFilter)
+	got, err := s.Resources()
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	for _, res := range got {
+		assert.Equal(t, "id-"+res.Account, res.ResourceId())
+		assert.Equal(t, "us-east-1", res.Region)
+		assert.Empty(t, *res.Attributes())
+	}
+}
+
+func TestScannerShouldRecordTimings(t *testing.T) {
+	alerter := alerter.NewAlerter()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	for _, region := range []string{"us-east-1", "eu-west-3"} {
+		fakeEnumerator := &common.MockEnumerator{}
+		fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+		fakeEnumerator.On("Enumerate", mock.Anything).After(10*time.Millisecond).Return([]*resource.Resource{}, nil)
+		remoteLibrary.AddEnumerator(aws.NewRegionalEnumerator(fakeEnumerator, region))
+	}
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", resource.ResourceType("FakeType")).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter, testFilter)
+	_, err := s.Resources()
+	assert.Nil(t, err)
+
+	timings := s.Timings()
+	assert.Len(t, timings, 1)
+	assert.GreaterOrEqual(t, timings["FakeType"], 20*time.Millisecond)
+}
+
+func TestScannerShouldRetryThrottledEnumerations(t *testing.T) {
+	throttled := remoteerr.NewResourceListingError(awserr.New("Throttling", "Rate exceeded", nil), "FakeType")
+
+	cases := []struct {
+		name       string
+		maxRetries int
+		throttles  int
+		wantCalls  int
+		wantErr    bool
+	}{
+		{name: "succeeds after retries", maxRetries: 3, throttles: 2, wantCalls: 3},
+		{name: "fails after too many retries", maxRetries: 2, throttles: 5, wantCalls: 3, wantErr: true},
+		{name: "fails right away without retries", maxRetries: 0, throttles: 1, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeEnumerator := &common.MockEnumerator{}
+			fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+			fakeEnumerator.On("Enumerate", mock.Anything).Return(nil, throttled).Times(tt.throttles)
+			fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "id", Type: "FakeType"}}, nil)
+
+			remoteLibrary := common.NewRemoteLibrary()
+			remoteLibrary.AddEnumerator(fakeEnumerator)
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", resource.ResourceType("FakeType")).Return(false)
+
+			s := NewScannerWithOptions(remoteLibrary, alerter.NewAlerter(), testFilter, common.RemoteOptions{MaxRetries: &tt.maxRetries})
+			s.backoffs = throttle.NewBackoffs(time.Millisecond, time.Millisecond)
+			got, err := s.Resources()
+			if tt.wantErr {
+				assert.Equal(t, throttled, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, got, 1)
+			}
+			fakeEnumerator.AssertNumberOfCalls(t, "Enumerate", tt.wantCalls)
+		})
+	}
+}
+
+func TestScannerShouldReportFailedTypesInBestEffortMode(t *testing.T) {
+	alerter := alerter.NewAlerter()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	workingEnumerator := &common.MockEnumerator{}
+	workingEnumerator.On("SupportedType").Return(resource.ResourceType("WorkingType"))
+	workingEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "id", Type: "WorkingType"}}, nil)
+	remoteLibrary.AddEnumerator(workingEnumerator)
+	for _, region := range []string{"us-east-1", "eu-west-3"} {
+		failingEnumerator := &common.MockEnumerator{}
+		failingEnumerator.On("SupportedType").Return(resource.ResourceType("FailingType"))
+		failingEnumerator.On("Enumerate", mock.Anything).Return(nil, errors.New("unexpected"))
+		remoteLibrary.AddEnumerator(aws.NewRegionalEnumerator(failingEnumerator, region))
+	}
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScannerWithOptions(remoteLibrary, alerter, testFilter, common.RemoteOptions{BestEffort: true})
+	got, err := s.Resources()
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, []string{"FailingType"}, s.FailedTypes())
+
+	// Alerts are only all received once retrieved
+	sent := alerter.Retrieve()
+	assert.Len(t, sent["FailingType"], 1)
+	assert.Equal(t, "An error occurred listing FailingType, it is left out of the results: unexpected", sent["FailingType"][0].Message())
+
+	assert.True(t, alerter.IsResourceIgnored(&resource.Resource{Id: "other", Type: "FailingType"}))
+	assert.False(t, alerter.IsResourceIgnored(&resource.Resource{Id: "id", Type: "WorkingType"}))
+}
+
+func TestScannerShouldFailWithoutBestEffortMode(t *testing.T) {
+	remoteLibrary := common.NewRemoteLibrary()
+	failingEnumerator := &common.MockEnumerator{}
+	failingEnumerator.On("SupportedType").Return(resource.ResourceType("FailingType"))
+	failingEnumerator.On("Enumerate", mock.Anything).Return(nil, errors.New("unexpected"))
+	remoteLibrary.AddEnumerator(failingEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(remoteLibrary, alerter.NewAlerter(), testFilter)
+	_, err := s.Resources()
+	assert.EqualError(t, err, "unexpected")
+	assert.Empty(t, s.FailedTypes())
+}
+
+func TestScannerShouldReportTimedOutEnumerations(t *testing.T) {
+	alerter := alerter.NewAlerter()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	workingEnumerator := &common.MockEnumerator{}
+	workingEnumerator.On("SupportedType").Return(resource.ResourceType("WorkingType"))
+	workingEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "id", Type: "WorkingType"}}, nil)
+	remoteLibrary.AddEnumerator(workingEnumerator)
+
+	// This enumerator does not watch its context
+	hang := make(chan time.Time)
+	defer close(hang)
+	hungEnumerator := &common.MockEnumerator{}
+	hungEnumerator.On("SupportedType").Return(resource.ResourceType("HungType"))
+	hungEnumerator.On("Enumerate", mock.Anything).WaitUntil(hang).Return([]*resource.Resource{}, nil)
+	remoteLibrary.AddEnumerator(hungEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScannerWithOptions(remoteLibrary, alerter, testFilter, common.RemoteOptions{
+		EnumeratorTimeouts: common.Timeouts{ByType: map[string]time.Duration{"HungType": 10 * time.Millisecond}},
+	})
+	got, err := s.Resources()
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, []string{"HungType"}, s.FailedTypes())
+
+	sent := alerter.Retrieve()
+	assert.Len(t, sent["HungType"], 1)
+	assert.Equal(t, "Listing HungType timed out, it is left out of the results", sent["HungType"][0].Message())
+	assert.True(t, sent["HungType"][0].ShouldIgnoreResource())
+}
+
+func TestScannerShouldStopEnumerationsOnScanTimeout(t *testing.T) {
+	remoteLibrary := common.NewRemoteLibrary()
+	for _, ty := range []string{"SlowType", "OtherSlowType"} {
+		slowEnumerator := &common.MockEnumerator{}
+		slowEnumerator.On("SupportedType").Return(resource.ResourceType(ty))
+		slowEnumerator.On("Enumerate", mock.Anything).Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).Return(nil, context.DeadlineExceeded)
+		remoteLibrary.AddEnumerator(slowEnumerator)
+	}
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScannerWithOptions(remoteLibrary, alerter.NewAlerter(), testFilter, common.RemoteOptions{Timeout: 10 * time.Millisecond})
+	got, err := s.Resources()
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	assert.Equal(t, []string{"OtherSlowType", "SlowType"}, s.FailedTypes())
+}
+
+func TestScannerShouldNotStartEnumerationsAfterScanTimeout(t *testing.T) {
+	remoteLibrary := common.NewRemoteLibrary()
+	slowEnumerator := &common.MockEnumerator{}
+	slowEnumerator.On("SupportedType").Return(resource.ResourceType("SlowType"))
+	slowEnumerator.On("Enumerate", mock.Anything).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.DeadlineExceeded)
+	remoteLibrary.AddEnumerator(slowEnumerator)
+	queuedEnumerator := &common.MockEnumerator{}
+	queuedEnumerator.On("SupportedType").Return(resource.ResourceType("QueuedType"))
+	remoteLibrary.AddEnumerator(queuedEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScannerWithOptions(remoteLibrary, alerter.NewAlerter(), testFilter, common.RemoteOptions{
+		Concurrency: 1,
+		Timeout:     10 * time.Millisecond,
+	})
+	got, err := s.Resources()
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	assert.Equal(t, []string{"QueuedType", "SlowType"}, s.FailedTypes())
+	queuedEnumerator.AssertNotCalled(t, "Enumerate", mock.Anything)
+}
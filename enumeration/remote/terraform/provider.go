@@ -17,6 +17,7 @@ import (
 	"github.com/sirupsen/logrus"
 	progress2 "github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/parallel"
+	"github.com/snyk/driftctl/enumeration/remote/common"
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/gocty"
@@ -29,8 +30,10 @@ const EXIT_ERROR = 3
 // we'll have an alias per region, and the alias IS the region itself.
 // So we can query resources using a specific custom provider configuration
 type TerraformProviderConfig struct {
-	Name              string
-	DefaultAlias      string
+	Name         string
+	DefaultAlias string
+	// Aliases are configured at init time in addition to the default one
+	Aliases           []string
 	GetProviderConfig func(alias string) interface{}
 }
 
@@ -47,7 +50,7 @@ type TerraformProvider struct {
 func NewTerraformProvider(installer *tf.ProviderInstaller, config TerraformProviderConfig, progress progress2.ProgressCounter) (*TerraformProvider, error) {
 	p := TerraformProvider{
 		providerInstaller: installer,
-		runner:            parallel.NewParallelRunner(context.TODO(), 10),
+		runner:            parallel.NewParallelRunner(context.TODO(), common.DefaultConcurrency),
 		grpcProviders:     make(map[string]*plugin.GRPCProvider),
 		Config:            config,
 		progress:          progress,
@@ -76,6 +79,14 @@ func (p *TerraformProvider) Init() error {
 	if err != nil {
 		return err
 	}
+	for _, alias := range p.Config.Aliases {
+		if alias == p.Config.DefaultAlias {
+			continue
+		}
+		if err := p.configure(alias); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -87,6 +98,11 @@ func (p *TerraformProvider) Runner() *parallel.ParallelRunner {
 	return p.runner
 }
 
+// SetConcurrency sets the number of requests the provider runner sends at the same time
+func (p *TerraformProvider) SetConcurrency(concurrency int) {
+	p.runner = parallel.NewParallelRunner(context.TODO(), int64(concurrency))
+}
+
 func (p *TerraformProvider) configure(alias string) error {
 	providerPath, err := p.providerInstaller.Install()
 	if err != nil {
@@ -142,7 +158,10 @@ func (p *TerraformProvider) configure(alias string) error {
 	return nil
 }
 
-func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value, error) {
+func (p *TerraformProvider) ReadResource(ctx context.Context, args tf.ReadResourceArgs) (*cty.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	logrus.WithFields(logrus.Fields{
 		"id":    args.ID,
@@ -189,7 +208,7 @@ func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value,
 	var newState cty.Value
 	r := retrier.New(retrier.ConstantBackoff(3, 100*time.Millisecond), nil)
 
-	err = r.Run(func() error {
+	err = r.RunCtx(ctx, func(ctx context.Context) error {
 		resp := p.grpcProviders[alias].ReadResource(providers.ReadResourceRequest{
 			TypeName:     typ,
 			PriorState:   priorState,
@@ -0,0 +1,182 @@
+package throttle
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimits is the number of API requests per second allowed for each service
+type RateLimits map[string]float64
+
+// ParseRateLimits parses rate limits given as SERVICE=RATE, RATE being a number of requests per second
+func ParseRateLimits(values []string) (RateLimits, error) {
+	limits := make(RateLimits, len(values))
+	for _, value := range values {
+		service, rawRate, found := strings.Cut(value, "=")
+		limit, err := strconv.ParseFloat(rawRate, 64)
+		if !found || service == "" || err != nil || limit <= 0 {
+			return nil, errors.Errorf("invalid rate limit '%s', expected SERVICE=RATE with RATE a number of requests per second (e.g. ec2=20)", value)
+		}
+		limits[strings.ToLower(service)] = limit
+	}
+	return limits, nil
+}
+
+// Limiter holds a token bucket for each rate limited service
+type Limiter struct {
+	limiters map[string]*rate.Limiter
+}
+
+func NewLimiter(limits RateLimits) *Limiter {
+	limiters := make(map[string]*rate.Limiter, len(limits))
+	for service, limit := range limits {
+		// The bucket holds a second of requests so that short bursts are not slowed down
+		limiters[service] = rate.NewLimiter(rate.Limit(limit), int(math.Max(1, math.Ceil(limit))))
+	}
+	return &Limiter{limiters: limiters}
+}
+
+// Wait blocks until a request to the given service is allowed, requests to services
+// without a rate limit are never delayed
+func (l *Limiter) Wait(ctx context.Context, service string) error {
+	if l == nil {
+		return nil
+	}
+	limiter, exists := l.limiters[strings.ToLower(service)]
+	if !exists {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
+
+// Backoff is a delay shared by the enumerations of a resource type, it doubles each time the cloud provider
+// throttles a request and decreases again as requests succeed, so that enumerations of a throttled type slow
+// down together instead of each one hitting the rate limits on its own
+type Backoff struct {
+	mu    sync.Mutex
+	base  time.Duration
+	max   time.Duration
+	delay time.Duration
+	sleep func(context.Context, time.Duration) error
+}
+
+func NewBackoff(base, max time.Duration) *Backoff {
+	return &Backoff{
+		base:  base,
+		max:   max,
+		sleep: sleep,
+	}
+}
+
+// Wait sleeps for the current delay, it returns early with the context error once ctx is done
+func (b *Backoff) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	delay := b.delay
+	b.mu.Unlock()
+	if delay > 0 {
+		return b.sleep(ctx, delay)
+	}
+	return ctx.Err()
+}
+
+// Throttled increases the delay and returns it
+func (b *Backoff) Throttled() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.delay *= 2
+	if b.delay < b.base {
+		b.delay = b.base
+	}
+	if b.delay > b.max {
+		b.delay = b.max
+	}
+	return b.delay
+}
+
+// Succeeded decreases the delay, it goes back to zero once below the base delay
+func (b *Backoff) Succeeded() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.delay /= 2
+	if b.delay < b.base {
+		b.delay = 0
+	}
+}
+
+// Backoffs holds a Backoff for each key, so that throttling of one resource type does not slow down the others
+type Backoffs struct {
+	mu       sync.Mutex
+	base     time.Duration
+	max      time.Duration
+	backoffs map[string]*Backoff
+}
+
+func NewBackoffs(base, max time.Duration) *Backoffs {
+	return &Backoffs{
+		base:     base,
+		max:      max,
+		backoffs: make(map[string]*Backoff),
+	}
+}
+
+// For returns the backoff of the given key, it is created on first use
+func (b *Backoffs) For(key string) *Backoff {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	backoff, ok := b.backoffs[key]
+	if !ok {
+		backoff = NewBackoff(b.base, b.max)
+		b.backoffs[key] = backoff
+	}
+	return backoff
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsThrottlingError returns whether an error, or one it wraps, tells that the cloud provider
+// throttled requests: AWS throttling error codes, GCP RESOURCE_EXHAUSTED or a 429 status code
+func IsThrottlingError(err error) bool {
+	for err != nil {
+		if request.IsErrorThrottle(err) {
+			return true
+		}
+		if grpcErr, ok := err.(interface{ GRPCStatus() *status.Status }); ok && grpcErr.GRPCStatus().Code() == codes.ResourceExhausted {
+			return true
+		}
+		if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code == http.StatusTooManyRequests {
+			return true
+		}
+		if respErr, ok := err.(azcore.HTTPResponse); ok && respErr.RawResponse() != nil && respErr.RawResponse().StatusCode == http.StatusTooManyRequests {
+			return true
+		}
+
+		if scanErr, ok := err.(interface{ RootCause() error }); ok {
+			err = scanErr.RootCause()
+			continue
+		}
+		err = errors.Unwrap(err)
+	}
+	return false
+}
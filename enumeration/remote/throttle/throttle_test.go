@@ -0,0 +1,169 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	limits, err := ParseRateLimits([]string{"ec2=20", "S3=0.5"})
+	assert.NoError(t, err)
+	assert.Equal(t, RateLimits{"ec2": 20, "s3": 0.5}, limits)
+
+	for _, value := range []string{"ec2", "=20", "ec2=fast", "ec2=0", "ec2=-1"} {
+		_, err := ParseRateLimits([]string{value})
+		assert.EqualError(t, err, "invalid rate limit '"+value+"', expected SERVICE=RATE with RATE a number of requests per second (e.g. ec2=20)")
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	limiter := NewLimiter(RateLimits{"ec2": 20})
+
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		assert.NoError(t, limiter.Wait(context.Background(), "EC2"))
+		assert.NoError(t, limiter.Wait(context.Background(), "s3"))
+	}
+	// The first 20 requests fit in the bucket, the next 10 are spread over half a second
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	var nilLimiter *Limiter
+	assert.NoError(t, nilLimiter.Wait(context.Background(), "ec2"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, NewLimiter(RateLimits{"ec2": 0.001}).Wait(ctx, "ec2"))
+}
+
+func TestBackoff(t *testing.T) {
+	var slept []time.Duration
+	backoff := NewBackoff(time.Second, 4*time.Second)
+	backoff.sleep = func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+	ctx := context.Background()
+
+	assert.NoError(t, backoff.Wait(ctx))
+	assert.Empty(t, slept)
+
+	assert.Equal(t, time.Second, backoff.Throttled())
+	assert.Equal(t, 2*time.Second, backoff.Throttled())
+	assert.Equal(t, 4*time.Second, backoff.Throttled())
+	assert.Equal(t, 4*time.Second, backoff.Throttled())
+	assert.NoError(t, backoff.Wait(ctx))
+
+	backoff.Succeeded()
+	assert.NoError(t, backoff.Wait(ctx))
+	backoff.Succeeded()
+	assert.NoError(t, backoff.Wait(ctx))
+	backoff.Succeeded()
+	assert.NoError(t, backoff.Wait(ctx))
+
+	assert.Equal(t, []time.Duration{4 * time.Second, 2 * time.Second, time.Second}, slept)
+}
+
+func TestBackoff_WaitReturnsOnceContextIsDone(t *testing.T) {
+	backoff := NewBackoff(time.Hour, time.Hour)
+	backoff.Throttled()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, backoff.Wait(ctx))
+
+	backoff.Succeeded()
+	assert.Equal(t, context.Canceled, backoff.Wait(ctx))
+}
+
+func TestBackoffs_For(t *testing.T) {
+	backoffs := NewBackoffs(time.Second, 4*time.Second)
+
+	assert.Same(t, backoffs.For("aws_s3_bucket"), backoffs.For("aws_s3_bucket"))
+	assert.NotSame(t, backoffs.For("aws_s3_bucket"), backoffs.For("aws_instance"))
+
+	assert.Equal(t, time.Second, backoffs.For("aws_s3_bucket").Throttled())
+	assert.Equal(t, time.Second, backoffs.For("aws_instance").Throttled())
+	assert.Equal(t, 2*time.Second, backoffs.For("aws_s3_bucket").Throttled())
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "AWS throttling",
+			err:  remoteerr.NewResourceListingError(awserr.New("Throttling", "Rate exceeded", nil), "aws_instance"),
+			want: true,
+		},
+		{
+			name: "AWS request limit exceeded",
+			err:  remoteerr.NewResourceListingError(awserr.NewRequestFailure(awserr.New("RequestLimitExceeded", "", nil), 503, ""), "aws_instance"),
+			want: true,
+		},
+		{
+			name: "AWS access denied",
+			err:  remoteerr.NewResourceListingError(awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", nil), 403, ""), "aws_instance"),
+			want: false,
+		},
+		{
+			name: "GCP resource exhausted",
+			err:  remoteerr.NewResourceListingError(status.Error(codes.ResourceExhausted, "quota exceeded"), "google_compute_instance"),
+			want: true,
+		},
+		{
+			name: "GCP permission denied",
+			err:  remoteerr.NewResourceListingError(status.Error(codes.PermissionDenied, "denied"), "google_compute_instance"),
+			want: false,
+		},
+		{
+			name: "GCP too many requests",
+			err:  remoteerr.NewResourceListingError(&googleapi.Error{Code: http.StatusTooManyRequests}, "google_storage_bucket"),
+			want: true,
+		},
+		{
+			name: "Azure too many requests",
+			err:  remoteerr.NewResourceListingError(&azureError{&http.Response{StatusCode: http.StatusTooManyRequests}}, "azurerm_virtual_network"),
+			want: true,
+		},
+		{
+			name: "Azure not found",
+			err:  remoteerr.NewResourceListingError(&azureError{&http.Response{StatusCode: http.StatusNotFound}}, "azurerm_virtual_network"),
+			want: false,
+		},
+		{
+			name: "other error",
+			err:  errors.New("unexpected"),
+			want: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsThrottlingError(tt.err))
+		})
+	}
+}
+
+type azureError struct {
+	resp *http.Response
+}
+
+func (e *azureError) Error() string {
+	return "azure error"
+}
+
+func (e *azureError) RawResponse() *http.Response {
+	return e.resp
+}
@@ -0,0 +1,3 @@
+package aws
+
+const AwsAutoscalingGroupResourceType = "aws_autoscaling_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsCloudwatchEventBusResourceType = "aws_cloudwatch_event_bus"
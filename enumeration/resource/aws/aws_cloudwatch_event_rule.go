@@ -0,0 +1,3 @@
+package aws
+
+const AwsCloudwatchEventRuleResourceType = "aws_cloudwatch_event_rule"
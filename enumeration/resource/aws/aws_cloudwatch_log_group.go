@@ -0,0 +1,3 @@
+package aws
+
+const AwsCloudwatchLogGroupResourceType = "aws_cloudwatch_log_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsCloudwatchMetricAlarmResourceType = "aws_cloudwatch_metric_alarm"
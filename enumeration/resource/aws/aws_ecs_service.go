@@ -0,0 +1,3 @@
+package aws
+
+const AwsEcsServiceResourceType = "aws_ecs_service"
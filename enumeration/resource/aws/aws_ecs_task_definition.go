@@ -0,0 +1,3 @@
+package aws
+
+const AwsEcsTaskDefinitionResourceType = "aws_ecs_task_definition"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsEksAddonResourceType = "aws_eks_addon"
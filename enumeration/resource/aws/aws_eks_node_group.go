@@ -0,0 +1,3 @@
+package aws
+
+const AwsEksNodeGroupResourceType = "aws_eks_node_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsFlowLogResourceType = "aws_flow_log"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsSecretsManagerSecretResourceType = "aws_secretsmanager_secret"
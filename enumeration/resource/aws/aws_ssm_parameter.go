@@ -0,0 +1,3 @@
+package aws
+
+const AwsSsmParameterResourceType = "aws_ssm_parameter"
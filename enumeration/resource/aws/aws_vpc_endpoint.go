@@ -0,0 +1,3 @@
+package aws
+
+const AwsVpcEndpointResourceType = "aws_vpc_endpoint"
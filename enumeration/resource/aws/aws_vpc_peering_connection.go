@@ -0,0 +1,3 @@
+package aws
+
+const AwsVpcPeeringConnectionResourceType = "aws_vpc_peering_connection"
@@ -44,12 +44,27 @@ func (s *TerraformStateSource) InternalName() string {
 	return s.Name
 }
 
+// TerraformPlanSource is the source of a resource read from a Terraform plan.
+// Pending is true when the plan is about to create or destroy the resource.
+type TerraformPlanSource struct {
+	TerraformStateSource
+	Pending bool
+}
+
+func NewTerraformPlanSource(plan, module, name string, pending bool) *TerraformPlanSource {
+	return &TerraformPlanSource{TerraformStateSource{plan, module, name}, pending}
+}
+
 type Resource struct {
-	Id     string
-	Type   string
-	Attrs  *Attributes
-	Sch    *Schema `json:"-" diff:"-"`
-	Source Source  `json:"-"`
+	Id    string
+	Type  string
+	Attrs *Attributes
+	// Account and Region are the account and region a resource has been found in,
+	// they are only set on multi-account and multi-region scans
+	Account string  `json:",omitempty"`
+	Region  string  `json:",omitempty"`
+	Sch     *Schema `json:"-" diff:"-"`
+	Source  Source  `json:"-"`
 }
 
 func (r *Resource) Schema() *Schema {
@@ -87,6 +102,10 @@ func (r *Resource) Equal(res *Resource) bool {
 		return false
 	}
 
+	if !r.SameLocation(res) {
+		return false
+	}
+
 	if r.Schema() != nil && r.Schema().DiscriminantFunc != nil {
 		return r.Schema().DiscriminantFunc(r, res)
 	}
@@ -94,6 +113,16 @@ func (r *Resource) Equal(res *Resource) bool {
 	return true
 }
 
+// SameLocation returns false only when both resources are located in a different account or region.
+// Resources are only located on multi-account and multi-region scans.
+func (r *Resource) SameLocation(res *Resource) bool {
+	return sameLocation(r.Account, res.Account) && sameLocation(r.Region, res.Region)
+}
+
+func sameLocation(location, otherLocation string) bool {
+	return location == "" || otherLocation == "" || location == otherLocation
+}
+
 type ResourceFactory interface {
 	CreateAbstractResource(ty, id string, data map[string]interface{}) *Resource
 }
@@ -103,6 +132,9 @@ type SerializableResource struct {
 	Type               string              `json:"type"`
 	ReadableAttributes map[string]string   `json:"human_readable_attributes,omitempty"`
 	Source             *SerializableSource `json:"source,omitempty"`
+	// Account and Region are only set on multi-account and multi-region scans
+	Account string `json:"account_id,omitempty"`
+	Region  string `json:"region,omitempty"`
 }
 
 func NewSerializableResource(res *Resource) *SerializableResource {
@@ -119,6 +151,8 @@ func NewSerializableResource(res *Resource) *SerializableResource {
 		Type:               res.ResourceType(),
 		ReadableAttributes: formatReadableAttributes(res),
 		Source:             src,
+		Account:            res.Account,
+		Region:             res.Region,
 	}
 }
 
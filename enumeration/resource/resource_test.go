@@ -73,3 +73,52 @@ func TestSanitizeDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestResource_Equal(t *testing.T) {
+	cases := map[string]struct {
+		res      *Resource
+		other    *Resource
+		expected bool
+	}{
+		"same type and id": {
+			res:      &Resource{Id: "id", Type: "type", Attrs: &Attributes{}},
+			other:    &Resource{Id: "id", Type: "type"},
+			expected: true,
+		},
+		"different id": {
+			res:      &Resource{Id: "id", Type: "type"},
+			other:    &Resource{Id: "other", Type: "type"},
+			expected: false,
+		},
+		"only one resource is located in a region": {
+			res:      &Resource{Id: "id", Type: "type", Region: "us-east-1"},
+			other:    &Resource{Id: "id", Type: "type", Attrs: &Attributes{}},
+			expected: true,
+		},
+		"same region": {
+			res:      &Resource{Id: "id", Type: "type", Region: "us-east-1"},
+			other:    &Resource{Id: "id", Type: "type", Region: "us-east-1"},
+			expected: true,
+		},
+		"different region": {
+			res:      &Resource{Id: "id", Type: "type", Region: "us-east-1"},
+			other:    &Resource{Id: "id", Type: "type", Region: "eu-west-3"},
+			expected: false,
+		},
+		"different region attributes are not locations": {
+			res:      &Resource{Id: "id", Type: "type", Attrs: &Attributes{"region": "us-east-1"}},
+			other:    &Resource{Id: "id", Type: "type", Attrs: &Attributes{"region": "eu-west-3"}},
+			expected: true,
+		},
+		"different account": {
+			res:      &Resource{Id: "id", Type: "type", Account: "111111111111"},
+			other:    &Resource{Id: "id", Type: "type", Account: "222222222222"},
+			expected: false,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, c.res.Equal(c.other))
+		})
+	}
+}
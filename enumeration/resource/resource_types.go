@@ -20,6 +20,8 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_default_vpc": {children: []ResourceType{
 		// VPC are used by aws_internet_gateway to determine if internet gateway is the default one in middleware
 		"aws_internet_gateway",
+		// VPC are used by aws_vpc_dhcp_options to determine if DHCP options are the default ones in middleware
+		"aws_vpc_dhcp_options",
 	}},
 	"aws_dynamodb_table": {},
 	"aws_ebs_snapshot":   {},
@@ -160,22 +162,48 @@ var supportedTypes = map[string]ResourceTypeMeta{
 		"aws_apigatewayv2_route",
 		"aws_apigatewayv2_integration",
 	}},
-	"aws_apigatewayv2_model":                {},
-	"aws_apigatewayv2_stage":                {},
-	"aws_apigatewayv2_route_response":       {},
-	"aws_apigatewayv2_deployment":           {},
-	"aws_apigatewayv2_domain_name":          {},
-	"aws_apigatewayv2_api_mapping":          {},
-	"aws_apigatewayv2_route":                {},
-	"aws_apigatewayv2_vpc_link":             {},
-	"aws_apigatewayv2_authorizer":           {},
-	"aws_apigatewayv2_integration":          {},
-	"aws_apigatewayv2_integration_response": {},
-	"aws_launch_template":                   {},
-	"aws_launch_configuration":              {},
-	"aws_elb":                               {},
-	"aws_elasticache_cluster":               {},
-	"aws_cloudtrail":                        {},
+	"aws_apigatewayv2_model":                 {},
+	"aws_apigatewayv2_stage":                 {},
+	"aws_apigatewayv2_route_response":        {},
+	"aws_apigatewayv2_deployment":            {},
+	"aws_apigatewayv2_domain_name":           {},
+	"aws_apigatewayv2_api_mapping":           {},
+	"aws_apigatewayv2_route":                 {},
+	"aws_apigatewayv2_vpc_link":              {},
+	"aws_apigatewayv2_authorizer":            {},
+	"aws_apigatewayv2_integration":           {},
+	"aws_apigatewayv2_integration_response":  {},
+	"aws_launch_template":                    {},
+	"aws_launch_configuration":               {},
+	"aws_autoscaling_group":                  {},
+	"aws_elb":                                {},
+	"aws_elasticache_cluster":                {},
+	"aws_cloudtrail":                         {},
+	"aws_ecs_cluster":                        {},
+	"aws_ecs_service":                        {},
+	"aws_ecs_task_definition":                {},
+	"aws_ecs_cluster_capacity_providers":     {},
+	"aws_eks_cluster":                        {},
+	"aws_eks_node_group":                     {},
+	"aws_eks_fargate_profile":                {},
+	"aws_eks_addon":                          {},
+	"aws_secretsmanager_secret":              {},
+	"aws_secretsmanager_secret_rotation":     {},
+	"aws_secretsmanager_secret_policy":       {},
+	"aws_ssm_parameter":                      {},
+	"aws_cloudwatch_log_group":               {},
+	"aws_cloudwatch_metric_alarm":            {},
+	"aws_cloudwatch_dashboard":               {},
+	"aws_cloudwatch_event_bus":               {},
+	"aws_cloudwatch_event_rule":              {},
+	"aws_cloudwatch_event_target":            {},
+	"aws_vpc_endpoint":                       {},
+	"aws_vpc_peering_connection":             {},
+	"aws_ec2_transit_gateway":                {},
+	"aws_ec2_transit_gateway_vpc_attachment": {},
+	"aws_flow_log":                           {},
+	"aws_network_interface":                  {},
+	"aws_vpc_dhcp_options":                   {},
 
 	"github_branch_protection": {},
 	"github_membership":        {},
@@ -1,5 +1,7 @@
 package resource
 
+import "time"
+
 // Supplier supply the list of resource.Resource, it's the main interface to retrieve remote resources
 type Supplier interface {
 	Resources() ([]*Resource, error)
@@ -9,3 +11,16 @@ type StoppableSupplier interface {
 	Supplier
 	Stop()
 }
+
+// TimedSupplier reports the time spent listing each resource type
+type TimedSupplier interface {
+	Supplier
+	Timings() map[string]time.Duration
+}
+
+// PartialSupplier reports the resource types that could not be listed, the
+// resources it supplies are then only partial
+type PartialSupplier interface {
+	Supplier
+	FailedTypes() []string
+}
@@ -1,13 +1,15 @@
 package terraform
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/resource"
 
 	"github.com/zclconf/go-cty/cty"
 )
 
 type ResourceReader interface {
-	ReadResource(args ReadResourceArgs) (*cty.Value, error)
+	ReadResource(ctx context.Context, args ReadResourceArgs) (*cty.Value, error)
 }
 
 type ReadResourceArgs struct {
@@ -15,3 +17,16 @@ type ReadResourceArgs struct {
 	ID         string
 	Attributes map[string]string
 }
+
+// ReadResourceArgsPreparer is implemented by providers adapting the arguments used to read listed
+// resources, e.g. to select the provider configuration of their region or to leave out attributes
+// their enumerators tagged them with
+type ReadResourceArgsPreparer interface {
+	PrepareReadResourceArgs(res *resource.Resource, args *ReadResourceArgs)
+}
+
+// ResourceReadSkipper is implemented by providers that must not read resources of some types, e.g. because
+// reading them fetches the values of secrets, those resources are kept as they were listed
+type ResourceReadSkipper interface {
+	SkipReadResource(ty resource.ResourceType) bool
+}
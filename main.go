@@ -76,6 +76,12 @@ func run() int {
 		if _, isNotInSync := err.(cmderrors.InfrastructureNotInSync); isNotInSync {
 			return scan.EXIT_NOT_IN_SYNC
 		}
+		if _, isPartial := err.(cmderrors.PartialScan); isPartial {
+			return scan.EXIT_PARTIAL
+		}
+		if _, isLintFailed := err.(cmderrors.DriftIgnoreLintFailed); isLintFailed {
+			return scan.EXIT_NOT_IN_SYNC
+		}
 		if cmd.IsReportingEnabled(&driftctlCmd.Command) {
 			sentry.CaptureException(err)
 		}
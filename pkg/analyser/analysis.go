@@ -3,32 +3,78 @@ package analyser
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/r3labs/diff/v2"
 	"github.com/snyk/driftctl/enumeration/alerter"
 
 	"github.com/snyk/driftctl/enumeration/resource"
 )
 
+type Change struct {
+	diff.Change
+	Computed   bool `json:"computed"`
+	JsonString bool `json:"-"`
+}
+
+type Changelog []Change
+
+type Difference struct {
+	Res       *resource.Resource
+	Changelog Changelog
+}
+
+type serializableDifference struct {
+	Res       resource.SerializableResource `json:"res"`
+	Changelog Changelog                     `json:"changelog"`
+}
+
 type Summary struct {
 	TotalResources      int  `json:"total_resources"`
 	TotalUnmanaged      int  `json:"total_unmanaged"`
 	TotalDeleted        int  `json:"total_missing"`
 	TotalManaged        int  `json:"total_managed"`
+	TotalDrifted        int  `json:"total_changed"`
 	TotalIaCSourceCount uint `json:"total_iac_source_count"`
 }
 
+// Resolution is the drift resolved since a previous analysis
+type Resolution struct {
+	Unmanaged        []*resource.Resource
+	Deleted          []*resource.Resource
+	Differences      []Difference
+	PreviousCoverage int
+}
+
+type serializableResolution struct {
+	Unmanaged        []resource.SerializableResource `json:"unmanaged"`
+	Deleted          []resource.SerializableResource `json:"missing"`
+	Differences      []serializableDifference        `json:"differences"`
+	PreviousCoverage int                             `json:"previous_coverage"`
+}
+
 type Analysis struct {
 	unmanaged       []*resource.Resource
 	managed         []*resource.Resource
 	deleted         []*resource.Resource
+	differences     []Difference
 	summary         Summary
 	alerts          alerter.Alerts
 	Duration        time.Duration
 	Date            time.Time
 	ProviderName    string
 	ProviderVersion string
+	// EnumerationTimings is the time spent listing each resource type from the cloud provider
+	EnumerationTimings map[string]time.Duration
+	// FailedResourceTypes are the resource types that could not be listed in best effort mode,
+	// they are left out of the analysis
+	FailedResourceTypes []string
+	// Filtered is set when resources were left out of the analysis by driftignore rules or a filter expression
+	Filtered bool
+	// Resolved is the drift resolved since a previous analysis, it is only set when comparing two analyses
+	Resolved *Resolution
 }
 
 type serializableAnalysis struct {
@@ -36,12 +82,18 @@ type serializableAnalysis struct {
 	Managed         []resource.SerializableResource        `json:"managed"`
 	Unmanaged       []resource.SerializableResource        `json:"unmanaged"`
 	Deleted         []resource.SerializableResource        `json:"missing"`
+	Differences     []serializableDifference               `json:"differences"`
 	Coverage        int                                    `json:"coverage"`
 	Alerts          map[string][]alerter.SerializableAlert `json:"alerts"`
 	ProviderName    string                                 `json:"provider_name"`
 	ProviderVersion string                                 `json:"provider_version"`
 	ScanDuration    uint                                   `json:"scan_duration,omitempty"`
 	Date            time.Time                              `json:"date"`
+	// EnumerationTimings are durations in seconds by resource type
+	EnumerationTimings  map[string]float64      `json:"enumeration_timings,omitempty"`
+	FailedResourceTypes []string                `json:"failed_resource_types,omitempty"`
+	Filtered            bool                    `json:"filtered,omitempty"`
+	Resolved            *serializableResolution `json:"resolved,omitempty"`
 }
 
 type GenDriftIgnoreOptions struct {
@@ -67,6 +119,12 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 	for _, d := range a.deleted {
 		bla.Deleted = append(bla.Deleted, *resource.NewSerializableResource(d))
 	}
+	for _, di := range a.differences {
+		bla.Differences = append(bla.Differences, serializableDifference{
+			Res:       *resource.NewSerializableResource(di.Res),
+			Changelog: di.Changelog,
+		})
+	}
 	if len(a.alerts) > 0 {
 		bla.Alerts = make(map[string][]alerter.SerializableAlert)
 		for k, v := range a.alerts {
@@ -81,6 +139,29 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 	bla.ProviderVersion = a.ProviderVersion
 	bla.ScanDuration = uint(a.Duration.Seconds())
 	bla.Date = a.Date
+	if len(a.EnumerationTimings) > 0 {
+		bla.EnumerationTimings = make(map[string]float64, len(a.EnumerationTimings))
+		for ty, duration := range a.EnumerationTimings {
+			bla.EnumerationTimings[ty] = duration.Seconds()
+		}
+	}
+	bla.FailedResourceTypes = a.FailedResourceTypes
+	bla.Filtered = a.Filtered
+	if a.Resolved != nil {
+		bla.Resolved = &serializableResolution{PreviousCoverage: a.Resolved.PreviousCoverage}
+		for _, u := range a.Resolved.Unmanaged {
+			bla.Resolved.Unmanaged = append(bla.Resolved.Unmanaged, *resource.NewSerializableResource(u))
+		}
+		for _, d := range a.Resolved.Deleted {
+			bla.Resolved.Deleted = append(bla.Resolved.Deleted, *resource.NewSerializableResource(d))
+		}
+		for _, di := range a.Resolved.Differences {
+			bla.Resolved.Differences = append(bla.Resolved.Differences, serializableDifference{
+				Res:       *resource.NewSerializableResource(di.Res),
+				Changelog: di.Changelog,
+			})
+		}
+	}
 
 	return json.Marshal(bla)
 }
@@ -92,20 +173,26 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 	}
 	for _, u := range bla.Unmanaged {
 		a.AddUnmanaged(&resource.Resource{
-			Id:   u.Id,
-			Type: u.Type,
+			Id:      u.Id,
+			Type:    u.Type,
+			Account: u.Account,
+			Region:  u.Region,
 		})
 	}
 	for _, d := range bla.Deleted {
 		a.AddDeleted(&resource.Resource{
-			Id:   d.Id,
-			Type: d.Type,
+			Id:      d.Id,
+			Type:    d.Type,
+			Account: d.Account,
+			Region:  d.Region,
 		})
 	}
 	for _, m := range bla.Managed {
 		res := &resource.Resource{
-			Id:   m.Id,
-			Type: m.Type,
+			Id:      m.Id,
+			Type:    m.Type,
+			Account: m.Account,
+			Region:  m.Region,
 		}
 		if m.Source != nil {
 			// We loose the source type in the serialization process, for now everything is serialized back to a
@@ -120,6 +207,25 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 		}
 		a.AddManaged(res)
 	}
+	for _, di := range bla.Differences {
+		res := &resource.Resource{
+			Id:      di.Res.Id,
+			Type:    di.Res.Type,
+			Account: di.Res.Account,
+			Region:  di.Res.Region,
+		}
+		if di.Res.Source != nil {
+			res.Source = &resource.TerraformStateSource{
+				State:  di.Res.Source.S,
+				Module: di.Res.Source.Ns,
+				Name:   di.Res.Source.Name,
+			}
+		}
+		a.AddDifference(Difference{
+			Res:       res,
+			Changelog: di.Changelog,
+		})
+	}
 	if len(bla.Alerts) > 0 {
 		a.alerts = make(alerter.Alerts)
 		for k, v := range bla.Alerts {
@@ -135,11 +241,58 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 	a.SetIaCSourceCount(bla.Summary.TotalIaCSourceCount)
 	a.Duration = time.Duration(bla.ScanDuration) * time.Second
 	a.Date = bla.Date
+	if len(bla.EnumerationTimings) > 0 {
+		a.EnumerationTimings = make(map[string]time.Duration, len(bla.EnumerationTimings))
+		for ty, seconds := range bla.EnumerationTimings {
+			a.EnumerationTimings[ty] = time.Duration(seconds * float64(time.Second))
+		}
+	}
+	a.FailedResourceTypes = bla.FailedResourceTypes
+	a.Filtered = bla.Filtered
+	if bla.Resolved != nil {
+		a.Resolved = &Resolution{PreviousCoverage: bla.Resolved.PreviousCoverage}
+		for _, u := range bla.Resolved.Unmanaged {
+			a.Resolved.Unmanaged = append(a.Resolved.Unmanaged, unserializeResource(u))
+		}
+		for _, d := range bla.Resolved.Deleted {
+			a.Resolved.Deleted = append(a.Resolved.Deleted, unserializeResource(d))
+		}
+		for _, di := range bla.Resolved.Differences {
+			a.Resolved.Differences = append(a.Resolved.Differences, Difference{
+				Res:       unserializeResource(di.Res),
+				Changelog: di.Changelog,
+			})
+		}
+	}
 	return nil
 }
 
+// unserializeResource returns the resource of a serialized analysis, its source is read back as a
+// TerraformStateSource like the ones of managed resources
+func unserializeResource(r resource.SerializableResource) *resource.Resource {
+	res := &resource.Resource{
+		Id:      r.Id,
+		Type:    r.Type,
+		Account: r.Account,
+		Region:  r.Region,
+	}
+	if r.Source != nil {
+		res.Source = &resource.TerraformStateSource{
+			State:  r.Source.S,
+			Module: r.Source.Ns,
+			Name:   r.Source.Name,
+		}
+	}
+	return res
+}
+
+// IsPartial returns whether some resource types could not be listed from the cloud provider
+func (a *Analysis) IsPartial() bool {
+	return len(a.FailedResourceTypes) > 0
+}
+
 func (a *Analysis) IsSync() bool {
-	return a.summary.TotalUnmanaged == 0 && a.summary.TotalDeleted == 0
+	return a.summary.TotalUnmanaged == 0 && a.summary.TotalDeleted == 0 && a.summary.TotalDrifted == 0
 }
 
 func (a *Analysis) AddDeleted(resources ...*resource.Resource) {
@@ -160,6 +313,13 @@ func (a *Analysis) AddManaged(resources ...*resource.Resource) {
 	a.summary.TotalManaged += len(resources)
 }
 
+// AddDifference records attribute level drifts found on managed resources.
+// Drifted resources are also managed ones, so they are not counted in TotalResources.
+func (a *Analysis) AddDifference(diffs ...Difference) {
+	a.differences = append(a.differences, diffs...)
+	a.summary.TotalDrifted += len(diffs)
+}
+
 func (a *Analysis) SetAlerts(alerts alerter.Alerts) {
 	a.alerts = alerts
 }
@@ -187,6 +347,10 @@ func (a *Analysis) Deleted() []*resource.Resource {
 	return a.deleted
 }
 
+func (a *Analysis) Differences() []Difference {
+	return a.differences
+}
+
 func (a *Analysis) Summary() Summary {
 	return a.summary
 }
@@ -198,6 +362,34 @@ func (a *Analysis) Alerts() alerter.Alerts {
 func (a *Analysis) SortResources() {
 	a.unmanaged = resource.Sort(a.unmanaged)
 	a.deleted = resource.Sort(a.deleted)
+	a.differences = SortDifferences(a.differences)
+	if a.Resolved != nil {
+		a.Resolved.Unmanaged = resource.Sort(a.Resolved.Unmanaged)
+		a.Resolved.Deleted = resource.Sort(a.Resolved.Deleted)
+		a.Resolved.Differences = SortDifferences(a.Resolved.Differences)
+	}
+}
+
+func SortDifferences(diffs []Difference) []Difference {
+	sort.SliceStable(diffs, func(i, j int) bool {
+		if diffs[i].Res.ResourceType() != diffs[j].Res.ResourceType() {
+			return diffs[i].Res.ResourceType() < diffs[j].Res.ResourceType()
+		}
+		return diffs[i].Res.ResourceId() < diffs[j].Res.ResourceId()
+	})
+
+	for _, d := range diffs {
+		SortChanges(d.Changelog)
+	}
+
+	return diffs
+}
+
+func SortChanges(changes []Change) []Change {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return strings.Join(changes[i].Path, ".") < strings.Join(changes[j].Path, ".")
+	})
+	return changes
 }
 
 func (a *Analysis) DriftIgnoreList(opts GenDriftIgnoreOptions) (int, string) {
@@ -220,6 +412,12 @@ func (a *Analysis) DriftIgnoreList(opts GenDriftIgnoreOptions) (int, string) {
 		list = append(list, "# Missing resources")
 		addResources(a.Deleted()...)
 	}
+	if !opts.ExcludeDrifted && a.Summary().TotalDrifted > 0 {
+		list = append(list, "# Drifted resources")
+		for _, d := range a.Differences() {
+			addResources(d.Res)
+		}
+	}
 
 	return resourceCount, strings.Join(list, "\n")
 }
@@ -1,6 +1,11 @@
 package analyser
 
 import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/r3labs/diff/v2"
+	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
 	"github.com/snyk/driftctl/pkg/filter"
@@ -67,13 +72,20 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 
 	haveComputedDiff := false
 	for _, stateRes := range resourcesFromState {
-		i, _, found := findCorrespondingRes(filteredRemoteResource, stateRes)
+		i, remoteRes, found := findCorrespondingRes(filteredRemoteResource, stateRes)
 
 		if a.filter.IsResourceIgnored(stateRes) || a.alerter.IsResourceIgnored(stateRes) {
 			continue
 		}
 
 		if !found {
+			if isPendingInPlan(stateRes) {
+				logrus.WithFields(logrus.Fields{
+					"id":   stateRes.ResourceId(),
+					"type": stateRes.ResourceType(),
+				}).Debug("Resource is about to be created or destroyed by the plan, not reporting it as missing")
+				continue
+			}
 			analysis.AddDeleted(stateRes)
 			continue
 		}
@@ -81,6 +93,17 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 		// Remove managed resources, so it will remain only unmanaged ones
 		filteredRemoteResource = removeResourceByIndex(i, filteredRemoteResource)
 		analysis.AddManaged(stateRes)
+
+		changelog, computed := a.computeChangelog(stateRes, remoteRes)
+		if computed {
+			haveComputedDiff = true
+		}
+		if len(changelog) > 0 {
+			analysis.AddDifference(Difference{
+				Res:       stateRes,
+				Changelog: changelog,
+			})
+		}
 	}
 
 	if a.hasUnmanagedSecurityGroupRules(filteredRemoteResource) {
@@ -103,6 +126,78 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []*resource.Resour
 	return analysis, nil
 }
 
+// computeChangelog compares attributes of a managed resource as found in the IaC with the ones retrieved
+// from the remote. Remote enumerators only retrieve a subset of attributes, so we only compare top level
+// attributes that are known on both sides. Changes on attributes ignored by the filter are left out.
+// The second returned value is true if at least one change has been detected on a computed field.
+func (a Analyzer) computeChangelog(stateRes, remoteRes *resource.Resource) (Changelog, bool) {
+	if stateRes.Attributes() == nil || remoteRes.Attributes() == nil {
+		return nil, false
+	}
+
+	delta, err := diff.Diff(*stateRes.Attributes(), *remoteRes.Attributes())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"id":   stateRes.ResourceId(),
+			"type": stateRes.ResourceType(),
+		}).Debugf("Unable to compute diff: %s", err)
+		return nil, false
+	}
+
+	haveComputedDiff := false
+	changelog := make(Changelog, 0, len(delta))
+	for _, change := range delta {
+		if len(change.Path) == 0 {
+			continue
+		}
+		if _, exist := stateRes.Attributes().Get(change.Path[0]); !exist {
+			continue
+		}
+		if _, exist := remoteRes.Attributes().Get(change.Path[0]); !exist {
+			continue
+		}
+		if a.filter.IsFieldIgnored(stateRes, change.Path) {
+			continue
+		}
+
+		c := Change{Change: change}
+		if resSchema := stateRes.Schema(); resSchema != nil {
+			c.Computed = resSchema.IsComputedField(c.Path)
+			c.JsonString = resSchema.IsJsonStringField(c.Path)
+		}
+		if c.JsonString && isJsonEqual(c.From, c.To) {
+			continue
+		}
+		if c.Computed {
+			haveComputedDiff = true
+		}
+		changelog = append(changelog, c)
+	}
+
+	return SortChanges(changelog), haveComputedDiff
+}
+
+// isJsonEqual returns true if both values are JSON strings holding the same document,
+// regardless of the keys ordering or the formatting.
+func isJsonEqual(from, to interface{}) bool {
+	fromStr, ok := from.(string)
+	if !ok {
+		return false
+	}
+	toStr, ok := to.(string)
+	if !ok {
+		return false
+	}
+	var fromJson, toJson interface{}
+	if err := json.Unmarshal([]byte(fromStr), &fromJson); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(toStr), &toJson); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(fromJson, toJson)
+}
+
 func findCorrespondingRes(resources []*resource.Resource, res *resource.Resource) (int, *resource.Resource, bool) {
 	for i, r := range resources {
 		if res.Equal(r) {
@@ -112,6 +207,13 @@ func findCorrespondingRes(resources []*resource.Resource, res *resource.Resource
 	return -1, nil, false
 }
 
+// isPendingInPlan returns true for resources read from a Terraform plan that
+// the plan is about to create or destroy
+func isPendingInPlan(res *resource.Resource) bool {
+	source, ok := res.Source.(*resource.TerraformPlanSource)
+	return ok && source.Pending
+}
+
 func removeResourceByIndex(i int, resources []*resource.Resource) []*resource.Resource {
 	if i == len(resources)-1 {
 		return resources[:len(resources)-1]
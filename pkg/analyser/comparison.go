@@ -0,0 +1,141 @@
+package analyser
+
+import (
+	"reflect"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// Comparison holds what changed between two analyses of the same infrastructure
+type Comparison struct {
+	previous *Analysis
+	current  *Analysis
+
+	NewUnmanaged      []*resource.Resource
+	ResolvedUnmanaged []*resource.Resource
+	NewDeleted        []*resource.Resource
+	ResolvedDeleted   []*resource.Resource
+	// NewDifferences only hold changes that were not reported by the previous analysis
+	NewDifferences      []Difference
+	ResolvedDifferences []Difference
+}
+
+// Compare returns the drift that appeared or got resolved between a previous and a current analysis
+func Compare(previous, current *Analysis) *Comparison {
+	c := &Comparison{
+		previous:          previous,
+		current:           current,
+		NewUnmanaged:      subtractResources(current.Unmanaged(), previous.Unmanaged()),
+		ResolvedUnmanaged: subtractResources(previous.Unmanaged(), current.Unmanaged()),
+		NewDeleted:        subtractResources(current.Deleted(), previous.Deleted()),
+		ResolvedDeleted:   subtractResources(previous.Deleted(), current.Deleted()),
+	}
+
+	for _, difference := range current.Differences() {
+		previousDifference, found := findDifference(previous.Differences(), difference.Res)
+		if !found {
+			c.NewDifferences = append(c.NewDifferences, difference)
+			continue
+		}
+		var changelog Changelog
+		for _, change := range difference.Changelog {
+			if !containsChange(previousDifference.Changelog, change) {
+				changelog = append(changelog, change)
+			}
+		}
+		if len(changelog) > 0 {
+			c.NewDifferences = append(c.NewDifferences, Difference{Res: difference.Res, Changelog: changelog})
+		}
+	}
+	for _, difference := range previous.Differences() {
+		if _, found := findDifference(current.Differences(), difference.Res); !found {
+			c.ResolvedDifferences = append(c.ResolvedDifferences, difference)
+		}
+	}
+
+	return c
+}
+
+// CoverageChange returns the evolution of the coverage in percentage points
+func (c *Comparison) CoverageChange() int {
+	return c.current.Coverage() - c.previous.Coverage()
+}
+
+func (c *Comparison) PreviousCoverage() int {
+	return c.previous.Coverage()
+}
+
+func (c *Comparison) Coverage() int {
+	return c.current.Coverage()
+}
+
+func (c *Comparison) HasNewDrift() bool {
+	return len(c.NewUnmanaged) > 0 || len(c.NewDeleted) > 0 || len(c.NewDifferences) > 0
+}
+
+// Analysis returns an analysis of the current scan only holding the new drift, so it can be written
+// with any output. Managed resources, alerts, timings and failed resource types are the ones of the current
+// analysis. The total of resources, hence the coverage, is the one of the current scan too, while drift
+// totals only count the new drift. The resolved drift and the previous coverage are held by its Resolved field.
+func (c *Comparison) Analysis() *Analysis {
+	a := NewAnalysis()
+	a.AddManaged(c.current.Managed()...)
+	a.AddUnmanaged(c.NewUnmanaged...)
+	a.AddDeleted(c.NewDeleted...)
+	a.AddDifference(c.NewDifferences...)
+	a.SetAlerts(c.current.Alerts())
+	a.SetIaCSourceCount(c.current.Summary().TotalIaCSourceCount)
+	a.summary.TotalResources = c.current.Summary().TotalResources
+	a.Duration = c.current.Duration
+	a.Date = c.current.Date
+	a.ProviderName = c.current.ProviderName
+	a.ProviderVersion = c.current.ProviderVersion
+	a.EnumerationTimings = c.current.EnumerationTimings
+	a.FailedResourceTypes = c.current.FailedResourceTypes
+	a.Resolved = &Resolution{
+		Unmanaged:        c.ResolvedUnmanaged,
+		Deleted:          c.ResolvedDeleted,
+		Differences:      c.ResolvedDifferences,
+		PreviousCoverage: c.PreviousCoverage(),
+	}
+	a.SortResources()
+	return a
+}
+
+// subtractResources returns resources that are not in the other list, resources are matched on their type, id,
+// account and region
+func subtractResources(resources, other []*resource.Resource) []*resource.Resource {
+	var result []*resource.Resource
+	for _, res := range resources {
+		found := false
+		for _, o := range other {
+			if res.Equal(o) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, res)
+		}
+	}
+	return result
+}
+
+func findDifference(differences []Difference, res *resource.Resource) (Difference, bool) {
+	for _, difference := range differences {
+		if difference.Res.Equal(res) {
+			return difference, true
+		}
+	}
+	return Difference{}, false
+}
+
+func containsChange(changelog Changelog, change Change) bool {
+	for _, c := range changelog {
+		if c.Type == change.Type && reflect.DeepEqual(c.Path, change.Path) &&
+			reflect.DeepEqual(c.From, change.From) && reflect.DeepEqual(c.To, change.To) {
+			return true
+		}
+	}
+	return false
+}
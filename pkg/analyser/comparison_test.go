@@ -0,0 +1,100 @@
+package analyser
+
+import (
+	"testing"
+
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	bucket := &resource.Resource{Id: "bucket", Type: "aws_s3_bucket"}
+	otherBucket := &resource.Resource{Id: "other-bucket", Type: "aws_s3_bucket"}
+	user := &resource.Resource{Id: "user", Type: "aws_iam_user"}
+	otherUser := &resource.Resource{Id: "other-user", Type: "aws_iam_user"}
+	role := &resource.Resource{Id: "role", Type: "aws_iam_role"}
+	otherRole := &resource.Resource{Id: "other-role", Type: "aws_iam_role"}
+
+	aclChange := Change{Change: diff.Change{Type: diff.UPDATE, Path: []string{"acl"}, From: "private", To: "public-read"}}
+	policyChange := Change{Change: diff.Change{Type: diff.UPDATE, Path: []string{"policy"}, From: "{}", To: "{\"Statement\":[]}"}}
+
+	previous := NewAnalysis()
+	previous.AddManaged(bucket, otherBucket)
+	previous.AddUnmanaged(user)
+	previous.AddDeleted(role)
+	previous.AddDifference(
+		Difference{Res: bucket, Changelog: Changelog{aclChange}},
+		Difference{Res: otherBucket, Changelog: Changelog{aclChange}},
+	)
+
+	current := NewAnalysis()
+	current.AddManaged(bucket, otherBucket, user)
+	current.AddUnmanaged(otherUser)
+	current.AddDeleted(role, otherRole)
+	current.AddDifference(
+		Difference{Res: bucket, Changelog: Changelog{aclChange, policyChange}},
+	)
+
+	comparison := Compare(previous, current)
+
+	assert.Equal(t, []*resource.Resource{otherUser}, comparison.NewUnmanaged)
+	assert.Equal(t, []*resource.Resource{user}, comparison.ResolvedUnmanaged)
+	assert.Equal(t, []*resource.Resource{otherRole}, comparison.NewDeleted)
+	assert.Nil(t, comparison.ResolvedDeleted)
+	assert.Equal(t, []Difference{{Res: bucket, Changelog: Changelog{policyChange}}}, comparison.NewDifferences)
+	assert.Equal(t, []Difference{{Res: otherBucket, Changelog: Changelog{aclChange}}}, comparison.ResolvedDifferences)
+	assert.True(t, comparison.HasNewDrift())
+	assert.Equal(t, 50, comparison.PreviousCoverage())
+	assert.Equal(t, 50, comparison.Coverage())
+	assert.Equal(t, 0, comparison.CoverageChange())
+
+	analysis := comparison.Analysis()
+	assert.Equal(t, Summary{
+		TotalResources: 6,
+		TotalManaged:   3,
+		TotalUnmanaged: 1,
+		TotalDeleted:   1,
+		TotalDrifted:   1,
+	}, analysis.Summary())
+	assert.Equal(t, comparison.Coverage(), analysis.Coverage())
+	assert.Equal(t, &Resolution{
+		Unmanaged:        []*resource.Resource{user},
+		Differences:      []Difference{{Res: otherBucket, Changelog: Changelog{aclChange}}},
+		PreviousCoverage: 50,
+	}, analysis.Resolved)
+}
+
+func TestCompare_MatchResourcesOnTheirLocation(t *testing.T) {
+	queue := &resource.Resource{Id: "queue", Type: "aws_sqs_queue", Region: "us-east-1"}
+	otherRegionQueue := &resource.Resource{Id: "queue", Type: "aws_sqs_queue", Region: "eu-west-3"}
+	user := &resource.Resource{Id: "user", Type: "aws_iam_user", Account: "111111111111"}
+	otherAccountUser := &resource.Resource{Id: "user", Type: "aws_iam_user", Account: "222222222222"}
+
+	previous := NewAnalysis()
+	previous.AddUnmanaged(queue, user)
+
+	current := NewAnalysis()
+	current.AddUnmanaged(queue, otherRegionQueue, otherAccountUser)
+
+	comparison := Compare(previous, current)
+
+	assert.Equal(t, []*resource.Resource{otherRegionQueue, otherAccountUser}, comparison.NewUnmanaged)
+	assert.Equal(t, []*resource.Resource{user}, comparison.ResolvedUnmanaged)
+}
+
+func TestCompare_NoNewDrift(t *testing.T) {
+	user := &resource.Resource{Id: "user", Type: "aws_iam_user"}
+
+	previous := NewAnalysis()
+	previous.AddUnmanaged(user)
+
+	current := NewAnalysis()
+	current.AddManaged(user)
+
+	comparison := Compare(previous, current)
+
+	assert.False(t, comparison.HasNewDrift())
+	assert.Equal(t, 100, comparison.CoverageChange())
+	assert.True(t, comparison.Analysis().IsSync())
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/pkg/analyser"
+	cmderrors "github.com/snyk/driftctl/pkg/cmd/errors"
+	"github.com/snyk/driftctl/pkg/cmd/scan/output"
+	globaloutput "github.com/snyk/driftctl/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+func NewDiffCmd(opts *pkg.DiffOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff PREVIOUS_RESULT CURRENT_RESULT",
+		Short: "Compare two scan results",
+		Long: "Take two analysis results in JSON and report the drift that appeared since the previous one.\n" +
+			"Outputs hold the new drift, along with the drift resolved since the previous scan and the coverage change.\n\n" +
+			"Example: driftctl diff yesterday.json today.json",
+		Args: cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			outputFlag, _ := cmd.Flags().GetStringSlice("output")
+			out, err := parseOutputFlags(outputFlag)
+			if err != nil {
+				return err
+			}
+			if err := applyWebhookOptions(cmd, out); err != nil {
+				return err
+			}
+			opts.Output = out
+			opts.PreviousPath = args[0]
+			opts.CurrentPath = args[1]
+			opts.Quiet, _ = cmd.Flags().GetBool("quiet")
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDiff(opts)
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringSliceP(
+		"output",
+		"o",
+		[]string{output.Example(output.ConsoleOutputType)},
+		"Output format, by default it will write to the console\n"+
+			"Accepted formats are: "+strings.Join(output.SupportedOutputsExample(), ",")+"\n",
+	)
+	addWebhookFlags(fl)
+	fl.Bool(
+		"quiet",
+		false,
+		"Do not display anything but diff results",
+	)
+
+	return cmd
+}
+
+func runDiff(opts *pkg.DiffOptions) error {
+	if output.ShouldPrint(opts.Output, opts.Quiet) {
+		globaloutput.ChangePrinter(globaloutput.NewConsolePrinter())
+	}
+
+	previous, err := readAnalysis(opts.PreviousPath)
+	if err != nil {
+		return err
+	}
+	current, err := readAnalysis(opts.CurrentPath)
+	if err != nil {
+		return err
+	}
+
+	comparison := analyser.Compare(previous, current)
+	analysis := comparison.Analysis()
+
+	for _, o := range opts.Output {
+		if err := output.GetOutput(o).Write(analysis); err != nil {
+			logrus.Errorf("Error writing to output %s: %v", o.String(), err.Error())
+		}
+	}
+
+	if comparison.HasNewDrift() {
+		return cmderrors.InfrastructureNotInSync{}
+	}
+
+	return nil
+}
+
+func readAnalysis(path string) (*analyser.Analysis, error) {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := analyser.NewAnalysis()
+	if err := json.Unmarshal(input, analysis); err != nil {
+		return nil, err
+	}
+
+	return analysis, nil
+}
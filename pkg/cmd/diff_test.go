@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/pkg/analyser"
+	cmderrors "github.com/snyk/driftctl/pkg/cmd/errors"
+	"github.com/snyk/driftctl/pkg/cmd/scan/output"
+	"github.com/snyk/driftctl/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_runDiff(t *testing.T) {
+	resultPath := path.Join(t.TempDir(), "result.json")
+	opts := &pkg.DiffOptions{
+		PreviousPath: "testdata/diff/previous.json",
+		CurrentPath:  "testdata/diff/current.json",
+		Output: []output.OutputConfig{
+			{
+				Key:  output.JSONOutputType,
+				Path: resultPath,
+			},
+		},
+		Quiet: true,
+	}
+
+	err := runDiff(opts)
+	assert.Equal(t, cmderrors.InfrastructureNotInSync{}, err)
+
+	result, err := os.ReadFile(resultPath)
+	require.Nil(t, err)
+	analysis := analyser.NewAnalysis()
+	require.Nil(t, json.Unmarshal(result, analysis))
+
+	assert.Equal(t, 3, analysis.Summary().TotalManaged)
+	require.Len(t, analysis.Unmanaged(), 1)
+	assert.Equal(t, "user-3", analysis.Unmanaged()[0].ResourceId())
+	require.Len(t, analysis.Deleted(), 1)
+	assert.Equal(t, "role-2", analysis.Deleted()[0].ResourceId())
+	require.Len(t, analysis.Differences(), 1)
+	assert.Equal(t, "bucket-2", analysis.Differences()[0].Res.ResourceId())
+	assert.Equal(t, []string{"aws_sqs_queue"}, analysis.FailedResourceTypes)
+
+	// Resolved drift and the previous coverage are written along with the new drift
+	require.NotNil(t, analysis.Resolved)
+	require.Len(t, analysis.Resolved.Unmanaged, 1)
+	assert.Equal(t, "user-1", analysis.Resolved.Unmanaged[0].ResourceId())
+	require.Len(t, analysis.Resolved.Deleted, 1)
+	assert.Equal(t, "role-1", analysis.Resolved.Deleted[0].ResourceId())
+	require.Len(t, analysis.Resolved.Differences, 1)
+	assert.Equal(t, "bucket-1", analysis.Resolved.Differences[0].Res.ResourceId())
+	assert.Equal(t, 40, analysis.Resolved.PreviousCoverage)
+
+	// Coverage and total of resources are the ones of the current scan, not of the new drift only
+	var written struct {
+		Summary  analyser.Summary `json:"summary"`
+		Coverage int              `json:"coverage"`
+	}
+	require.Nil(t, json.Unmarshal(result, &written))
+	assert.Equal(t, 50, written.Coverage)
+	assert.Equal(t, 6, written.Summary.TotalResources)
+}
+
+func Test_runDiff_NoNewDrift(t *testing.T) {
+	opts := &pkg.DiffOptions{
+		PreviousPath: "testdata/diff/current.json",
+		CurrentPath:  "testdata/diff/current.json",
+		Output: []output.OutputConfig{
+			{
+				Key:  output.JSONOutputType,
+				Path: path.Join(t.TempDir(), "result.json"),
+			},
+		},
+		Quiet: true,
+	}
+
+	assert.Nil(t, runDiff(opts))
+}
+
+func Test_runDiff_InvalidInput(t *testing.T) {
+	opts := &pkg.DiffOptions{
+		PreviousPath: "testdata/fmt/input_stdin_invalid.json",
+		CurrentPath:  "testdata/diff/current.json",
+		Quiet:        true,
+	}
+
+	err := runDiff(opts)
+	require.NotNil(t, err)
+	assert.Equal(t, "invalid character 'i' looking for beginning of value", err.Error())
+}
+
+func TestDiffCmd_Invalid(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"diff"}, expected: "accepts 2 arg(s), received 0"},
+		{args: []string{"diff", "previous.json"}, expected: "accepts 2 arg(s), received 1"},
+		{args: []string{"diff", "previous.json", "current.json", "-o", "foobar://barfoo"}, expected: "Unsupported output 'foobar': \nValid formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,junit://PATH/TO/FILE.xml,markdown://PATH/TO/FILE.md,plan://PATH/TO/FILE.json,prometheus://PATH/TO/FILE.prom,sarif://PATH/TO/FILE.sarif,tf-import://PATH/TO/DIR,webhook+https://URL"},
+	}
+
+	for _, tt := range cases {
+		rootCmd := &cobra.Command{Use: "root"}
+		rootCmd.AddCommand(NewDiffCmd(&pkg.DiffOptions{}))
+		_, err := test.Execute(rootCmd, tt.args...)
+		if err == nil {
+			t.Errorf("Invalid arg should generate error")
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("Expected '%v', got '%v'", tt.expected, err)
+		}
+	}
+}
+
+func TestDiffCmd_WebhookOptions(t *testing.T) {
+	opts := &pkg.DiffOptions{}
+	rootCmd := &cobra.Command{Use: "root"}
+	diffCmd := NewDiffCmd(opts)
+	diffCmd.RunE = func(_ *cobra.Command, args []string) error { return nil }
+	rootCmd.AddCommand(diffCmd)
+
+	_, err := test.Execute(rootCmd, "diff", "previous.json", "current.json", "-o", "webhook+https://example.com/hook", "--webhook-template", "slack", "--webhook-headers", "X-Token=secret")
+	require.NoError(t, err)
+	require.Len(t, opts.Output, 1)
+	require.NotNil(t, opts.Output[0].Webhook)
+	assert.Equal(t, map[string]string{"X-Token": "secret"}, opts.Output[0].Webhook.Headers)
+	assert.False(t, opts.Output[0].Webhook.Always)
+	assert.NotNil(t, opts.Output[0].Webhook.Template)
+}
@@ -70,7 +70,10 @@ func NewDriftctlCmd(build build.BuildInterface) *DriftctlCmd {
 
 	cmd.AddCommand(NewScanCmd(&pkg.ScanOptions{}))
 	cmd.AddCommand(NewFmtCmd(&pkg.FmtOptions{}))
+	cmd.AddCommand(NewDiffCmd(&pkg.DiffOptions{}))
+	cmd.AddCommand(NewServeCmd(&pkg.ServeOptions{}))
 	cmd.AddCommand(NewGenDriftIgnoreCmd())
+	cmd.AddCommand(NewDriftIgnoreCmd())
 
 	return cmd
 }
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg"
+	cmderrors "github.com/snyk/driftctl/pkg/cmd/errors"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/spf13/cobra"
+)
+
+func NewDriftIgnoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "driftignore",
+		Short: "Manage driftignore rules",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewDriftIgnoreLintCmd(&pkg.DriftIgnoreLintOptions{}))
+
+	return cmd
+}
+
+func NewDriftIgnoreLintCmd(opts *pkg.DriftIgnoreLintOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Report driftignore rules to prune or renew",
+		Long: "List the driftignore rules that are expired, that expire soon or that have invalid annotations.\n" +
+			"Rules can be annotated with a trailing comment: PATTERN # expires=2026-12-31 owner=team-infra reason=\"...\" ticket=INFRA-123\n\n" +
+			"When a scan result is given, rules that no longer match any resource are listed too. " +
+			"Attribute rules, e.g. aws_instance.*.tags.LastPatched, are kept as long as they match a managed resource. " +
+			"Ignored resources are left out of scan results, so unused rules are only looked for in results of scans run " +
+			"without ignore rules nor filter expression.\n\n" +
+			"Example: driftctl scan --driftignore /dev/null -o json://result.json; driftctl driftignore lint -i result.json",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDriftIgnoreLint(opts, cmd.OutOrStdout())
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVar(&opts.DriftignorePath, "driftignore", ".driftignore", "Path to the driftignore file to lint")
+	fl.StringVarP(&opts.InputPath, "input", "i", "", "JSON scan result to find rules that no longer match any resource")
+	fl.DurationVar(&opts.StaleWithin, "stale-within", 30*24*time.Hour, "Report rules expiring within this duration as stale")
+
+	return cmd
+}
+
+func runDriftIgnoreLint(opts *pkg.DriftIgnoreLintOptions, out io.Writer) error {
+	var keys, managedKeys []string
+	if opts.InputPath != "" {
+		analysis, err := readAnalysis(opts.InputPath)
+		if err != nil {
+			return err
+		}
+		if analysis.Filtered {
+			return errors.Errorf("unable to find unused rules in %s, resources were left out of this scan result by ignore rules or a filter expression\n"+
+				"Run the scan with --driftignore /dev/null and without --filter", opts.InputPath)
+		}
+		keys = []string{}
+		for _, res := range analysis.Managed() {
+			managedKeys = append(managedKeys, fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()))
+		}
+		keys = append(keys, managedKeys...)
+		for _, resources := range [][]*resource.Resource{analysis.Unmanaged(), analysis.Deleted()} {
+			for _, res := range resources {
+				keys = append(keys, fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()))
+			}
+		}
+		for _, difference := range analysis.Differences() {
+			for _, change := range difference.Changelog {
+				keys = append(keys, fmt.Sprintf("%s.%s.%s", difference.Res.ResourceType(), difference.Res.ResourceId(), strings.Join(change.Path, ".")))
+			}
+		}
+	}
+
+	driftIgnore := filter.NewDriftIgnore(opts.DriftignorePath)
+	result := driftIgnore.Lint(time.Now().Add(opts.StaleWithin), keys, managedKeys)
+	if result.IsEmpty() {
+		fmt.Fprintf(out, "All %d rule(s) of %s are up to date\n", len(driftIgnore.Rules()), opts.DriftignorePath)
+		return nil
+	}
+
+	printLintedRules(out, opts.DriftignorePath, "expired rule(s), no longer applied", result.Expired)
+	printLintedRules(out, opts.DriftignorePath, fmt.Sprintf("stale rule(s), expiring within %s", opts.StaleWithin), result.Stale)
+	printLintedRules(out, opts.DriftignorePath, "rule(s) with invalid annotations", result.Invalid)
+	printLintedRules(out, opts.DriftignorePath, "rule(s) not matching any resource of the scan", result.Unused)
+
+	return cmderrors.DriftIgnoreLintFailed{}
+}
+
+func printLintedRules(out io.Writer, path, title string, rules []filter.Rule) {
+	if len(rules) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "%d %s:\n", len(rules), title)
+	for _, rule := range rules {
+		details := rule.Error
+		if details == "" {
+			details = rule.Annotations()
+		}
+		if details != "" {
+			details = fmt.Sprintf(" (%s)", details)
+		}
+		fmt.Fprintf(out, "  - %s:%d %s%s\n", path, rule.Line, rule.Pattern, details)
+	}
+}
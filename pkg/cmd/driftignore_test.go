@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	cmderrors "github.com/snyk/driftctl/pkg/cmd/errors"
+	"github.com/snyk/driftctl/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriftIgnoreLintCmd(t *testing.T) {
+	cases := []struct {
+		name   string
+		args   []string
+		output string
+		err    error
+	}{
+		{
+			name: "test expired rules without scan result",
+			args: []string{"--driftignore", "testdata/driftignore_lint/.driftignore"},
+			output: "1 expired rule(s), no longer applied:\n" +
+				"  - testdata/driftignore_lint/.driftignore:1 aws_s3_bucket.bucket-1 (expires: 2000-01-31, owner: team-infra, ticket: INFRA-123)\n",
+			err: cmderrors.DriftIgnoreLintFailed{},
+		},
+		{
+			name: "test stale and unused rules with scan result",
+			args: []string{"--driftignore", "testdata/driftignore_lint/.driftignore", "-i", "testdata/diff/current.json", "--stale-within", "1000000h"},
+			output: "1 expired rule(s), no longer applied:\n" +
+				"  - testdata/driftignore_lint/.driftignore:1 aws_s3_bucket.bucket-1 (expires: 2000-01-31, owner: team-infra, ticket: INFRA-123)\n" +
+				"1 stale rule(s), expiring within 1000000h0m0s:\n" +
+				"  - testdata/driftignore_lint/.driftignore:5 aws_iam_role.* (expires: 2100-12-31)\n" +
+				"1 rule(s) not matching any resource of the scan:\n" +
+				"  - testdata/driftignore_lint/.driftignore:4 aws_iam_user.user-9\n",
+			err: cmderrors.DriftIgnoreLintFailed{},
+		},
+		{
+			name:   "test up to date rules",
+			args:   []string{"--driftignore", "testdata/driftignore_lint/.driftignore_clean", "-i", "testdata/diff/current.json"},
+			output: "All 3 rule(s) of testdata/driftignore_lint/.driftignore_clean are up to date\n",
+		},
+		{
+			name: "test error when scan result is filtered",
+			args: []string{"--driftignore", "testdata/driftignore_lint/.driftignore_clean", "-i", "testdata/driftignore_lint/filtered.json"},
+			err: errors.New("unable to find unused rules in testdata/driftignore_lint/filtered.json, resources were left out of this scan result by ignore rules or a filter expression\n" +
+				"Run the scan with --driftignore /dev/null and without --filter"),
+		},
+		{
+			name: "test error when input file does not exist",
+			args: []string{"--driftignore", "testdata/driftignore_lint/.driftignore_clean", "-i", "doesnotexist"},
+			err:  errors.New("open doesnotexist: no such file or directory"),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rootCmd := &cobra.Command{Use: "root"}
+			rootCmd.AddCommand(NewDriftIgnoreCmd())
+			rootCmd.SilenceErrors = true
+			rootCmd.SilenceUsage = true
+
+			output, err := test.Execute(rootCmd, append([]string{"driftignore", "lint"}, c.args...)...)
+			if c.err != nil {
+				assert.EqualError(t, err, c.err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			if c.output != "" {
+				assert.Equal(t, c.output, output)
+			}
+		})
+	}
+}
@@ -11,3 +11,11 @@ func NewUsageError(msg string) UsageError {
 func (u UsageError) Error() string {
 	return u.msg
 }
+
+// DriftIgnoreLintFailed is returned when some driftignore rules are expired, about
+// to expire, invalid or unused, they have been printed already
+type DriftIgnoreLintFailed struct{}
+
+func (d DriftIgnoreLintFailed) Error() string {
+	return "Some driftignore rules need attention"
+}
@@ -5,3 +5,11 @@ type InfrastructureNotInSync struct{}
 func (i InfrastructureNotInSync) Error() string {
 	return "Infrastructure is not in sync"
 }
+
+// PartialScan is returned when some resource types could not be listed, the
+// results of the scan have been written anyway
+type PartialScan struct{}
+
+func (p PartialScan) Error() string {
+	return "Scan is partial, some resource types could not be listed"
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/supplier"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/net/http/httpguts"
 )
 
 func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
@@ -87,6 +90,29 @@ func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
 	return configs, nil
 }
 
+// addHeadersFlag adds a flag of HTTP headers given as NAME=VALUE pairs, see parseHeadersFlag
+func addHeadersFlag(fl *pflag.FlagSet, name, shorthand, usage string) {
+	fl.StringToStringP(name, shorthand, map[string]string{}, usage)
+}
+
+// parseHeadersFlag returns the HTTP headers of a flag added by addHeadersFlag, headers that
+// cannot be sent in a request are refused
+func parseHeadersFlag(cmd *cobra.Command, name string) (map[string]string, error) {
+	headers, err := cmd.Flags().GetStringToString(name)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range headers {
+		if !httpguts.ValidHeaderFieldName(key) {
+			return nil, errors.Errorf("invalid HTTP header name '%s' in --%s", key, name)
+		}
+		if !httpguts.ValidHeaderFieldValue(value) {
+			return nil, errors.Errorf("invalid value of HTTP header '%s' in --%s", key, name)
+		}
+	}
+	return headers, nil
+}
+
 func parseOutputFlags(out []string) ([]output.OutputConfig, error) {
 	result := make([]output.OutputConfig, 0, len(out))
 	for _, v := range out {
@@ -117,6 +143,11 @@ func parseOutputFlag(out string) (*output.OutputConfig, error) {
 	o := &output.OutputConfig{
 		Key: schemeOpts[0],
 	}
+	// Webhook outputs carry the scheme of the endpoint, e.g. webhook+https://URL
+	webhookScheme := ""
+	if strings.HasPrefix(o.Key, output.WebhookOutputType+"+") {
+		o.Key, webhookScheme, _ = strings.Cut(o.Key, "+")
+	}
 	if !output.IsSupported(o.Key) {
 		return nil, errors.Wrapf(
 			cmderrors.NewUsageError(
@@ -175,6 +206,90 @@ func parseOutputFlag(out string) (*output.OutputConfig, error) {
 			)
 		}
 		o.Path = opts[0]
+	case output.SARIFOutputType:
+		if len(opts) != 1 || opts[0] == "" {
+			return nil, errors.Wrapf(
+				cmderrors.NewUsageError(
+					fmt.Sprintf(
+						"\nMust be of kind: %s",
+						output.Example(output.SARIFOutputType),
+					),
+				),
+				"Invalid sarif output '%s'",
+				out,
+			)
+		}
+		o.Path = opts[0]
+	case output.JUnitOutputType:
+		if len(opts) != 1 || opts[0] == "" {
+			return nil, errors.Wrapf(
+				cmderrors.NewUsageError(
+					fmt.Sprintf(
+						"\nMust be of kind: %s",
+						output.Example(output.JUnitOutputType),
+					),
+				),
+				"Invalid junit output '%s'",
+				out,
+			)
+		}
+		o.Path = opts[0]
+	case output.MarkdownOutputType:
+		if len(opts) != 1 || opts[0] == "" {
+			return nil, errors.Wrapf(
+				cmderrors.NewUsageError(
+					fmt.Sprintf(
+						"\nMust be of kind: %s",
+						output.Example(output.MarkdownOutputType),
+					),
+				),
+				"Invalid markdown output '%s'",
+				out,
+			)
+		}
+		o.Path = opts[0]
+	case output.TFImportOutputType:
+		if len(opts) != 1 || opts[0] == "" {
+			return nil, errors.Wrapf(
+				cmderrors.NewUsageError(
+					fmt.Sprintf(
+						"\nMust be of kind: %s",
+						output.Example(output.TFImportOutputType),
+					),
+				),
+				"Invalid tf-import output '%s'",
+				out,
+			)
+		}
+		o.Path = opts[0]
+	case output.PrometheusOutputType:
+		if len(opts) != 1 || opts[0] == "" {
+			return nil, errors.Wrapf(
+				cmderrors.NewUsageError(
+					fmt.Sprintf(
+						"\nMust be of kind: %s",
+						output.Example(output.PrometheusOutputType),
+					),
+				),
+				"Invalid prometheus output '%s'",
+				out,
+			)
+		}
+		o.Path = opts[0]
+	case output.WebhookOutputType:
+		if (webhookScheme != "http" && webhookScheme != "https") || opts[0] == "" {
+			return nil, errors.Wrapf(
+				cmderrors.NewUsageError(
+					fmt.Sprintf(
+						"\nMust be of kind: %s",
+						output.Example(output.WebhookOutputType),
+					),
+				),
+				"Invalid webhook output '%s'",
+				out,
+			)
+		}
+		o.Path = webhookScheme + "://" + strings.Join(opts, "://")
 	}
 
 	return o, nil
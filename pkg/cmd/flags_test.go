@@ -83,7 +83,7 @@ func Test_parseOutputFlag(t *testing.T) {
 				out: []string{""},
 			},
 			want: []output.OutputConfig{},
-			err:  fmt.Errorf("Unable to parse output flag '': \nAccepted formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,plan://PATH/TO/FILE.json"),
+			err:  fmt.Errorf("Unable to parse output flag '': \nAccepted formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,junit://PATH/TO/FILE.xml,markdown://PATH/TO/FILE.md,plan://PATH/TO/FILE.json,prometheus://PATH/TO/FILE.prom,sarif://PATH/TO/FILE.sarif,tf-import://PATH/TO/DIR,webhook+https://URL"),
 		},
 		{
 			name: "test empty array",
@@ -99,7 +99,7 @@ func Test_parseOutputFlag(t *testing.T) {
 				out: []string{"sdgjsdgjsdg"},
 			},
 			want: []output.OutputConfig{},
-			err:  fmt.Errorf("Unable to parse output flag 'sdgjsdgjsdg': \nAccepted formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,plan://PATH/TO/FILE.json"),
+			err:  fmt.Errorf("Unable to parse output flag 'sdgjsdgjsdg': \nAccepted formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,junit://PATH/TO/FILE.xml,markdown://PATH/TO/FILE.md,plan://PATH/TO/FILE.json,prometheus://PATH/TO/FILE.prom,sarif://PATH/TO/FILE.sarif,tf-import://PATH/TO/DIR,webhook+https://URL"),
 		},
 		{
 			name: "test invalid",
@@ -107,7 +107,7 @@ func Test_parseOutputFlag(t *testing.T) {
 				out: []string{"://"},
 			},
 			want: []output.OutputConfig{},
-			err:  fmt.Errorf("Unable to parse output flag '://': \nAccepted formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,plan://PATH/TO/FILE.json"),
+			err:  fmt.Errorf("Unable to parse output flag '://': \nAccepted formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,junit://PATH/TO/FILE.xml,markdown://PATH/TO/FILE.md,plan://PATH/TO/FILE.json,prometheus://PATH/TO/FILE.prom,sarif://PATH/TO/FILE.sarif,tf-import://PATH/TO/DIR,webhook+https://URL"),
 		},
 		{
 			name: "test unsupported",
@@ -115,7 +115,7 @@ func Test_parseOutputFlag(t *testing.T) {
 				out: []string{"foobar://"},
 			},
 			want: []output.OutputConfig{},
-			err:  fmt.Errorf("Unsupported output 'foobar': \nValid formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,plan://PATH/TO/FILE.json"),
+			err:  fmt.Errorf("Unsupported output 'foobar': \nValid formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,junit://PATH/TO/FILE.xml,markdown://PATH/TO/FILE.md,plan://PATH/TO/FILE.json,prometheus://PATH/TO/FILE.prom,sarif://PATH/TO/FILE.sarif,tf-import://PATH/TO/DIR,webhook+https://URL"),
 		},
 		{
 			name: "test empty json",
@@ -171,6 +171,144 @@ func Test_parseOutputFlag(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "test empty sarif",
+			args: args{
+				out: []string{"sarif://"},
+			},
+			want: []output.OutputConfig{},
+			err:  fmt.Errorf("Invalid sarif output 'sarif://': \nMust be of kind: sarif://PATH/TO/FILE.sarif"),
+		},
+		{
+			name: "test valid sarif",
+			args: args{
+				out: []string{"sarif:///tmp/foobar.sarif"},
+			},
+			want: []output.OutputConfig{
+				{
+					Key:  "sarif",
+					Path: "/tmp/foobar.sarif",
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "test empty junit",
+			args: args{
+				out: []string{"junit://"},
+			},
+			want: []output.OutputConfig{},
+			err:  fmt.Errorf("Invalid junit output 'junit://': \nMust be of kind: junit://PATH/TO/FILE.xml"),
+		},
+		{
+			name: "test valid junit",
+			args: args{
+				out: []string{"junit:///tmp/foobar.xml"},
+			},
+			want: []output.OutputConfig{
+				{
+					Key:  "junit",
+					Path: "/tmp/foobar.xml",
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "test empty markdown",
+			args: args{
+				out: []string{"markdown://"},
+			},
+			want: []output.OutputConfig{},
+			err:  fmt.Errorf("Invalid markdown output 'markdown://': \nMust be of kind: markdown://PATH/TO/FILE.md"),
+		},
+		{
+			name: "test valid markdown",
+			args: args{
+				out: []string{"markdown:///tmp/foobar.md"},
+			},
+			want: []output.OutputConfig{
+				{
+					Key:  "markdown",
+					Path: "/tmp/foobar.md",
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "test empty tf-import",
+			args: args{
+				out: []string{"tf-import://"},
+			},
+			want: []output.OutputConfig{},
+			err:  fmt.Errorf("Invalid tf-import output 'tf-import://': \nMust be of kind: tf-import://PATH/TO/DIR"),
+		},
+		{
+			name: "test valid tf-import",
+			args: args{
+				out: []string{"tf-import:///tmp/imports"},
+			},
+			want: []output.OutputConfig{
+				{
+					Key:  "tf-import",
+					Path: "/tmp/imports",
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "test empty prometheus",
+			args: args{
+				out: []string{"prometheus://"},
+			},
+			want: []output.OutputConfig{},
+			err:  fmt.Errorf("Invalid prometheus output 'prometheus://': \nMust be of kind: prometheus://PATH/TO/FILE.prom"),
+		},
+		{
+			name: "test valid prometheus",
+			args: args{
+				out: []string{"prometheus:///var/lib/node_exporter/driftctl.prom"},
+			},
+			want: []output.OutputConfig{
+				{
+					Key:  "prometheus",
+					Path: "/var/lib/node_exporter/driftctl.prom",
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "test invalid webhook scheme",
+			args: args{
+				out: []string{"webhook+ftp://example.com"},
+			},
+			want: []output.OutputConfig{},
+			err: fmt.Errorf(
+				"Invalid webhook output 'webhook+ftp://example.com': \nMust be of kind: webhook+https://URL",
+			),
+		},
+		{
+			name: "test webhook without scheme",
+			args: args{
+				out: []string{"webhook://example.com"},
+			},
+			want: []output.OutputConfig{},
+			err: fmt.Errorf(
+				"Invalid webhook output 'webhook://example.com': \nMust be of kind: webhook+https://URL",
+			),
+		},
+		{
+			name: "test valid webhook",
+			args: args{
+				out: []string{"webhook+https://hooks.slack.com/services/T000/B000/XXXX"},
+			},
+			want: []output.OutputConfig{
+				{
+					Key:  "webhook",
+					Path: "https://hooks.slack.com/services/T000/B000/XXXX",
+				},
+			},
+			err: nil,
+		},
 		{
 			name: "test multiple output values",
 			args: args{
@@ -197,7 +335,7 @@ func Test_parseOutputFlag(t *testing.T) {
 					Key: "console",
 				},
 			},
-			err: fmt.Errorf("Unsupported output 'invalid': \nValid formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,plan://PATH/TO/FILE.json"),
+			err: fmt.Errorf("Unsupported output 'invalid': \nValid formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,junit://PATH/TO/FILE.xml,markdown://PATH/TO/FILE.md,plan://PATH/TO/FILE.json,prometheus://PATH/TO/FILE.prom,sarif://PATH/TO/FILE.sarif,tf-import://PATH/TO/DIR,webhook+https://URL"),
 		},
 		{
 			name: "test multiple valid output values",
@@ -30,6 +30,9 @@ func NewFmtCmd(opts *pkg.FmtOptions) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if err := applyWebhookOptions(cmd, out); err != nil {
+				return err
+			}
 			opts.Output = out[0]
 			return nil
 		},
@@ -46,6 +49,7 @@ func NewFmtCmd(opts *pkg.FmtOptions) *cobra.Command {
 		"Output format, by default it will write to the console\n"+
 			"Accepted formats are: "+strings.Join(output.SupportedOutputsExample(), ",")+"\n",
 	)
+	addWebhookFlags(fl)
 
 	return cmd
 }
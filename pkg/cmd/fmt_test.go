@@ -112,7 +112,7 @@ func TestFmtCmd_Invalid(t *testing.T) {
 	}{
 		{args: []string{"fmt", "test"}, expected: `unknown command "test" for "root fmt"`},
 		{args: []string{"fmt", "-o", "json://test.json", "-o", "html://test.html"}, expected: "Only one output format can be set"},
-		{args: []string{"fmt", "-o", "foobar://barfoo"}, expected: "Unsupported output 'foobar': \nValid formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,plan://PATH/TO/FILE.json"},
+		{args: []string{"fmt", "-o", "foobar://barfoo"}, expected: "Unsupported output 'foobar': \nValid formats are: console://,html://PATH/TO/FILE.html,json://PATH/TO/FILE.json,junit://PATH/TO/FILE.xml,markdown://PATH/TO/FILE.md,plan://PATH/TO/FILE.json,prometheus://PATH/TO/FILE.prom,sarif://PATH/TO/FILE.sarif,tf-import://PATH/TO/DIR,webhook+https://URL"},
 	}
 
 	for _, tt := range cases {
@@ -129,3 +129,18 @@ func TestFmtCmd_Invalid(t *testing.T) {
 		})
 	}
 }
+
+func TestFmtCmd_WebhookOptions(t *testing.T) {
+	opts := &pkg.FmtOptions{}
+	rootCmd := &cobra.Command{Use: "root"}
+	fmtCmd := NewFmtCmd(opts)
+	fmtCmd.RunE = func(_ *cobra.Command, args []string) error { return nil }
+	rootCmd.AddCommand(fmtCmd)
+
+	_, err := test.Execute(rootCmd, "fmt", "-o", "webhook+https://example.com/hook", "--webhook-headers", "X-Token=secret", "--webhook-always")
+	require.NoError(t, err)
+	require.NotNil(t, opts.Output.Webhook)
+	assert.Equal(t, map[string]string{"X-Token": "secret"}, opts.Output.Webhook.Headers)
+	assert.True(t, opts.Output.Webhook.Always)
+	assert.NotNil(t, opts.Output.Webhook.Template)
+}
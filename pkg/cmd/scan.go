@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -19,7 +20,9 @@ import (
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote"
 	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	"github.com/snyk/driftctl/enumeration/terraform"
 	"github.com/snyk/driftctl/enumeration/terraform/lock"
 	"github.com/snyk/driftctl/pkg/analyser"
@@ -31,10 +34,13 @@ import (
 	"github.com/snyk/driftctl/pkg/telemetry"
 	"github.com/snyk/driftctl/pkg/terraform/hcl"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/snyk/driftctl/pkg"
 	cmderrors "github.com/snyk/driftctl/pkg/cmd/errors"
+	"github.com/snyk/driftctl/pkg/cmd/scan"
 	"github.com/snyk/driftctl/pkg/cmd/scan/output"
+	"github.com/snyk/driftctl/pkg/cmd/scan/profile"
 	"github.com/snyk/driftctl/pkg/filter"
 	"github.com/snyk/driftctl/pkg/iac/supplier"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
@@ -50,6 +56,10 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		Long:  "Scan",
 		Args:  cobra.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := applyProfile(cmd, profile.DefaultConfigFile); err != nil {
+				return err
+			}
+
 			from, _ := cmd.Flags().GetStringSlice("from")
 
 			iacSource, err := parseFromFlag(from)
@@ -59,6 +69,11 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 
 			opts.From = iacSource
 
+			opts.BackendOptions.Headers, err = parseHeadersFlag(cmd, "headers")
+			if err != nil {
+				return err
+			}
+
 			to, _ := cmd.Flags().GetString("to")
 			if !remote.IsSupported(to) {
 				return errors.Errorf(
@@ -74,6 +89,9 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if err := applyWebhookOptions(cmd, out); err != nil {
+				return err
+			}
 			opts.Output = out
 
 			filterFlag, _ := cmd.Flags().GetStringArray("filter")
@@ -110,11 +128,66 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 				}
 			}
 
+			awsRegions, _ := cmd.Flags().GetStringSlice("aws-regions")
+			if len(awsRegions) > 0 && to != common.RemoteAWSTerraform {
+				return errors.Errorf("--aws-regions can only be used with --to=%s", common.RemoteAWSTerraform)
+			}
+			opts.AWSRegions = awsRegions
+
+			opts.AWSAccounts, _ = cmd.Flags().GetStringSlice("aws-accounts")
+			opts.AWSOrganizationalUnit, _ = cmd.Flags().GetString("aws-organizational-unit")
+			opts.AWSAssumeRoleName, _ = cmd.Flags().GetString("aws-assume-role-name")
+			opts.AWSAssumeRoleExternalID, _ = cmd.Flags().GetString("aws-assume-role-external-id")
+			opts.AWSStateAccounts, _ = cmd.Flags().GetStringToString("aws-state-accounts")
+			if len(opts.AWSAccounts) > 0 || opts.AWSOrganizationalUnit != "" {
+				if to != common.RemoteAWSTerraform {
+					return errors.Errorf("--aws-accounts and --aws-organizational-unit can only be used with --to=%s", common.RemoteAWSTerraform)
+				}
+				if opts.AWSAssumeRoleName == "" {
+					return errors.New("--aws-assume-role-name is required to scan multiple AWS accounts")
+				}
+			} else if len(opts.AWSStateAccounts) > 0 {
+				return errors.New("--aws-state-accounts can only be used along with --aws-accounts or --aws-organizational-unit")
+			}
+
 			opts.Quiet, _ = cmd.Flags().GetBool("quiet")
 			opts.DisableTelemetry, _ = cmd.Flags().GetBool("disable-telemetry")
 
 			opts.ConfigDir, _ = cmd.Flags().GetString("config-dir")
 
+			cacheTTLFlag, _ := cmd.Flags().GetStringSlice("cache-ttl")
+			opts.CacheTTL, err = cache.ParseTTL(cacheTTLFlag)
+			if err != nil {
+				return err
+			}
+			opts.NoCache, _ = cmd.Flags().GetBool("no-cache")
+
+			opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+			if opts.Concurrency < 1 {
+				return errors.New("--concurrency must be at least 1")
+			}
+			opts.MaxRetries, _ = cmd.Flags().GetInt("max-retries")
+			if opts.MaxRetries < 0 {
+				return errors.New("--max-retries must not be negative")
+			}
+			opts.BestEffort, _ = cmd.Flags().GetBool("best-effort")
+
+			rateLimitFlag, _ := cmd.Flags().GetStringSlice("rate-limit")
+			opts.RateLimits, err = throttle.ParseRateLimits(rateLimitFlag)
+			if err != nil {
+				return err
+			}
+
+			opts.Timeout, _ = cmd.Flags().GetDuration("timeout")
+			if opts.Timeout < 0 {
+				return errors.New("--timeout must not be negative")
+			}
+			enumeratorTimeoutFlag, _ := cmd.Flags().GetStringSlice("enumerator-timeout")
+			opts.EnumeratorTimeouts, err = common.ParseTimeouts(enumeratorTimeoutFlag)
+			if err != nil {
+				return err
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -130,6 +203,12 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		false,
 		"Do not display anything but scan results",
 	)
+	fl.String(
+		"profile",
+		"",
+		"Name of the scan profile to use from the "+profile.DefaultConfigFile+" file of the working directory\n"+
+			"Flags and environment variables take precedence over the profile settings\n",
+	)
 	fl.StringArray(
 		"filter",
 		[]string{},
@@ -146,6 +225,7 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		"Output format, by default it will write to the console\n"+
 			"Accepted formats are: "+strings.Join(output.SupportedOutputsExample(), ",")+"\n",
 	)
+	addWebhookFlags(fl)
 	fl.StringSliceP(
 		"from",
 		"f",
@@ -162,10 +242,9 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		"Cloud provider source\n"+
 			"Accepted values are: "+strings.Join(supportedRemotes, ",")+"\n",
 	)
-	fl.StringToStringVarP(&opts.BackendOptions.Headers,
+	addHeadersFlag(fl,
 		"headers",
 		"H",
-		map[string]string{},
 		"Use those HTTP headers to query the provided URL.\n"+
 			"Only used with tfstate+http(s) backend for now.\n",
 	)
@@ -191,6 +270,40 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		os.Getenv("AZURE_STORAGE_KEY"),
 		"Azure storage account key for state backend.\n",
 	)
+	fl.StringSlice(
+		"aws-regions",
+		[]string{},
+		"AWS regions to scan, by default only the region of the current AWS session is scanned.\n"+
+			"Use '"+common.AWSAllRegions+"' to scan every region enabled for your account.\n"+
+			"Global services like IAM, Route53 or CloudFront are scanned only once.\n",
+	)
+	fl.StringSlice(
+		"aws-accounts",
+		[]string{},
+		"AWS accounts to scan by assuming the role given with --aws-assume-role-name in each of them.\n",
+	)
+	fl.String(
+		"aws-organizational-unit",
+		"",
+		"AWS Organizations organizational unit whose active accounts, including the ones of child units, are scanned.\n"+
+			"It can be used along with --aws-accounts and requires --aws-assume-role-name.\n",
+	)
+	fl.String(
+		"aws-assume-role-name",
+		"",
+		"Name of the IAM role to assume in every scanned AWS account.\n",
+	)
+	fl.String(
+		"aws-assume-role-external-id",
+		"",
+		"External ID to use when assuming the role in scanned AWS accounts.\n",
+	)
+	fl.StringToString(
+		"aws-state-accounts",
+		map[string]string{},
+		"AWS account of states when scanning multiple accounts, e.g. 'tfstate+s3://states/prod/*=111111111111'.\n"+
+			"States are matched using glob patterns, the account of other states is found from the ARNs of their resources.\n",
+	)
 	fl.String(
 		"tf-provider-version",
 		"",
@@ -228,6 +341,55 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		configDir,
 		"Directory path that driftctl uses for configuration.\n",
 	)
+	fl.StringSlice(
+		"cache-ttl",
+		[]string{},
+		"Keep resources listed from the cloud provider in a cache under the config directory for this long,\n"+
+			"either for every resource type (e.g. 10m) or for a given one (e.g. aws_s3_bucket=1h).\n"+
+			"Resources are always listed from the cloud provider when not set.\n",
+	)
+	fl.Bool(
+		"no-cache",
+		false,
+		"Do not use the cache of listed resources, even when a cache TTL is set\n",
+	)
+	fl.Int(
+		"concurrency",
+		common.DefaultConcurrency,
+		"Number of resource types listed from the cloud provider at the same time\n",
+	)
+	fl.Int(
+		"max-retries",
+		common.DefaultMaxRetries,
+		"Number of times listing a resource type is retried when the cloud provider throttles requests, before the scan fails.\n"+
+			"Throttled requests are first retried on their own by the cloud provider clients\n",
+	)
+	fl.StringSlice(
+		"rate-limit",
+		[]string{},
+		"Maximum number of requests per second sent to a service of the cloud provider, as SERVICE=RATE (e.g. ec2=20).\n"+
+			"SERVICE is an AWS API (e.g. ec2, s3, iam), an Azure resource provider (e.g. network, storage)\n"+
+			"or cloudasset for the Google Cloud Asset API.\n",
+	)
+	fl.Bool(
+		"best-effort",
+		false,
+		"Keep scanning when a resource type cannot be listed from the cloud provider.\n"+
+			"Resource types that failed are left out of the results and the scan exits with code "+strconv.Itoa(scan.EXIT_PARTIAL)+".\n",
+	)
+	fl.Duration(
+		"timeout",
+		0,
+		"Maximum time spent listing resources from the cloud provider (e.g. 30m).\n"+
+			"Resource types still being listed are left out of the results and the scan exits with code "+strconv.Itoa(scan.EXIT_PARTIAL)+".\n",
+	)
+	fl.StringSlice(
+		"enumerator-timeout",
+		[]string{},
+		"Maximum time spent listing a resource type from the cloud provider,\n"+
+			"either for every resource type (e.g. 5m) or for a given one (e.g. aws_s3_bucket=30s).\n"+
+			"Resource types not listed in time are left out of the results and the scan exits with code "+strconv.Itoa(scan.EXIT_PARTIAL)+".\n",
+	)
 	var deprecatedOnlyUnmanaged bool
 	fl.BoolVar(&deprecatedOnlyUnmanaged,
 		"only-unmanaged",
@@ -238,24 +400,163 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 	return cmd
 }
 
+// applyProfile sets the flags not given on the command line or through the
+// environment from the profile selected with --profile
+func applyProfile(cmd *cobra.Command, configPath string) error {
+	name, _ := cmd.Flags().GetString("profile")
+	if name == "" {
+		return nil
+	}
+
+	projectConfig, err := profile.Read(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.Errorf("unable to use profile '%s': %s not found in the working directory", name, configPath)
+		}
+		return err
+	}
+
+	p, err := projectConfig.Profile(name)
+	if err != nil {
+		return err
+	}
+
+	for flag, values := range p.Flags() {
+		if cmd.Flags().Changed(flag) {
+			continue
+		}
+		for _, value := range values {
+			if err := cmd.Flags().Set(flag, value); err != nil {
+				return errors.Wrapf(err, "invalid value for '%s' in profile '%s'", flag, name)
+			}
+		}
+		logrus.WithFields(logrus.Fields{
+			"profile": name,
+			"flag":    flag,
+		}).Debug("Applied profile setting to flag")
+	}
+
+	return nil
+}
+
+// addWebhookFlags adds the flags of webhook outputs, they are shared by every command writing outputs
+func addWebhookFlags(fl *pflag.FlagSet) {
+	fl.String(
+		"webhook-template",
+		output.WebhookPresetJSON,
+		"Payload posted by webhook outputs, either a preset or the path to a Go template file rendered from the analysis\n"+
+			"Accepted presets are: "+strings.Join(output.WebhookPresets(), ",")+"\n",
+	)
+	addHeadersFlag(fl,
+		"webhook-headers",
+		"",
+		"Use those HTTP headers to post to webhook outputs.\n"+
+			"Headers given with --headers are not sent to webhooks, so that credentials of state backends never leak to them.\n",
+	)
+	fl.Bool(
+		"webhook-always",
+		false,
+		"Post to webhook outputs even when the infrastructure is in sync\n",
+	)
+}
+
+// applyWebhookOptions sets the webhook flags on the webhook outputs
+func applyWebhookOptions(cmd *cobra.Command, outputs []output.OutputConfig) error {
+	var webhookOpts *output.WebhookOptions
+	for i := range outputs {
+		if outputs[i].Key != output.WebhookOutputType {
+			continue
+		}
+		if webhookOpts == nil {
+			templateFlag, _ := cmd.Flags().GetString("webhook-template")
+			tmpl, err := output.ParseWebhookTemplate(templateFlag)
+			if err != nil {
+				return err
+			}
+			webhookOpts = &output.WebhookOptions{Template: tmpl}
+			webhookOpts.Headers, err = parseHeadersFlag(cmd, "webhook-headers")
+			if err != nil {
+				return err
+			}
+			webhookOpts.Always, _ = cmd.Flags().GetBool("webhook-always")
+		}
+		outputs[i].Webhook = webhookOpts
+	}
+	return nil
+}
+
 func scanRun(opts *pkg.ScanOptions) error {
 	store := memstore.New()
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
-	alerter := alerter.NewAlerter()
-
 	// For now, we only use the global printer to print progress and information about the current scan, so unless one
 	// of the configured output should silence global output we simply use console by default.
 	if output.ShouldPrint(opts.Output, opts.Quiet) {
 		globaloutput.ChangePrinter(globaloutput.NewConsolePrinter())
 	}
 
+	providerLibrary := terraform.NewProviderLibrary()
+
+	// Teardown
+	defer func() {
+		logrus.Trace("Exiting scan cmd")
+		providerLibrary.Cleanup()
+		logrus.Trace("Exited")
+	}()
+
+	analysis, err := runScan(opts, providerLibrary, store, c)
+	if err != nil {
+		return err
+	}
+
+	validOutput := false
+	for _, o := range opts.Output {
+		if err = output.GetOutput(o).Write(analysis); err != nil {
+			logrus.Errorf("Error writing to output %s: %v", o.String(), err.Error())
+			continue
+		}
+		validOutput = true
+	}
+
+	// Fallback to console output if all output failed
+	if !validOutput {
+		logrus.Debug("All outputs failed, fallback to console output")
+		if err = output.NewConsole().Write(analysis); err != nil {
+			return err
+		}
+	}
+
+	globaloutput.Printf(color.WhiteString("Scan duration: %s\n", analysis.Duration.Round(time.Second)))
+	globaloutput.Printf(color.WhiteString("Provider version used to scan: %s. Use --tf-provider-version to use another version.\n"), opts.ProviderVersion)
+
+	if !opts.DisableTelemetry {
+		tl := telemetry.NewTelemetry(&build.Build{})
+		tl.SendTelemetry(store.Bucket(memstore.TelemetryBucket))
+	}
+
+	// A partial scan takes precedence as drifts of the resource types left out are unknown
+	if analysis.IsPartial() {
+		return cmderrors.PartialScan{}
+	}
+
+	if !analysis.IsSync() {
+		return cmderrors.InfrastructureNotInSync{}
+	}
+
+	return nil
+}
+
+// runScan scans the infrastructure described by the given options. Providers
+// already initialized in the given library are reused, others are added to it.
+func runScan(opts *pkg.ScanOptions, providerLibrary *terraform.ProviderLibrary, store memstore.Store, interrupt <-chan os.Signal) (*analyser.Analysis, error) {
+	alerter := alerter.NewAlerter()
+
 	if len(opts.From) == 0 {
 		supplierConfigs, err := retrieveBackendsFromHCL("")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		opts.From = append(opts.From, supplierConfigs...)
 	}
@@ -268,7 +569,6 @@ func scanRun(opts *pkg.ScanOptions) error {
 		})
 	}
 
-	providerLibrary := terraform.NewProviderLibrary()
 	remoteLibrary := common.NewRemoteLibrary()
 
 	iacProgress := globaloutput.NewProgress("Scanning states", "Scanned states", true)
@@ -278,39 +578,52 @@ func scanRun(opts *pkg.ScanOptions) error {
 
 	resFactory := dctlresource.NewDriftctlResourceFactory(resourceSchemaRepository)
 
-	err := remote.Activate(opts.To, opts.ProviderVersion, alerter, providerLibrary, remoteLibrary, scanProgress, resFactory, opts.ConfigDir)
+	remoteOpts := common.RemoteOptions{
+		AWSRegions:              opts.AWSRegions,
+		AWSAccounts:             opts.AWSAccounts,
+		AWSOrganizationalUnit:   opts.AWSOrganizationalUnit,
+		AWSAssumeRoleName:       opts.AWSAssumeRoleName,
+		AWSAssumeRoleExternalID: opts.AWSAssumeRoleExternalID,
+		Concurrency:             opts.Concurrency,
+		MaxRetries:              &opts.MaxRetries,
+		RateLimits:              opts.RateLimits,
+		BestEffort:              opts.BestEffort,
+		Timeout:                 opts.Timeout,
+		EnumeratorTimeouts:      opts.EnumeratorTimeouts,
+	}
+	if !opts.NoCache && opts.CacheTTL.IsEnabled() {
+		remoteOpts.Cache = cache.NewDiskCache(filepath.Join(opts.ConfigDir, ".driftctl", "cache"), opts.CacheTTL)
+	}
+
+	err := remote.Activate(opts.To, opts.ProviderVersion, alerter, providerLibrary, remoteLibrary, scanProgress, resFactory, opts.ConfigDir, remoteOpts)
 	if err != nil {
 		if err == aws.AWSCredentialsNotFoundError {
 			// special case command-line advice, because AWS is the default cloud
 			// provider, and users may be confused by a cloud-specific error out of
 			// the box
-			return fmt.Errorf("%s\n\n%s", err, "To use a different cloud provider, use --to=\"gcp+tf\" for GCP or --to=\"azure+tf\" for Azure.")
+			return nil, fmt.Errorf("%s\n\n%s", err, "To use a different cloud provider, use --to=\"gcp+tf\" for GCP or --to=\"azure+tf\" for Azure.")
 		}
-		return err
+		return nil, err
 	}
 
 	providerName := common.RemoteParameter(opts.To).GetProviderAddress().Type
 	err = resourceSchemaRepository.Init(providerName, opts.ProviderVersion, providerLibrary.Provider(providerName).Schema())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Teardown
-	defer func() {
-		logrus.Trace("Exiting scan cmd")
-		providerLibrary.Cleanup()
-		logrus.Trace("Exited")
-	}()
-
 	logrus.Debug("Checking for driftignore")
 	driftIgnore := filter.NewDriftIgnore(opts.DriftignorePath, opts.Driftignores...)
+	for _, rule := range driftIgnore.ExpiredRules() {
+		alerter.SendAlert("", filter.NewExpiredRuleAlert(rule))
+	}
 
 	// TODO use enum library interface here
-	scanner := remote.NewScanner(remoteLibrary, alerter, driftIgnore)
+	scanner := remote.NewScannerWithOptions(remoteLibrary, alerter, driftIgnore, remoteOpts)
 
 	iacSupplier, err := supplier.GetIACSupplier(opts.From, providerLibrary, opts.BackendOptions, iacProgress, alerter, resFactory, driftIgnore)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ctl := pkg.NewDriftCTL(
@@ -326,51 +639,28 @@ func scanRun(opts *pkg.ScanOptions) error {
 		store,
 	)
 
+	done := make(chan struct{})
+	defer close(done)
 	go func() {
-		<-c
-		logrus.Warn("Detected interrupt, cleanup ...")
-		ctl.Stop()
+		select {
+		case <-interrupt:
+			logrus.Warn("Detected interrupt, cleanup ...")
+			ctl.Stop()
+		case <-done:
+		}
 	}()
 
 	analysis, err := ctl.Run()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	analysis.ProviderVersion = opts.ProviderVersion
 	analysis.ProviderName = opts.To
+	analysis.Filtered = opts.Filter != nil || driftIgnore.AppliesRules()
 	store.Bucket(memstore.TelemetryBucket).Set("provider_name", analysis.ProviderName)
 
-	validOutput := false
-	for _, o := range opts.Output {
-		if err = output.GetOutput(o).Write(analysis); err != nil {
-			logrus.Errorf("Error writing to output %s: %v", o.String(), err.Error())
-			continue
-		}
-		validOutput = true
-	}
-
-	// Fallback to console output if all output failed
-	if !validOutput {
-		logrus.Debug("All outputs failed, fallback to console output")
-		if err = output.NewConsole().Write(analysis); err != nil {
-			return err
-		}
-	}
-
-	globaloutput.Printf(color.WhiteString("Scan duration: %s\n", analysis.Duration.Round(time.Second)))
-	globaloutput.Printf(color.WhiteString("Provider version used to scan: %s. Use --tf-provider-version to use another version.\n"), opts.ProviderVersion)
-
-	if !opts.DisableTelemetry {
-		tl := telemetry.NewTelemetry(&build.Build{})
-		tl.SendTelemetry(store.Bucket(memstore.TelemetryBucket))
-	}
-
-	if !analysis.IsSync() {
-		return cmderrors.InfrastructureNotInSync{}
-	}
-
-	return nil
+	return analysis, nil
 }
 
 func validateTfProviderVersionString(version string) error {
@@ -4,4 +4,6 @@ const (
 	EXIT_IN_SYNC     = 0
 	EXIT_NOT_IN_SYNC = 1
 	EXIT_ERROR       = 2
+	// EXIT_PARTIAL is returned by scans run with --best-effort when some resource types could not be listed
+	EXIT_PARTIAL = 3
 )
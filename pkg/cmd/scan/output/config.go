@@ -5,8 +5,13 @@ import "fmt"
 type OutputConfig struct {
 	Key  string
 	Path string
+	// Webhook holds the settings of webhook outputs, Path being the URL of the endpoint
+	Webhook *WebhookOptions
 }
 
 func (o *OutputConfig) String() string {
+	if o.Key == WebhookOutputType {
+		return webhookURL(o.Path)
+	}
 	return fmt.Sprintf("%s://%s", o.Key, o.Path)
 }
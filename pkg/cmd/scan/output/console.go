@@ -1,9 +1,15 @@
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
 
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
 
@@ -88,6 +94,42 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 		}
 	}
 
+	if analysis.Summary().TotalDrifted > 0 {
+		fmt.Println("Found changed resources:")
+		for _, difference := range analysis.Differences() {
+			humanStringSource := difference.Res.ResourceType()
+			if difference.Res.SourceString() != "" {
+				humanStringSource = difference.Res.SourceString()
+			}
+			humanString := fmt.Sprintf("  - %s (%s):", difference.Res.ResourceId(), humanStringSource)
+			whiteSpace := "    "
+			if humanAttrs := formatResourceAttributes(difference.Res); humanAttrs != "" {
+				humanString += fmt.Sprintf("\n        %s", humanAttrs)
+				whiteSpace = "        "
+			}
+			fmt.Println(humanString)
+			for _, change := range difference.Changelog {
+				path := strings.Join(change.Path, ".")
+				pref := fmt.Sprintf("%s %s:", color.YellowString("~"), path)
+				if change.Type == diff.CREATE {
+					pref = fmt.Sprintf("%s %s:", color.GreenString("+"), path)
+				} else if change.Type == diff.DELETE {
+					pref = fmt.Sprintf("%s %s:", color.RedString("-"), path)
+				}
+				if change.Type == diff.UPDATE && change.JsonString {
+					fmt.Printf("%s%s\n%s\n%s=>\n%s\n", whiteSpace, pref, prettifyJson(change.From, whiteSpace+"    "), whiteSpace+"    ", prettifyJson(change.To, whiteSpace+"    "))
+					continue
+				}
+				fmt.Printf("%s%s %s => %s", whiteSpace, pref, prettify(change.From), prettify(change.To))
+				if change.Computed {
+					fmt.Printf(" %s", color.YellowString("(computed)"))
+				}
+				fmt.Printf("\n")
+			}
+		}
+	}
+
+	c.writeResolved(analysis)
 	c.writeSummary(analysis)
 
 	enumerationErrorMessage := ""
@@ -107,6 +149,34 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 	return nil
 }
 
+// writeResolved lists the drift resolved since the previous analysis when comparing two of them
+func (c Console) writeResolved(analysis *analyser.Analysis) {
+	if analysis.Resolved == nil || len(analysis.Resolved.Unmanaged)+len(analysis.Resolved.Deleted)+len(analysis.Resolved.Differences) == 0 {
+		return
+	}
+	fmt.Println(color.GreenString("Resolved since the previous scan:"))
+	resolvedDifferences := make([]*resource.Resource, 0, len(analysis.Resolved.Differences))
+	for _, difference := range analysis.Resolved.Differences {
+		resolvedDifferences = append(resolvedDifferences, difference.Res)
+	}
+	for _, group := range []struct {
+		title     string
+		resources []*resource.Resource
+	}{
+		{"resource(s) not covered by IaC anymore", analysis.Resolved.Unmanaged},
+		{"missing resource(s) found again", analysis.Resolved.Deleted},
+		{"changed resource(s) back in sync", resolvedDifferences},
+	} {
+		if len(group.resources) == 0 {
+			continue
+		}
+		fmt.Printf("  %d %s:\n", len(group.resources), group.title)
+		for _, res := range group.resources {
+			fmt.Printf("    - %s (%s)\n", res.ResourceId(), res.ResourceType())
+		}
+	}
+}
+
 func (c Console) writeSummary(analysis *analyser.Analysis) {
 	boldWriter := color.New(color.Bold)
 	successWriter := color.New(color.Bold, color.FgGreen)
@@ -125,6 +195,14 @@ func (c Console) writeSummary(analysis *analyser.Analysis) {
 			analysis.Coverage(),
 		),
 	)
+	if analysis.Resolved != nil {
+		fmt.Printf(
+			" - coverage went from %d%% to %d%% (%+d%%)\n",
+			analysis.Resolved.PreviousCoverage,
+			analysis.Coverage(),
+			analysis.Coverage()-analysis.Resolved.PreviousCoverage,
+		)
+	}
 	if !analysis.IsSync() {
 		managed := successWriter.Sprintf("0")
 		if analysis.Summary().TotalManaged > 0 {
@@ -132,6 +210,12 @@ func (c Console) writeSummary(analysis *analyser.Analysis) {
 		}
 		fmt.Printf(" - %s resource(s) managed by Terraform\n", managed)
 
+		drifted := successWriter.Sprintf("0")
+		if analysis.Summary().TotalDrifted > 0 {
+			drifted = errorWriter.Sprintf("%d", analysis.Summary().TotalDrifted)
+		}
+		fmt.Printf("     - %s/%s resource(s) out of sync with Terraform state\n", drifted, managed)
+
 		unmanaged := successWriter.Sprintf("0")
 		if analysis.Summary().TotalUnmanaged > 0 {
 			unmanaged = warningWriter.Sprintf("%d", analysis.Summary().TotalUnmanaged)
@@ -148,6 +232,32 @@ func (c Console) writeSummary(analysis *analyser.Analysis) {
 	}
 }
 
+func prettify(value interface{}) string {
+	v := reflect.ValueOf(value)
+	if value == nil || v.Kind() == reflect.Ptr && v.IsNil() {
+		return "<nil>"
+	}
+	return awsutil.Prettify(value)
+}
+
+// prettifyJson indents a JSON string attribute so that changes in documents like policies
+// stay readable, it fallbacks on the raw value when the attribute is not a valid JSON document.
+func prettifyJson(value interface{}, indent string) string {
+	str, ok := value.(string)
+	if !ok {
+		return indent + prettify(value)
+	}
+	var doc interface{}
+	if err := json.Unmarshal([]byte(str), &doc); err != nil {
+		return indent + prettify(value)
+	}
+	out, err := json.MarshalIndent(doc, indent, "  ")
+	if err != nil {
+		return indent + prettify(value)
+	}
+	return indent + string(out)
+}
+
 func groupByType(resources []*resource.Resource) (map[string][]*resource.Resource, []string) {
 	result := map[string][]*resource.Resource{}
 	for _, res := range resources {
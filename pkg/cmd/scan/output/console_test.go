@@ -95,6 +95,18 @@ func TestConsole_Write(t *testing.T) {
 			args:       args{analysis: fakeAnalysisWithoutDeep()},
 			wantErr:    false,
 		},
+		{
+			name:       "test console output with drifted resources",
+			goldenfile: "output_drift.txt",
+			args:       args{analysis: fakeAnalysisWithDrift()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with resolved drift",
+			goldenfile: "output_resolved.txt",
+			args:       args{analysis: fakeAnalysisWithResolution()},
+			wantErr:    false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3,11 +3,15 @@ package output
 import (
 	"embed"
 	"encoding/base64"
+	"fmt"
 	"html/template"
 	"math"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/r3labs/diff/v2"
+
 	"github.com/snyk/driftctl/enumeration/alerter"
 
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -33,7 +37,9 @@ type HTMLTemplateParams struct {
 	Summary         analyser.Summary
 	Unmanaged       []*resource.Resource
 	Deleted         []*resource.Resource
+	Differences     []analyser.Difference
 	Alerts          alerter.Alerts
+	Resolved        *analyser.Resolution
 	Stylesheet      template.CSS
 	ScanDuration    string
 	ProviderName    string
@@ -82,6 +88,9 @@ func (c *HTML) Write(analysis *analyser.Analysis) error {
 			resources := make([]*resource.Resource, 0)
 			resources = append(resources, analysis.Unmanaged()...)
 			resources = append(resources, analysis.Deleted()...)
+			for _, d := range analysis.Differences() {
+				resources = append(resources, d.Res)
+			}
 
 			return distinctResourceTypes(resources)
 		},
@@ -92,6 +101,21 @@ func (c *HTML) Write(analysis *analyser.Analysis) error {
 
 			return distinctIaCSources(resources)
 		},
+		"formatChange": func(ch analyser.Change) string {
+			prefix := "~"
+			if ch.Type == diff.CREATE {
+				prefix = "+"
+			} else if ch.Type == diff.DELETE {
+				prefix = "-"
+			}
+			return fmt.Sprintf("%s %s: %s => %s", prefix, strings.Join(ch.Path, "."), prettify(ch.From), prettify(ch.To))
+		},
+		"resolvedCount": func() int {
+			if analysis.Resolved == nil {
+				return 0
+			}
+			return len(analysis.Resolved.Unmanaged) + len(analysis.Resolved.Deleted) + len(analysis.Resolved.Differences)
+		},
 		"rate": func(count int) float64 {
 			if analysis.Summary().TotalResources == 0 {
 				return 0
@@ -113,7 +137,9 @@ func (c *HTML) Write(analysis *analyser.Analysis) error {
 		Summary:         analysis.Summary(),
 		Unmanaged:       analysis.Unmanaged(),
 		Deleted:         analysis.Deleted(),
+		Differences:     analysis.Differences(),
 		Alerts:          analysis.Alerts(),
+		Resolved:        analysis.Resolved,
 		Stylesheet:      template.CSS(styleFile),
 		ScanDuration:    analysis.Duration.Round(time.Second).String(),
 		ProviderName:    analysis.ProviderName,
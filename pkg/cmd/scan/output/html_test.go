@@ -152,6 +152,16 @@ func TestHTML_Write(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name:       "test html output with resolved drift",
+			goldenfile: "output_resolved.html",
+			analysis: func() *analyser.Analysis {
+				a := fakeAnalysisWithResolution()
+				a.Date = time.Date(2021, 06, 10, 0, 0, 0, 0, &time.Location{})
+				return a
+			},
+			err: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
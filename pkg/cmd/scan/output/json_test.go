@@ -47,6 +47,14 @@ func TestJSON_Write(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:       "test json output with resolved drift",
+			goldenfile: "output_resolved.json",
+			args: args{
+				analysis: fakeAnalysisWithResolution(),
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,223 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+)
+
+const JUnitOutputType = "junit"
+const JUnitOutputExample = "junit://PATH/TO/FILE.xml"
+
+// junitAlertsSuite gathers alerts that are not related to a given resource type
+const junitAlertsSuite = "alerts"
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+type JUnit struct {
+	path string
+}
+
+func NewJUnit(path string) *JUnit {
+	return &JUnit{path}
+}
+
+func (c *JUnit) Write(analysis *analyser.Analysis) error {
+	file := os.Stdout
+	if !isStdOut(c.path) {
+		f, err := os.OpenFile(c.path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		file = f
+	}
+
+	junit, err := xml.MarshalIndent(newJUnitTestSuites(analysis), "", "\t")
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(xml.Header); err != nil {
+		return err
+	}
+	if _, err := file.Write(junit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func newJUnitTestSuites(analysis *analyser.Analysis) junitTestSuites {
+	suites := map[string]*junitTestSuite{}
+	addCase := func(suiteName string, testCase junitTestCase) {
+		suite, exist := suites[suiteName]
+		if !exist {
+			suite = &junitTestSuite{Name: suiteName}
+			suites[suiteName] = suite
+		}
+		suite.Tests++
+		switch {
+		case testCase.Failure != nil:
+			suite.Failures++
+		case testCase.Error != nil:
+			suite.Errors++
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	differences := make(map[string]analyser.Difference, len(analysis.Differences()))
+	for _, difference := range analysis.Differences() {
+		differences[junitResourceKey(difference.Res)] = difference
+	}
+
+	for _, res := range analysis.Managed() {
+		testCase := newJUnitResourceTestCase(res)
+		if difference, drifted := differences[junitResourceKey(res)]; drifted {
+			testCase.Failure = &junitFailure{
+				Message: "Resource has drifted from IaC",
+				Type:    "drifted",
+				Content: formatJUnitChangelog(difference.Changelog),
+			}
+		}
+		addCase(res.ResourceType(), testCase)
+	}
+	for _, res := range analysis.Unmanaged() {
+		testCase := newJUnitResourceTestCase(res)
+		testCase.Failure = &junitFailure{
+			Message: "Resource is not covered by IaC",
+			Type:    "unmanaged",
+		}
+		addCase(res.ResourceType(), testCase)
+	}
+	for _, res := range analysis.Deleted() {
+		testCase := newJUnitResourceTestCase(res)
+		testCase.Failure = &junitFailure{
+			Message: "Resource is missing from the cloud provider",
+			Type:    "missing",
+			Content: res.SourceString(),
+		}
+		addCase(res.ResourceType(), testCase)
+	}
+
+	alertKeys := make([]string, 0, len(analysis.Alerts()))
+	for key := range analysis.Alerts() {
+		alertKeys = append(alertKeys, key)
+	}
+	sort.Strings(alertKeys)
+	for _, key := range alertKeys {
+		suiteName := key
+		if suiteName == "" {
+			suiteName = junitAlertsSuite
+		}
+		for _, alert := range analysis.Alerts()[key] {
+			testCase := junitTestCase{
+				Name:      alert.Message(),
+				Classname: suiteName,
+			}
+			// Resources that could not be listed were not checked at all, other alerts only warn about the results
+			if _, ok := alert.(*alerts.RemoteAccessDeniedAlert); ok {
+				testCase.Error = &junitFailure{
+					Message: alert.Message(),
+					Type:    "access_denied",
+				}
+			} else {
+				testCase.Skipped = &junitSkipped{Message: alert.Message()}
+			}
+			addCase(suiteName, testCase)
+		}
+	}
+
+	result := junitTestSuites{
+		Name:   "driftctl",
+		Time:   fmt.Sprintf("%.3f", analysis.Duration.Seconds()),
+		Suites: make([]junitTestSuite, 0, len(suites)),
+	}
+	for _, suite := range suites {
+		sort.SliceStable(suite.Cases, func(i, j int) bool {
+			return suite.Cases[i].Name < suite.Cases[j].Name
+		})
+		result.Tests += suite.Tests
+		result.Failures += suite.Failures
+		result.Errors += suite.Errors
+		result.Skipped += suite.Skipped
+		result.Suites = append(result.Suites, *suite)
+	}
+	sort.Slice(result.Suites, func(i, j int) bool {
+		return result.Suites[i].Name < result.Suites[j].Name
+	})
+
+	return result
+}
+
+func newJUnitResourceTestCase(res *resource.Resource) junitTestCase {
+	return junitTestCase{
+		Name:      res.ResourceId(),
+		Classname: res.ResourceType(),
+	}
+}
+
+func junitResourceKey(res *resource.Resource) string {
+	return fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId())
+}
+
+func formatJUnitChangelog(changelog analyser.Changelog) string {
+	lines := make([]string, 0, len(changelog))
+	for _, change := range changelog {
+		pref := "~"
+		if change.Type == diff.CREATE {
+			pref = "+"
+		} else if change.Type == diff.DELETE {
+			pref = "-"
+		}
+		line := fmt.Sprintf("%s %s: %s => %s", pref, strings.Join(change.Path, "."), prettify(change.From), prettify(change.To))
+		if change.Computed {
+			line += " (computed)"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,73 @@
+package output
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/test/goldenfile"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJUnit_Write(t *testing.T) {
+	tests := []struct {
+		name       string
+		goldenfile string
+		analysis   *analyser.Analysis
+		wantErr    bool
+	}{
+		{
+			name:       "test junit output",
+			goldenfile: "output_junit.xml",
+			analysis:   fakeAnalysis(),
+			wantErr:    false,
+		},
+		{
+			name:       "test junit output with drifted resources",
+			goldenfile: "output_junit_drift.xml",
+			analysis:   fakeAnalysisWithDrift(),
+			wantErr:    false,
+		},
+		{
+			name:       "test junit output with alerts",
+			goldenfile: "output_junit_alerts.xml",
+			analysis:   fakeAnalysisWithAlerts(),
+			wantErr:    false,
+		},
+		{
+			name:       "test junit output when no infra",
+			goldenfile: "output_junit_empty.xml",
+			analysis:   &analyser.Analysis{},
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			tempFile, err := os.CreateTemp(tempDir, "result")
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := NewJUnit(tempFile.Name())
+			if err := c.Write(tt.analysis); (err != nil) != tt.wantErr {
+				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			result, err := os.ReadFile(tempFile.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectedFilePath := path.Join("./testdata/", tt.goldenfile)
+			if *goldenfile.Update == tt.goldenfile {
+				if err := os.WriteFile(expectedFilePath, result, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(expectedFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, string(expected), string(result))
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+)
+
+const MarkdownOutputType = "markdown"
+const MarkdownOutputExample = "markdown://PATH/TO/FILE.md"
+
+// Pull request comments are limited to 65536 characters on GitHub, keep some room for surrounding text
+const markdownMaxSize = 60000
+
+// Groups with more resources than this are folded in a collapsible section
+const markdownFoldThreshold = 10
+
+// Room kept to close a collapsible section and write the truncation notice once the size cap is reached
+const markdownFooterSize = 200
+
+type Markdown struct {
+	path    string
+	maxSize int
+}
+
+func NewMarkdown(path string) *Markdown {
+	return &Markdown{path, markdownMaxSize}
+}
+
+func (c *Markdown) Write(analysis *analyser.Analysis) error {
+	file := os.Stdout
+	if !isStdOut(c.path) {
+		f, err := os.OpenFile(c.path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		file = f
+	}
+
+	w := &markdownWriter{maxSize: c.maxSize}
+	c.writeSummary(w, analysis)
+	c.writeDeleted(w, analysis)
+	c.writeUnmanaged(w, analysis)
+	c.writeDifferences(w, analysis)
+	c.writeResolved(w, analysis)
+	c.writeAlerts(w, analysis)
+
+	if _, err := file.WriteString(w.String()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Markdown) writeSummary(w *markdownWriter, analysis *analyser.Analysis) {
+	summary := analysis.Summary()
+
+	w.line("## Drift summary")
+	w.line("")
+	w.line("| | Resources |")
+	w.line("|---|---|")
+	w.line("| Total | %d |", summary.TotalResources)
+	w.line("| Coverage | %d%% |", analysis.Coverage())
+	if analysis.Resolved != nil {
+		w.line("| Previous coverage | %d%% (%+d%%) |", analysis.Resolved.PreviousCoverage, analysis.Coverage()-analysis.Resolved.PreviousCoverage)
+	}
+	w.line("| Managed by Terraform | %d |", summary.TotalManaged)
+	w.line("| Out of sync with Terraform state | %d |", summary.TotalDrifted)
+	w.line("| Not managed by Terraform | %d |", summary.TotalUnmanaged)
+	w.line("| Missing on the cloud provider | %d |", summary.TotalDeleted)
+	w.line("")
+
+	if analysis.IsSync() {
+		w.line("Congrats! Your infrastructure is fully in sync.")
+		w.line("")
+	}
+}
+
+func (c *Markdown) writeDeleted(w *markdownWriter, analysis *analyser.Analysis) {
+	if analysis.Summary().TotalDeleted == 0 {
+		return
+	}
+
+	groupedBySource := make(map[string][]*resource.Resource)
+	for _, deletedResource := range analysis.Deleted() {
+		key := ""
+		if deletedResource.Source != nil {
+			key = deletedResource.Source.Source()
+		}
+		groupedBySource[key] = append(groupedBySource[key], deletedResource)
+	}
+	sources := make([]string, 0, len(groupedBySource))
+	for s := range groupedBySource {
+		sources = append(sources, s)
+	}
+	sort.Strings(sources)
+
+	w.line("### Missing resources")
+	w.line("")
+	for _, source := range sources {
+		title := "Unknown source"
+		if source != "" {
+			title = fmt.Sprintf("From %s", source)
+		}
+		resources := groupedBySource[source]
+		w.group(title, len(resources), func() {
+			for _, deletedResource := range resources {
+				humanStringSource := deletedResource.ResourceType()
+				if deletedResource.SourceString() != "" {
+					humanStringSource = deletedResource.SourceString()
+				}
+				w.line("- `%s` (%s)%s", deletedResource.ResourceId(), humanStringSource, markdownAttributes(deletedResource))
+			}
+		})
+	}
+}
+
+func (c *Markdown) writeUnmanaged(w *markdownWriter, analysis *analyser.Analysis) {
+	if analysis.Summary().TotalUnmanaged == 0 {
+		return
+	}
+
+	w.line("### Resources not covered by IaC")
+	w.line("")
+	unmanagedByType, keys := groupByType(analysis.Unmanaged())
+	for _, ty := range keys {
+		resources := unmanagedByType[ty]
+		w.group(ty, len(resources), func() {
+			for _, res := range resources {
+				w.line("- `%s`%s", res.ResourceId(), markdownAttributes(res))
+			}
+		})
+	}
+}
+
+func (c *Markdown) writeDifferences(w *markdownWriter, analysis *analyser.Analysis) {
+	if analysis.Summary().TotalDrifted == 0 {
+		return
+	}
+
+	w.line("### Changed resources")
+	w.line("")
+	w.group("Resources out of sync with Terraform state", len(analysis.Differences()), func() {
+		for _, difference := range analysis.Differences() {
+			humanStringSource := difference.Res.ResourceType()
+			if difference.Res.SourceString() != "" {
+				humanStringSource = difference.Res.SourceString()
+			}
+			paths := make([]string, 0, len(difference.Changelog))
+			for _, change := range difference.Changelog {
+				paths = append(paths, fmt.Sprintf("`%s`", strings.Join(change.Path, ".")))
+			}
+			w.line("- `%s` (%s): %s", difference.Res.ResourceId(), humanStringSource, strings.Join(paths, ", "))
+		}
+	})
+}
+
+// writeResolved lists the drift resolved since the previous analysis when comparing two of them
+func (c *Markdown) writeResolved(w *markdownWriter, analysis *analyser.Analysis) {
+	if analysis.Resolved == nil || len(analysis.Resolved.Unmanaged)+len(analysis.Resolved.Deleted)+len(analysis.Resolved.Differences) == 0 {
+		return
+	}
+	resolvedDifferences := make([]*resource.Resource, 0, len(analysis.Resolved.Differences))
+	for _, difference := range analysis.Resolved.Differences {
+		resolvedDifferences = append(resolvedDifferences, difference.Res)
+	}
+
+	w.line("### Resolved since the previous scan")
+	w.line("")
+	for _, group := range []struct {
+		title     string
+		resources []*resource.Resource
+	}{
+		{"Resources not covered by IaC anymore", analysis.Resolved.Unmanaged},
+		{"Missing resources found again", analysis.Resolved.Deleted},
+		{"Changed resources back in sync", resolvedDifferences},
+	} {
+		if len(group.resources) == 0 {
+			continue
+		}
+		resources := group.resources
+		w.group(group.title, len(resources), func() {
+			for _, res := range resources {
+				w.line("- `%s` (%s)", res.ResourceId(), res.ResourceType())
+			}
+		})
+	}
+}
+
+func (c *Markdown) writeAlerts(w *markdownWriter, analysis *analyser.Analysis) {
+	if len(analysis.Alerts()) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(analysis.Alerts()))
+	for key := range analysis.Alerts() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	w.line("### Alerts")
+	w.line("")
+	for _, key := range keys {
+		for _, alert := range analysis.Alerts()[key] {
+			w.line("- %s", alert.Message())
+		}
+	}
+	w.line("")
+}
+
+func markdownAttributes(res *resource.Resource) string {
+	if humanAttrs := formatResourceAttributes(res); humanAttrs != "" {
+		return fmt.Sprintf(" %s", humanAttrs)
+	}
+	return ""
+}
+
+// markdownWriter stops writing once the size cap is reached and ends the document with a truncation notice
+type markdownWriter struct {
+	builder     strings.Builder
+	maxSize     int
+	truncated   bool
+	openDetails bool
+}
+
+func (w *markdownWriter) line(format string, args ...interface{}) {
+	if w.truncated {
+		return
+	}
+	l := fmt.Sprintf(format, args...) + "\n"
+	if w.builder.Len()+len(l) > w.maxSize-markdownFooterSize {
+		w.truncated = true
+		return
+	}
+	w.builder.WriteString(l)
+}
+
+// group writes a list of resources under a title, large lists are folded in a collapsible section
+func (w *markdownWriter) group(title string, count int, writeItems func()) {
+	if count <= markdownFoldThreshold {
+		w.line("#### %s (%d)", title, count)
+		w.line("")
+		writeItems()
+		w.line("")
+		return
+	}
+
+	w.line("<details><summary>%s (%d)</summary>", title, count)
+	w.line("")
+	if w.truncated {
+		return
+	}
+	w.openDetails = true
+	writeItems()
+	w.closeDetails()
+}
+
+func (w *markdownWriter) closeDetails() {
+	if !w.openDetails {
+		return
+	}
+	// Written even when truncated, room has been kept for it
+	w.builder.WriteString("\n</details>\n\n")
+	w.openDetails = false
+}
+
+func (w *markdownWriter) String() string {
+	if w.truncated {
+		w.closeDetails()
+		w.builder.WriteString("_Output truncated as it exceeds the maximum size, use the JSON output to get the full result._\n")
+	}
+	return w.builder.String()
+}
@@ -0,0 +1,116 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/test/goldenfile"
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeAnalysisWithManyUnmanaged() *analyser.Analysis {
+	a := analyser.NewAnalysis()
+	for i := 0; i < 15; i++ {
+		a.AddUnmanaged(&resource.Resource{
+			Id:   fmt.Sprintf("unmanaged-id-%02d", i),
+			Type: "aws_unmanaged_resource",
+		})
+	}
+	a.AddUnmanaged(&resource.Resource{
+		Id:   "other-unmanaged-id",
+		Type: "aws_other_unmanaged_resource",
+	})
+	return a
+}
+
+func TestMarkdown_Write(t *testing.T) {
+	tests := []struct {
+		name       string
+		goldenfile string
+		analysis   *analyser.Analysis
+		maxSize    int
+		wantErr    bool
+	}{
+		{
+			name:       "test markdown output",
+			goldenfile: "output.md",
+			analysis:   fakeAnalysis(),
+			wantErr:    false,
+		},
+		{
+			name:       "test markdown output when infrastructure is in sync",
+			goldenfile: "output_sync.md",
+			analysis:   fakeAnalysisNoDrift(),
+			wantErr:    false,
+		},
+		{
+			name:       "test markdown output with drifted resources",
+			goldenfile: "output_drift.md",
+			analysis:   fakeAnalysisWithDrift(),
+			wantErr:    false,
+		},
+		{
+			name:       "test markdown output with resolved drift",
+			goldenfile: "output_resolved.md",
+			analysis:   fakeAnalysisWithResolution(),
+			wantErr:    false,
+		},
+		{
+			name:       "test markdown output with alerts",
+			goldenfile: "output_alerts.md",
+			analysis:   fakeAnalysisWithAlerts(),
+			wantErr:    false,
+		},
+		{
+			name:       "test markdown output folds large lists",
+			goldenfile: "output_folded.md",
+			analysis:   fakeAnalysisWithManyUnmanaged(),
+			wantErr:    false,
+		},
+		{
+			name:       "test markdown output is truncated when too large",
+			goldenfile: "output_truncated.md",
+			analysis:   fakeAnalysisWithManyUnmanaged(),
+			maxSize:    800,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			tempFile, err := os.CreateTemp(tempDir, "result")
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := NewMarkdown(tempFile.Name())
+			if tt.maxSize > 0 {
+				c.maxSize = tt.maxSize
+			}
+			if err := c.Write(tt.analysis); (err != nil) != tt.wantErr {
+				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			result, err := os.ReadFile(tempFile.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.maxSize > 0 {
+				assert.LessOrEqual(t, len(result), tt.maxSize)
+			}
+			expectedFilePath := path.Join("./testdata/", tt.goldenfile)
+			if *goldenfile.Update == tt.goldenfile {
+				if err := os.WriteFile(expectedFilePath, result, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(expectedFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, string(expected), string(result))
+		})
+	}
+}
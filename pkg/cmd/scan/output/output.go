@@ -16,13 +16,25 @@ var supportedOutputTypes = []string{
 	JSONOutputType,
 	HTMLOutputType,
 	PlanOutputType,
+	SARIFOutputType,
+	JUnitOutputType,
+	MarkdownOutputType,
+	TFImportOutputType,
+	PrometheusOutputType,
+	WebhookOutputType,
 }
 
 var supportedOutputExample = map[string]string{
-	ConsoleOutputType: ConsoleOutputExample,
-	JSONOutputType:    JSONOutputExample,
-	HTMLOutputType:    HTMLOutputExample,
-	PlanOutputType:    PlanOutputExample,
+	ConsoleOutputType:    ConsoleOutputExample,
+	JSONOutputType:       JSONOutputExample,
+	HTMLOutputType:       HTMLOutputExample,
+	PlanOutputType:       PlanOutputExample,
+	SARIFOutputType:      SARIFOutputExample,
+	JUnitOutputType:      JUnitOutputExample,
+	MarkdownOutputType:   MarkdownOutputExample,
+	TFImportOutputType:   TFImportOutputExample,
+	PrometheusOutputType: PrometheusOutputExample,
+	WebhookOutputType:    WebhookOutputExample,
 }
 
 func SupportedOutputsExample() []string {
@@ -55,6 +67,18 @@ func GetOutput(config OutputConfig) Output {
 		return NewHTML(config.Path)
 	case PlanOutputType:
 		return NewPlan(config.Path)
+	case SARIFOutputType:
+		return NewSARIF(config.Path)
+	case JUnitOutputType:
+		return NewJUnit(config.Path)
+	case MarkdownOutputType:
+		return NewMarkdown(config.Path)
+	case TFImportOutputType:
+		return NewTFImport(config.Path)
+	case PrometheusOutputType:
+		return NewPrometheus(config.Path)
+	case WebhookOutputType:
+		return NewWebhook(config.Path, config.Webhook)
 	case ConsoleOutputType:
 		fallthrough
 	default:
@@ -83,6 +107,18 @@ func GetPrinter(config OutputConfig, quiet bool) output.Printer {
 		fallthrough
 	case PlanOutputType:
 		fallthrough
+	case SARIFOutputType:
+		fallthrough
+	case JUnitOutputType:
+		fallthrough
+	case MarkdownOutputType:
+		fallthrough
+	case TFImportOutputType:
+		fallthrough
+	case PrometheusOutputType:
+		fallthrough
+	case WebhookOutputType:
+		fallthrough
 	case HTMLOutputType:
 		fallthrough
 	case ConsoleOutputType:
@@ -12,6 +12,7 @@ import (
 	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
 
 	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/output"
@@ -209,6 +210,106 @@ func fakeAnalysisWithoutDeep() *analyser.Analysis {
 	return &a
 }
 
+func fakeAnalysisWithDrift() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.Date = time.Date(2022, 4, 8, 10, 35, 0, 0, time.UTC)
+	managed := &resource.Resource{
+		Id:   "diff-id-1",
+		Type: "aws_diff_resource",
+		Source: &resource.TerraformStateSource{
+			State:  "tfstate://state.tfstate",
+			Module: "",
+			Name:   "name",
+		},
+	}
+	a.AddManaged(managed)
+	a.AddDifference(analyser.Difference{
+		Res: managed,
+		Changelog: analyser.Changelog{
+			{
+				Change: diff.Change{
+					Type: diff.UPDATE,
+					Path: []string{"updated", "field"},
+					From: "foobar",
+					To:   "barfoo",
+				},
+			},
+			{
+				Change: diff.Change{
+					Type: diff.CREATE,
+					Path: []string{"new", "field"},
+					From: nil,
+					To:   "newValue",
+				},
+			},
+			{
+				Change: diff.Change{
+					Type: diff.DELETE,
+					Path: []string{"a"},
+					From: "oldValue",
+					To:   nil,
+				},
+				Computed: true,
+			},
+			{
+				Change: diff.Change{
+					Type: diff.UPDATE,
+					Path: []string{"policy"},
+					From: `{"Version":"2012-10-17"}`,
+					To:   `{"Version":"2012-10-18"}`,
+				},
+				JsonString: true,
+			},
+		},
+	})
+	a.ProviderName = "AWS"
+	a.ProviderVersion = "3.19.0"
+	return &a
+}
+
+func fakeAnalysisWithResolution() *analyser.Analysis {
+	a := fakeAnalysis()
+	a.Resolved = &analyser.Resolution{
+		Unmanaged: []*resource.Resource{
+			{
+				Id:   "resolved-unmanaged-id-1",
+				Type: "aws_unmanaged_resource",
+			},
+		},
+		Deleted: []*resource.Resource{
+			{
+				Id:   "resolved-deleted-id-1",
+				Type: "aws_deleted_resource",
+				Source: &resource.TerraformStateSource{
+					State:  "tfstate://delete_state.tfstate",
+					Module: "module",
+					Name:   "name",
+				},
+			},
+		},
+		Differences: []analyser.Difference{
+			{
+				Res: &resource.Resource{
+					Id:   "resolved-diff-id-1",
+					Type: "aws_diff_resource",
+				},
+				Changelog: analyser.Changelog{
+					{
+						Change: diff.Change{
+							Type: diff.UPDATE,
+							Path: []string{"updated", "field"},
+							From: "foobar",
+							To:   "barfoo",
+						},
+					},
+				},
+			},
+		},
+		PreviousCoverage: 20,
+	}
+	return a
+}
+
 func TestGetPrinter(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,47 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/pkg/metrics"
+)
+
+const PrometheusOutputType = "prometheus"
+const PrometheusOutputExample = "prometheus://PATH/TO/FILE.prom"
+
+// Prometheus writes metrics in the Prometheus text format, to be collected by
+// the textfile collector of node_exporter
+type Prometheus struct {
+	path string
+}
+
+func NewPrometheus(path string) *Prometheus {
+	return &Prometheus{path}
+}
+
+func (c *Prometheus) Write(analysis *analyser.Analysis) error {
+	if isStdOut(c.path) {
+		return metrics.Write(os.Stdout, metrics.LabeledAnalysis{Analysis: analysis})
+	}
+
+	// The file is written aside and renamed so that the collector never reads a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := metrics.Write(tmp, metrics.LabeledAnalysis{Analysis: analysis}); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path)
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/test/goldenfile"
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeAnalysisWithTimings() *analyser.Analysis {
+	a := fakeAnalysisWithDrift()
+	a.Duration = 12 * time.Second
+	a.EnumerationTimings = map[string]time.Duration{
+		"aws_s3_bucket": 1500 * time.Millisecond,
+		"aws_iam_user":  250 * time.Millisecond,
+	}
+	return a
+}
+
+func TestPrometheus_Write(t *testing.T) {
+	tests := []struct {
+		name       string
+		goldenfile string
+		analysis   *analyser.Analysis
+		wantErr    bool
+	}{
+		{
+			name:       "test prometheus output",
+			goldenfile: "output.prom",
+			analysis:   fakeAnalysis(),
+			wantErr:    false,
+		},
+		{
+			name:       "test prometheus output with drifted resources and timings",
+			goldenfile: "output_drift.prom",
+			analysis:   fakeAnalysisWithTimings(),
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempFile := filepath.Join(t.TempDir(), "driftctl.prom")
+			c := NewPrometheus(tempFile)
+			if err := c.Write(tt.analysis); (err != nil) != tt.wantErr {
+				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			result, err := os.ReadFile(tempFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Only the final file is left in the directory
+			entries, err := os.ReadDir(filepath.Dir(tempFile))
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+
+			expectedFilePath := path.Join("./testdata/", tt.goldenfile)
+			if *goldenfile.Update == tt.goldenfile {
+				if err := os.WriteFile(expectedFilePath, result, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(expectedFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, string(expected), string(result))
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/pkg/version"
+)
+
+const SARIFOutputType = "sarif"
+const SARIFOutputExample = "sarif://PATH/TO/FILE.sarif"
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// Kinds of results, they prefix rule ids so that each resource type gets a stable rule per kind
+const (
+	sarifUnmanagedKind = "unmanaged"
+	sarifMissingKind   = "missing"
+	sarifDriftedKind   = "drifted"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	Id               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleId              string            `json:"ruleId"`
+	RuleIndex           int               `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+type SARIF struct {
+	path string
+}
+
+func NewSARIF(path string) *SARIF {
+	return &SARIF{path}
+}
+
+func (c *SARIF) Write(analysis *analyser.Analysis) error {
+	file := os.Stdout
+	if !isStdOut(c.path) {
+		f, err := os.OpenFile(c.path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		file = f
+	}
+
+	sarif, err := json.MarshalIndent(newSarifLog(analysis), "", "\t")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(sarif); err != nil {
+		return err
+	}
+	return nil
+}
+
+func newSarifLog(analysis *analyser.Analysis) sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "driftctl",
+				InformationURI: "https://driftctl.com",
+				Version:        version.Current(),
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	rulesIndex := map[string]int{}
+	addResult := func(kind, level string, res *resource.Resource, message string) {
+		ruleId := fmt.Sprintf("%s/%s", kind, res.ResourceType())
+		index, exist := rulesIndex[ruleId]
+		if !exist {
+			index = len(run.Tool.Driver.Rules)
+			rulesIndex[ruleId] = index
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSarifRule(ruleId, kind, res.ResourceType()))
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleId:    ruleId,
+			RuleIndex: index,
+			Level:     level,
+			Message:   sarifMessage{Text: message},
+			Locations: sarifLocations(res),
+			PartialFingerprints: map[string]string{
+				"driftctlResource/v1": sarifFingerprint(kind, res),
+			},
+		})
+	}
+
+	for _, res := range analysis.Unmanaged() {
+		addResult(sarifUnmanagedKind, "warning", res,
+			fmt.Sprintf("Resource %s of type %s is not covered by IaC", res.ResourceId(), res.ResourceType()))
+	}
+	for _, res := range analysis.Deleted() {
+		addResult(sarifMissingKind, "error", res,
+			fmt.Sprintf("Resource %s of type %s is missing from the cloud provider", res.ResourceId(), res.ResourceType()))
+	}
+	for _, difference := range analysis.Differences() {
+		paths := make([]string, 0, len(difference.Changelog))
+		for _, change := range difference.Changelog {
+			paths = append(paths, strings.Join(change.Path, "."))
+		}
+		addResult(sarifDriftedKind, "warning", difference.Res,
+			fmt.Sprintf("Resource %s of type %s has drifted from IaC on: %s", difference.Res.ResourceId(), difference.Res.ResourceType(), strings.Join(paths, ", ")))
+	}
+
+	return sarifLog{
+		Schema:  sarifSchemaURI,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+}
+
+func newSarifRule(id, kind, ty string) sarifRule {
+	var description string
+	switch kind {
+	case sarifUnmanagedKind:
+		description = fmt.Sprintf("Resource of type %s not covered by IaC", ty)
+	case sarifMissingKind:
+		description = fmt.Sprintf("Resource of type %s missing from the cloud provider", ty)
+	case sarifDriftedKind:
+		description = fmt.Sprintf("Resource of type %s drifted from IaC", ty)
+	}
+	return sarifRule{
+		Id:               id,
+		ShortDescription: sarifMessage{Text: description},
+		HelpURI:          "https://docs.driftctl.com",
+	}
+}
+
+// sarifFingerprint identifies a result across scans, the account and region of resources are part of it
+// on multi-account and multi-region scans as the same resource ID may then be found in several locations
+func sarifFingerprint(kind string, res *resource.Resource) string {
+	fingerprint := fmt.Sprintf("%s/%s.%s", kind, res.ResourceType(), res.ResourceId())
+	if res.Account != "" {
+		fingerprint += fmt.Sprintf("@%s/%s", res.Account, res.Region)
+	} else if res.Region != "" {
+		fingerprint += fmt.Sprintf("@%s", res.Region)
+	}
+	return fingerprint
+}
+
+// sarifLocations points to the IaC source of a resource, resources that are not covered by IaC have no location.
+// Only sources read from a local file inside the working directory have a physical location, other sources,
+// e.g. remote states, are only given as logical locations
+func sarifLocations(res *resource.Resource) []sarifLocation {
+	if res.Src() == nil {
+		return nil
+	}
+	location := sarifLocation{
+		LogicalLocations: []sarifLogicalLocation{
+			{
+				FullyQualifiedName: res.SourceString(),
+				Kind:               "resource",
+			},
+		},
+	}
+	if uri, ok := sarifArtifactURI(res.Src().Source()); ok {
+		location.PhysicalLocation = &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: uri},
+		}
+	}
+	return []sarifLocation{location}
+}
+
+// sarifArtifactURI returns the path of a local IaC source relative to the working directory
+func sarifArtifactURI(source string) (string, bool) {
+	scheme, path, found := strings.Cut(source, "://")
+	// Sources read through a backend, e.g. tfstate+s3://, are not files of the repository
+	if !found || strings.Contains(scheme, "+") || path == "" {
+		return "", false
+	}
+	if filepath.IsAbs(path) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", false
+		}
+		if path, err = filepath.Rel(wd, path); err != nil {
+			return "", false
+		}
+	}
+	path = filepath.Clean(path)
+	if path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(path), true
+}
@@ -0,0 +1,119 @@
+package output
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/test/goldenfile"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSARIF_Write(t *testing.T) {
+	tests := []struct {
+		name       string
+		goldenfile string
+		analysis   *analyser.Analysis
+		wantErr    bool
+	}{
+		{
+			name:       "test sarif output",
+			goldenfile: "output.sarif",
+			analysis:   fakeAnalysis(),
+			wantErr:    false,
+		},
+		{
+			name:       "test sarif output with drifted resources",
+			goldenfile: "output_drift.sarif",
+			analysis:   fakeAnalysisWithDrift(),
+			wantErr:    false,
+		},
+		{
+			name:       "test sarif output with remote sources and locations",
+			goldenfile: "output_locations.sarif",
+			analysis:   fakeAnalysisWithLocations(),
+			wantErr:    false,
+		},
+		{
+			name:       "test sarif output when no infra",
+			goldenfile: "output_empty.sarif",
+			analysis:   &analyser.Analysis{},
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			tempFile, err := os.CreateTemp(tempDir, "result")
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := NewSARIF(tempFile.Name())
+			if err := c.Write(tt.analysis); (err != nil) != tt.wantErr {
+				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			result, err := os.ReadFile(tempFile.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectedFilePath := path.Join("./testdata/", tt.goldenfile)
+			if *goldenfile.Update == tt.goldenfile {
+				if err := os.WriteFile(expectedFilePath, result, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(expectedFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, string(expected), string(result))
+		})
+	}
+}
+
+func fakeAnalysisWithLocations() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddUnmanaged(
+		&resource.Resource{
+			Id:      "bucket",
+			Type:    "aws_s3_bucket",
+			Account: "123456789012",
+			Region:  "eu-west-3",
+		},
+		&resource.Resource{
+			Id:     "bucket",
+			Type:   "aws_s3_bucket",
+			Region: "us-east-1",
+		},
+	)
+	a.AddDeleted(
+		&resource.Resource{
+			Id:   "remote-id",
+			Type: "aws_deleted_resource",
+			Source: &resource.TerraformStateSource{
+				State: "tfstate+s3://bucket/prod.tfstate",
+				Name:  "remote",
+			},
+		},
+		&resource.Resource{
+			Id:   "local-id",
+			Type: "aws_deleted_resource",
+			Source: &resource.TerraformStateSource{
+				State:  "tfstate://states/../prod.tfstate",
+				Module: "module.app",
+				Name:   "local",
+			},
+		},
+		&resource.Resource{
+			Id:   "outside-id",
+			Type: "aws_deleted_resource",
+			Source: &resource.TerraformStateSource{
+				State: "tfstate://../prod.tfstate",
+				Name:  "outside",
+			},
+		},
+	)
+	return &a
+}
@@ -0,0 +1,9 @@
+import {
+  to = aws_iam_access_key.AKIA1234
+  id = "AKIA1234"
+}
+
+resource "aws_iam_access_key" "AKIA1234" {
+  user = "driftctl"
+}
+
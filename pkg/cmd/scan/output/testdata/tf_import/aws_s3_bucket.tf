@@ -0,0 +1,38 @@
+import {
+  to = aws_s3_bucket.my-bucket
+  id = "my-bucket"
+}
+
+resource "aws_s3_bucket" "my-bucket" {
+  bucket        = "my-bucket"
+  force_destroy = false
+  tags = {
+    Name               = "My bucket"
+    "kubernetes.io/ns" = "$${default}"
+  }
+  logging {
+    target_bucket = "logs"
+    target_prefix = "my-bucket/"
+  }
+  versioning {
+    enabled    = true
+    mfa_delete = false
+  }
+}
+
+import {
+  to = aws_s3_bucket.r_1-bucket_with_dots
+  id = "1-bucket.with.dots"
+}
+
+resource "aws_s3_bucket" "r_1-bucket_with_dots" {
+}
+
+import {
+  to = aws_s3_bucket.r_1-bucket_with_dots_2
+  id = "1-bucket/with/dots"
+}
+
+resource "aws_s3_bucket" "r_1-bucket_with_dots_2" {
+}
+
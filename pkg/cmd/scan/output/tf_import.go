@@ -0,0 +1,204 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const TFImportOutputType = "tf-import"
+const TFImportOutputExample = "tf-import://PATH/TO/DIR"
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// TFImport writes Terraform import blocks along with resource skeletons for unmanaged resources,
+// one file per resource type. Import blocks require Terraform 1.5 or later.
+type TFImport struct {
+	path string
+}
+
+func NewTFImport(path string) *TFImport {
+	return &TFImport{path}
+}
+
+func (c *TFImport) Write(analysis *analyser.Analysis) error {
+	if err := os.MkdirAll(c.path, 0700); err != nil {
+		return err
+	}
+
+	unmanagedByType, keys := groupByType(analysis.Unmanaged())
+	for _, ty := range keys {
+		f := hclwrite.NewEmptyFile()
+		names := map[string]struct{}{}
+		for _, res := range unmanagedByType[ty] {
+			name := uniqueResourceName(res.ResourceId(), names)
+			writeImport(f.Body(), res, name)
+		}
+
+		file, err := os.OpenFile(path.Join(c.path, fmt.Sprintf("%s.tf", ty)), os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		_, err = f.WriteTo(file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeImport(body *hclwrite.Body, res *resource.Resource, name string) {
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: res.ResourceType()},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(res.ResourceId()))
+	body.AppendNewline()
+
+	resourceBlock := body.AppendNewBlock("resource", []string{res.ResourceType(), name})
+	if res.Attributes() != nil {
+		attrs := make(map[string]interface{}, len(*res.Attributes()))
+		for k, v := range *res.Attributes() {
+			// The id is given by the import block
+			if k != "id" {
+				attrs[k] = v
+			}
+		}
+		writeResourceAttributes(resourceBlock.Body(), attrs, res.Schema(), "")
+	}
+	body.AppendNewline()
+}
+
+// writeResourceAttributes writes attributes that can be set in a configuration, attributes that are computed only or
+// unknown to the schema are dropped. Nested blocks are found in the schema as attributes prefixed by the block path.
+func writeResourceAttributes(body *hclwrite.Body, attrs map[string]interface{}, schema *resource.Schema, prefix string) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var blocks []string
+	for _, k := range keys {
+		value := attrs[k]
+		if value == nil {
+			continue
+		}
+		if schema == nil {
+			body.SetAttributeValue(k, toCtyValue(value))
+			continue
+		}
+		attributeSchema, isAttribute := schema.Attributes[prefix+k]
+		if isAttribute {
+			if attributeSchema.ConfigSchema.Computed && !attributeSchema.ConfigSchema.Optional && !attributeSchema.ConfigSchema.Required {
+				continue
+			}
+			body.SetAttributeValue(k, toCtyValue(value))
+			continue
+		}
+		if isNestedBlock(schema, prefix+k) {
+			blocks = append(blocks, k)
+		}
+	}
+
+	for _, k := range blocks {
+		var items []interface{}
+		switch v := attrs[k].(type) {
+		case []interface{}:
+			items = v
+		case map[string]interface{}:
+			items = []interface{}{v}
+		}
+		for _, item := range items {
+			itemAttrs, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			block := body.AppendNewBlock(k, nil)
+			writeResourceAttributes(block.Body(), itemAttrs, schema, prefix+k+".")
+		}
+	}
+}
+
+func isNestedBlock(schema *resource.Schema, path string) bool {
+	for attributePath := range schema.Attributes {
+		if strings.HasPrefix(attributePath, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// uniqueResourceName builds a valid Terraform resource name from a resource id
+func uniqueResourceName(id string, names map[string]struct{}) string {
+	name := invalidResourceNameChars.ReplaceAllString(id, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "r_" + name
+	}
+	unique := name
+	for i := 2; ; i++ {
+		if _, exist := names[unique]; !exist {
+			break
+		}
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[unique] = struct{}{}
+	return unique
+}
+
+func toCtyValue(value interface{}) cty.Value {
+	if value == nil {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return cty.StringVal(v.String())
+	case reflect.Bool:
+		return cty.BoolVal(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cty.NumberIntVal(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cty.NumberUIntVal(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cty.NumberFloatVal(v.Float())
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return cty.EmptyTupleVal
+		}
+		values := make([]cty.Value, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, toCtyValue(v.Index(i).Interface()))
+		}
+		return cty.TupleVal(values)
+	case reflect.Map:
+		if v.Len() == 0 {
+			return cty.EmptyObjectVal
+		}
+		values := make(map[string]cty.Value, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[fmt.Sprint(iter.Key().Interface())] = toCtyValue(iter.Value().Interface())
+		}
+		return cty.ObjectVal(values)
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		return toCtyValue(v.Elem().Interface())
+	}
+	return cty.StringVal(fmt.Sprint(value))
+}
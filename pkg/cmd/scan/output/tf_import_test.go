@@ -0,0 +1,156 @@
+package output
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/test/goldenfile"
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeAnalysisForTFImport() *analyser.Analysis {
+	bucketSchema := &resource.Schema{
+		Attributes: map[string]resource.AttributeSchema{
+			"id":                     {ConfigSchema: configschema.Attribute{Optional: true, Computed: true}},
+			"arn":                    {ConfigSchema: configschema.Attribute{Computed: true}},
+			"bucket":                 {ConfigSchema: configschema.Attribute{Optional: true, Computed: true}},
+			"force_destroy":          {ConfigSchema: configschema.Attribute{Optional: true}},
+			"tags":                   {ConfigSchema: configschema.Attribute{Optional: true}},
+			"policy":                 {ConfigSchema: configschema.Attribute{Optional: true}},
+			"versioning.enabled":     {ConfigSchema: configschema.Attribute{Optional: true}},
+			"versioning.mfa_delete":  {ConfigSchema: configschema.Attribute{Optional: true}},
+			"logging.target_bucket":  {ConfigSchema: configschema.Attribute{Required: true}},
+			"logging.target_prefix":  {ConfigSchema: configschema.Attribute{Optional: true}},
+			"logging.computed_field": {ConfigSchema: configschema.Attribute{Computed: true}},
+		},
+	}
+
+	a := analyser.NewAnalysis()
+	a.AddUnmanaged(
+		&resource.Resource{
+			Id:   "my-bucket",
+			Type: "aws_s3_bucket",
+			Attrs: &resource.Attributes{
+				"id":            "my-bucket",
+				"arn":           "arn:aws:s3:::my-bucket",
+				"bucket":        "my-bucket",
+				"force_destroy": false,
+				"alias":         "us-east-1",
+				"policy":        nil,
+				"tags": map[string]interface{}{
+					"Name":             "My bucket",
+					"kubernetes.io/ns": "${default}",
+				},
+				"versioning": []interface{}{
+					map[string]interface{}{
+						"enabled":    true,
+						"mfa_delete": false,
+					},
+				},
+				"logging": []interface{}{
+					map[string]interface{}{
+						"target_bucket":  "logs",
+						"target_prefix":  "my-bucket/",
+						"computed_field": "computed",
+					},
+				},
+			},
+			Sch: bucketSchema,
+		},
+		&resource.Resource{
+			Id:    "1-bucket.with.dots",
+			Type:  "aws_s3_bucket",
+			Attrs: &resource.Attributes{},
+			Sch:   bucketSchema,
+		},
+		&resource.Resource{
+			Id:    "1-bucket/with/dots",
+			Type:  "aws_s3_bucket",
+			Attrs: &resource.Attributes{},
+			Sch:   bucketSchema,
+		},
+		&resource.Resource{
+			Id:   "AKIA1234",
+			Type: "aws_iam_access_key",
+			Attrs: &resource.Attributes{
+				"id":   "AKIA1234",
+				"user": "driftctl",
+			},
+		},
+	)
+	a.AddDeleted(&resource.Resource{
+		Id:   "deleted-id-1",
+		Type: "aws_deleted_resource",
+	})
+	return a
+}
+
+func TestTFImport_Write(t *testing.T) {
+	tests := []struct {
+		name      string
+		goldendir string
+		analysis  *analyser.Analysis
+		wantFiles []string
+		wantErr   bool
+	}{
+		{
+			name:      "test tf-import output",
+			goldendir: "tf_import",
+			analysis:  fakeAnalysisForTFImport(),
+			wantFiles: []string{"aws_iam_access_key.tf", "aws_s3_bucket.tf"},
+			wantErr:   false,
+		},
+		{
+			name:      "test tf-import output when no unmanaged resources",
+			goldendir: "tf_import_empty",
+			analysis:  &analyser.Analysis{},
+			wantFiles: []string{},
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := path.Join(t.TempDir(), "imports")
+			c := NewTFImport(tempDir)
+			if err := c.Write(tt.analysis); (err != nil) != tt.wantErr {
+				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			entries, err := os.ReadDir(tempDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			files := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				files = append(files, entry.Name())
+			}
+			assert.Equal(t, tt.wantFiles, files)
+
+			for _, file := range files {
+				result, err := os.ReadFile(path.Join(tempDir, file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				goldenFileName := path.Join(tt.goldendir, file)
+				expectedFilePath := path.Join("./testdata/", goldenFileName)
+				if *goldenfile.Update == goldenFileName {
+					if err := os.MkdirAll(path.Dir(expectedFilePath), 0700); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(expectedFilePath, result, 0600); err != nil {
+						t.Fatal(err)
+					}
+				}
+				expected, err := os.ReadFile(expectedFilePath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, string(expected), string(result))
+			}
+		})
+	}
+}
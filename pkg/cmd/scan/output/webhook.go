@@ -0,0 +1,182 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/analyser"
+	pkghttp "github.com/snyk/driftctl/pkg/http"
+)
+
+const WebhookOutputType = "webhook"
+const WebhookOutputExample = "webhook+https://URL"
+
+const WebhookPresetJSON = "json"
+const WebhookPresetSlack = "slack"
+const WebhookPresetTeams = "teams"
+
+// Delivery is attempted this many times when the endpoint cannot be reached or
+// answers with a server error
+const webhookMaxAttempts = 3
+
+const webhookTimeout = 30 * time.Second
+
+var webhookPresets = map[string]string{
+	WebhookPresetJSON: `{{ json . }}`,
+	WebhookPresetSlack: `{{- $summary := .Summary -}}
+{{- $text := printf "*driftctl scan*: %d%% of resources are managed by IaC\n• %d not managed by IaC\n• %d missing on the cloud provider\n• %d changed outside of IaC" .Coverage $summary.TotalUnmanaged $summary.TotalDeleted $summary.TotalDrifted -}}
+{{- if .Resolved }}{{ $text = printf "%s\n• coverage went from %d%% to %d%%" $text .Resolved.PreviousCoverage .Coverage }}{{ end -}}
+{"text": {{ json $text }}}`,
+	WebhookPresetTeams: `{{- $summary := .Summary -}}
+{
+  "@type": "MessageCard",
+  "@context": "https://schema.org/extensions",
+  "summary": "driftctl scan",
+  "themeColor": "{{ if .IsSync }}2EB886{{ else }}D63333{{ end }}",
+  "title": {{ json (printf "driftctl scan: %d%% of resources are managed by IaC" .Coverage) }},
+  "sections": [{
+    "facts": [
+      {"name": "Total resources", "value": "{{ $summary.TotalResources }}"},
+      {"name": "Not managed by IaC", "value": "{{ $summary.TotalUnmanaged }}"},
+      {"name": "Missing on the cloud provider", "value": "{{ $summary.TotalDeleted }}"},
+      {"name": "Changed outside of IaC", "value": "{{ $summary.TotalDrifted }}"}{{ if .Resolved }},
+      {"name": "Previous coverage", "value": "{{ .Resolved.PreviousCoverage }}%"}{{ end }}
+    ]
+  }]
+}`,
+}
+
+// WebhookPresets returns the names of the built-in payload templates
+func WebhookPresets() []string {
+	return []string{WebhookPresetJSON, WebhookPresetSlack, WebhookPresetTeams}
+}
+
+// WebhookOptions holds the settings shared by webhook outputs
+type WebhookOptions struct {
+	// Headers are added to the request, e.g. for authentication
+	Headers map[string]string
+	// Template renders the payload from the analysis, the json preset is used when nil
+	Template *template.Template
+	// Always sends a notification, even when the infrastructure is in sync
+	Always bool
+}
+
+// ParseWebhookTemplate returns the template of the given preset, or the one read
+// from the file at the given path
+func ParseWebhookTemplate(presetOrPath string) (*template.Template, error) {
+	text, isPreset := webhookPresets[presetOrPath]
+	if !isPreset {
+		content, err := os.ReadFile(presetOrPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read webhook template")
+		}
+		text = string(content)
+	}
+
+	tmpl, err := template.New(presetOrPath).Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid webhook template")
+	}
+	return tmpl, nil
+}
+
+// Webhook posts the analysis, or a payload rendered from it, to an HTTP endpoint.
+// Nothing is sent when the infrastructure is in sync unless asked to.
+type Webhook struct {
+	url     string
+	opts    WebhookOptions
+	client  pkghttp.HTTPClient
+	backoff time.Duration
+}
+
+func NewWebhook(url string, opts *WebhookOptions) *Webhook {
+	webhook := &Webhook{
+		url:     url,
+		client:  &http.Client{Timeout: webhookTimeout},
+		backoff: time.Second,
+	}
+	if opts != nil {
+		webhook.opts = *opts
+	}
+	if webhook.opts.Template == nil {
+		webhook.opts.Template, _ = ParseWebhookTemplate(WebhookPresetJSON)
+	}
+	return webhook
+}
+
+func (c *Webhook) Write(analysis *analyser.Analysis) error {
+	if analysis.IsSync() && !c.opts.Always {
+		logrus.Debug("Infrastructure is in sync, skipping webhook notification")
+		return nil
+	}
+
+	var payload bytes.Buffer
+	if err := c.opts.Template.Execute(&payload, analysis); err != nil {
+		return errors.Wrap(err, "unable to render webhook payload")
+	}
+
+	var err error
+	for attempt := 1; attempt <= webhookMaxAttempts; attempt++ {
+		var retryable bool
+		retryable, err = c.send(payload.Bytes())
+		if err == nil || !retryable {
+			return err
+		}
+		if attempt < webhookMaxAttempts {
+			logrus.WithFields(logrus.Fields{
+				"attempt": attempt,
+				"error":   err,
+			}).Debug("Webhook delivery failed, retrying")
+			time.Sleep(c.backoff * time.Duration(attempt))
+		}
+	}
+	return errors.Wrapf(err, "webhook delivery failed after %d attempts", webhookMaxAttempts)
+}
+
+// send posts the payload and returns whether a failed delivery is worth retrying
+func (c *Webhook) send(payload []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range c.opts.Headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		body, _ := io.ReadAll(res.Body)
+		logrus.WithFields(logrus.Fields{"body": string(body)}).Trace("Webhook response")
+
+		retryable := res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+		return retryable, errors.Errorf("webhook endpoint answered with status code: %d", res.StatusCode)
+	}
+	return false, nil
+}
+
+// webhookURL returns the output string of a webhook without the path of its URL,
+// which often holds a secret token
+func webhookURL(url string) string {
+	scheme, rest, _ := strings.Cut(url, "://")
+	host, _, _ := strings.Cut(rest, "/")
+	return WebhookOutputType + "+" + scheme + "://" + host
+}
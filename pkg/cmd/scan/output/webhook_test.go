@@ -0,0 +1,166 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/stretchr/testify/assert"
+)
+
+type webhookRequest struct {
+	header http.Header
+	body   string
+}
+
+// newWebhookServer answers requests with the given status codes in turn, then with 200
+func newWebhookServer(t *testing.T, statuses ...int) (*httptest.Server, *[]webhookRequest) {
+	requests := make([]webhookRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, webhookRequest{r.Header, string(body)})
+		if len(requests) <= len(statuses) {
+			w.WriteHeader(statuses[len(requests)-1])
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestWebhook(url string, opts *WebhookOptions) *Webhook {
+	webhook := NewWebhook(url, opts)
+	webhook.backoff = 0
+	return webhook
+}
+
+func TestWebhook_Write(t *testing.T) {
+	server, requests := newWebhookServer(t)
+
+	c := newTestWebhook(server.URL, &WebhookOptions{Headers: map[string]string{"Authorization": "Bearer token"}})
+	assert.NoError(t, c.Write(fakeAnalysis()))
+
+	assert.Len(t, *requests, 1)
+	req := (*requests)[0]
+	assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", req.header.Get("Authorization"))
+
+	var analysis analyser.Analysis
+	assert.NoError(t, json.Unmarshal([]byte(req.body), &analysis))
+	assert.Equal(t, fakeAnalysis().Summary(), analysis.Summary())
+}
+
+func TestWebhook_Write_InSync(t *testing.T) {
+	server, requests := newWebhookServer(t)
+
+	assert.NoError(t, newTestWebhook(server.URL, nil).Write(fakeAnalysisNoDrift()))
+	assert.Empty(t, *requests)
+
+	assert.NoError(t, newTestWebhook(server.URL, &WebhookOptions{Always: true}).Write(fakeAnalysisNoDrift()))
+	assert.Len(t, *requests, 1)
+}
+
+func TestWebhook_Write_Presets(t *testing.T) {
+	for _, preset := range WebhookPresets() {
+		t.Run(preset, func(t *testing.T) {
+			server, requests := newWebhookServer(t)
+
+			tmpl, err := ParseWebhookTemplate(preset)
+			assert.NoError(t, err)
+			assert.NoError(t, newTestWebhook(server.URL, &WebhookOptions{Template: tmpl}).Write(fakeAnalysis()))
+			assert.NoError(t, newTestWebhook(server.URL, &WebhookOptions{Template: tmpl}).Write(fakeAnalysisWithResolution()))
+
+			assert.Len(t, *requests, 2)
+			for _, request := range *requests {
+				var payload map[string]interface{}
+				assert.NoError(t, json.Unmarshal([]byte(request.body), &payload), "payload should be valid JSON")
+			}
+		})
+	}
+
+	server, requests := newWebhookServer(t)
+	tmpl, _ := ParseWebhookTemplate(WebhookPresetSlack)
+	assert.NoError(t, newTestWebhook(server.URL, &WebhookOptions{Template: tmpl}).Write(fakeAnalysis()))
+	assert.JSONEq(t,
+		`{"text": "*driftctl scan*: 33% of resources are managed by IaC\n• 2 not managed by IaC\n• 2 missing on the cloud provider\n• 0 changed outside of IaC"}`,
+		(*requests)[0].body,
+	)
+
+	assert.NoError(t, newTestWebhook(server.URL, &WebhookOptions{Template: tmpl}).Write(fakeAnalysisWithResolution()))
+	assert.JSONEq(t,
+		`{"text": "*driftctl scan*: 33% of resources are managed by IaC\n• 2 not managed by IaC\n• 2 missing on the cloud provider\n• 0 changed outside of IaC\n• coverage went from 20% to 33%"}`,
+		(*requests)[1].body,
+	)
+}
+
+func TestWebhook_Write_CustomTemplate(t *testing.T) {
+	server, requests := newWebhookServer(t)
+
+	path := filepath.Join(t.TempDir(), "payload.tmpl")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"unmanaged": {{ .Summary.TotalUnmanaged }}, "provider": {{ json .ProviderName }}}`), 0600))
+
+	tmpl, err := ParseWebhookTemplate(path)
+	assert.NoError(t, err)
+	assert.NoError(t, newTestWebhook(server.URL, &WebhookOptions{Template: tmpl}).Write(fakeAnalysis()))
+	assert.Equal(t, `{"unmanaged": 2, "provider": "AWS"}`, (*requests)[0].body)
+}
+
+func TestParseWebhookTemplate_Invalid(t *testing.T) {
+	_, err := ParseWebhookTemplate("testdata/missing.tmpl")
+	assert.EqualError(t, err, "unable to read webhook template: open testdata/missing.tmpl: no such file or directory")
+
+	path := filepath.Join(t.TempDir(), "payload.tmpl")
+	assert.NoError(t, os.WriteFile(path, []byte(`{{ .Summary`), 0600))
+	_, err = ParseWebhookTemplate(path)
+	assert.ErrorContains(t, err, "invalid webhook template")
+}
+
+func TestWebhook_Write_Retry(t *testing.T) {
+	cases := []struct {
+		name     string
+		statuses []int
+		requests int
+		err      string
+	}{
+		{
+			name:     "delivered after server errors",
+			statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests},
+			requests: 3,
+		},
+		{
+			name:     "failed after too many server errors",
+			statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusServiceUnavailable},
+			requests: 3,
+			err:      "webhook delivery failed after 3 attempts: webhook endpoint answered with status code: 503",
+		},
+		{
+			name:     "client errors are not retried",
+			statuses: []int{http.StatusForbidden},
+			requests: 1,
+			err:      "webhook endpoint answered with status code: 403",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newWebhookServer(t, tt.statuses...)
+
+			err := newTestWebhook(server.URL, nil).Write(fakeAnalysis())
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, *requests, tt.requests)
+		})
+	}
+}
+
+func TestOutputConfig_String_Webhook(t *testing.T) {
+	config := OutputConfig{Key: WebhookOutputType, Path: "https://hooks.slack.com/services/T000/B000/XXXX"}
+	assert.Equal(t, "webhook+https://hooks.slack.com", config.String())
+}
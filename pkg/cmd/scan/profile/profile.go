@@ -0,0 +1,195 @@
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+// DefaultConfigFile is the project configuration file discovered in the
+// working directory
+const DefaultConfigFile = ".driftctl.yml"
+
+// Config is the content of a project configuration file
+type Config struct {
+	Profiles map[string]*Profile `json:"profiles"`
+}
+
+// Profile holds the settings of a named scan, each setting matches the scan
+// flag of the same name. Schedule is only used by the serve command.
+type Profile struct {
+	Schedule              string            `json:"schedule,omitempty"`
+	From                  []string          `json:"from,omitempty"`
+	To                    string            `json:"to,omitempty"`
+	Filter                string            `json:"filter,omitempty"`
+	Output                []string          `json:"output,omitempty"`
+	Driftignore           string            `json:"driftignore,omitempty"`
+	Ignore                []string          `json:"ignore,omitempty"`
+	Strict                *bool             `json:"strict,omitempty"`
+	Quiet                 *bool             `json:"quiet,omitempty"`
+	TfProviderVersion     string            `json:"tf-provider-version,omitempty"`
+	TfLockfile            string            `json:"tf-lockfile,omitempty"`
+	Headers               map[string]string `json:"headers,omitempty"`
+	TfcToken              string            `json:"tfc-token,omitempty"`
+	TfcEndpoint           string            `json:"tfc-endpoint,omitempty"`
+	AzurermStorageAccount string            `json:"azurerm-storage-account,omitempty"`
+	AzurermAccountKey     string            `json:"azurerm-account-key,omitempty"`
+	AwsRegions            []string          `json:"aws-regions,omitempty"`
+	AwsAccounts           []string          `json:"aws-accounts,omitempty"`
+	AwsOrganizationalUnit string            `json:"aws-organizational-unit,omitempty"`
+	AwsAssumeRoleName     string            `json:"aws-assume-role-name,omitempty"`
+	AwsAssumeRoleExtID    string            `json:"aws-assume-role-external-id,omitempty"`
+	AwsStateAccounts      map[string]string `json:"aws-state-accounts,omitempty"`
+	WebhookTemplate       string            `json:"webhook-template,omitempty"`
+	WebhookHeaders        map[string]string `json:"webhook-headers,omitempty"`
+	WebhookAlways         *bool             `json:"webhook-always,omitempty"`
+	CacheTTL              []string          `json:"cache-ttl,omitempty"`
+	NoCache               *bool             `json:"no-cache,omitempty"`
+	Concurrency           *int              `json:"concurrency,omitempty"`
+	MaxRetries            *int              `json:"max-retries,omitempty"`
+	RateLimit             []string          `json:"rate-limit,omitempty"`
+	BestEffort            *bool             `json:"best-effort,omitempty"`
+	Timeout               string            `json:"timeout,omitempty"`
+	EnumeratorTimeout     []string          `json:"enumerator-timeout,omitempty"`
+}
+
+// Read parses and validates the configuration file at the given path
+func Read(path string) (*Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := parse(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid configuration file '%s'", path)
+	}
+	return config, nil
+}
+
+func parse(content []byte) (*Config, error) {
+	j, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+	decoder := json.NewDecoder(bytes.NewReader(j))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, err
+	}
+
+	if len(config.Profiles) == 0 {
+		return nil, errors.New("no profile defined")
+	}
+	for name, profile := range config.Profiles {
+		if profile == nil {
+			return nil, errors.Errorf("profile '%s' is empty", name)
+		}
+	}
+
+	return &config, nil
+}
+
+// Profile returns the profile with the given name
+func (c *Config) Profile(name string) (*Profile, error) {
+	profile, exists := c.Profiles[name]
+	if !exists {
+		return nil, errors.Errorf("unknown profile '%s'\nAvailable profiles are: %s", name, strings.Join(c.Names(), ","))
+	}
+	return profile, nil
+}
+
+// Names returns the sorted names of the profiles
+func (c *Config) Names() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Flags returns the values of the profile by flag name. Multiple values are
+// returned for repeatable flags, in the order they should be set.
+func (p *Profile) Flags() map[string][]string {
+	flags := make(map[string][]string)
+	addValue := func(name, value string) {
+		if value != "" {
+			flags[name] = []string{value}
+		}
+	}
+	addValues := func(name string, values []string) {
+		if len(values) > 0 {
+			flags[name] = values
+		}
+	}
+	addBool := func(name string, value *bool) {
+		if value != nil {
+			flags[name] = []string{strconv.FormatBool(*value)}
+		}
+	}
+	addInt := func(name string, value *int) {
+		if value != nil {
+			flags[name] = []string{strconv.Itoa(*value)}
+		}
+	}
+	addMap := func(name string, values map[string]string) {
+		if len(values) == 0 {
+			return
+		}
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, 0, len(keys))
+		for _, key := range keys {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", key, values[key]))
+		}
+		flags[name] = pairs
+	}
+
+	addValues("from", p.From)
+	addValue("to", p.To)
+	addValue("filter", p.Filter)
+	addValues("output", p.Output)
+	addValue("driftignore", p.Driftignore)
+	addValues("ignore", p.Ignore)
+	addBool("strict", p.Strict)
+	addBool("quiet", p.Quiet)
+	addValue("tf-provider-version", p.TfProviderVersion)
+	addValue("tf-lockfile", p.TfLockfile)
+	addValue("tfc-token", p.TfcToken)
+	addValue("tfc-endpoint", p.TfcEndpoint)
+	addValue("azurerm-storage-account", p.AzurermStorageAccount)
+	addValue("azurerm-account-key", p.AzurermAccountKey)
+	addValues("aws-regions", p.AwsRegions)
+	addValues("aws-accounts", p.AwsAccounts)
+	addValue("aws-organizational-unit", p.AwsOrganizationalUnit)
+	addValue("aws-assume-role-name", p.AwsAssumeRoleName)
+	addValue("aws-assume-role-external-id", p.AwsAssumeRoleExtID)
+	addMap("aws-state-accounts", p.AwsStateAccounts)
+	addMap("headers", p.Headers)
+	addValue("webhook-template", p.WebhookTemplate)
+	addMap("webhook-headers", p.WebhookHeaders)
+	addBool("webhook-always", p.WebhookAlways)
+	addValues("cache-ttl", p.CacheTTL)
+	addBool("no-cache", p.NoCache)
+	addInt("concurrency", p.Concurrency)
+	addInt("max-retries", p.MaxRetries)
+	addValues("rate-limit", p.RateLimit)
+	addBool("best-effort", p.BestEffort)
+	addValue("timeout", p.Timeout)
+	addValues("enumerator-timeout", p.EnumeratorTimeout)
+
+	return flags
+}
@@ -0,0 +1,80 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	config, err := Read("testdata/valid.yml")
+	assert.NoError(t, err)
+	assert.Len(t, config.Profiles, 2)
+
+	p, err := config.Profile("prod")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"from":             {"tfstate+s3://my-bucket/prod/terraform.tfstate", "tfplan://plan.json"},
+		"to":               {"aws+tf"},
+		"filter":           {"Type=='aws_s3_bucket'"},
+		"output":           {"console://", "json://result.json", "webhook+https://hooks.slack.com/services/T000/B000/XXXX"},
+		"driftignore":      {".driftignore.prod"},
+		"strict":           {"false"},
+		"headers":          {"Authorization=Bearer token", "X-Env=prod"},
+		"aws-regions":      {"us-east-1", "eu-west-3"},
+		"webhook-template": {"slack"},
+		"webhook-headers":  {"X-Source=driftctl"},
+	}, p.Flags())
+
+	p, err = config.Profile("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"to":                 {"gcp+tf"},
+		"cache-ttl":          {"10m", "google_storage_bucket=1h"},
+		"concurrency":        {"4"},
+		"max-retries":        {"0"},
+		"rate-limit":         {"cloudasset=5"},
+		"best-effort":        {"true"},
+		"timeout":            {"30m"},
+		"enumerator-timeout": {"google_storage_bucket=2m"},
+	}, p.Flags())
+}
+
+func TestRead_Invalid(t *testing.T) {
+	cases := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "unknown setting",
+			path:     "testdata/unknown_field.yml",
+			expected: "invalid configuration file 'testdata/unknown_field.yml': json: unknown field \"regions\"",
+		},
+		{
+			name:     "no profile",
+			path:     "testdata/empty.yml",
+			expected: "invalid configuration file 'testdata/empty.yml': no profile defined",
+		},
+		{
+			name:     "missing file",
+			path:     "testdata/missing.yml",
+			expected: "open testdata/missing.yml: no such file or directory",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(tt.path)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestConfig_Profile_Unknown(t *testing.T) {
+	config, err := Read("testdata/valid.yml")
+	assert.NoError(t, err)
+
+	_, err = config.Profile("dev")
+	assert.EqualError(t, err, "unknown profile 'dev'\nAvailable profiles are: prod,staging")
+}
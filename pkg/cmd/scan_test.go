@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"os"
 	"testing"
+	"time"
 
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	"github.com/snyk/driftctl/pkg"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
@@ -40,6 +43,7 @@ func TestScanCmd_Valid(t *testing.T) {
 		{args: []string{"scan", "--to", "aws+tf", "--from", "tfstate+https://github.com/state.tfstate"}},
 		{args: []string{"scan", "--to", "aws+tf", "--from", "tfstate+tfcloud://workspace_id"}},
 		{args: []string{"scan", "--tfc-token", "token"}},
+		{args: []string{"scan", "--from", "tfstate+https://github.com/state.tfstate", "--headers", "Authorization=Bearer token"}},
 		{args: []string{"scan", "--filter", "Type=='aws_s3_bucket'"}},
 		{args: []string{"scan", "--strict"}},
 		{args: []string{"scan", "--tf-provider-version", "1.2.3"}},
@@ -47,8 +51,18 @@ func TestScanCmd_Valid(t *testing.T) {
 		{args: []string{"scan", "--driftignore", "./path/to/driftignore.s3"}},
 		{args: []string{"scan", "--driftignore", ".driftignore"}},
 		{args: []string{"scan", "-o", "html://result.html", "-o", "json://result.json"}},
+		{args: []string{"scan", "-o", "webhook+https://example.com/hook", "--webhook-template", "slack", "--webhook-headers", "Authorization=Bearer token"}},
 		{args: []string{"scan", "--tf-lockfile", "../.terraform.lock.hcl"}},
 		{args: []string{"scan", "--only-unmanaged"}},
+		{args: []string{"scan", "--cache-ttl", "10m,aws_s3_bucket=1h"}},
+		{args: []string{"scan", "--cache-ttl", "10m", "--no-cache"}},
+		{args: []string{"scan", "--concurrency", "4", "--max-retries", "0", "--rate-limit", "ec2=20,s3=5.5"}},
+		{args: []string{"scan", "--best-effort"}},
+		{args: []string{"scan", "--aws-regions", "us-east-1,eu-west-3"}},
+		{args: []string{"scan", "--aws-regions", "all"}},
+		{args: []string{"scan", "--aws-accounts", "111111111111,222222222222", "--aws-assume-role-name", "driftctl"}},
+		{args: []string{"scan", "--aws-organizational-unit", "ou-abcd-12345678", "--aws-assume-role-name", "driftctl", "--aws-assume-role-external-id", "id"}},
+		{args: []string{"scan", "--aws-accounts", "111111111111,222222222222", "--aws-assume-role-name", "driftctl", "--aws-state-accounts", "tfstate+s3://states/prod/*=111111111111"}},
 	}
 
 	for _, tt := range cases {
@@ -77,12 +91,12 @@ func TestScanCmd_Invalid(t *testing.T) {
 		{args: []string{"scan", "-f"}, expected: `flag needs an argument: 'f' in -f`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
 		{args: []string{"scan", "--from"}, expected: `flag needs an argument: --from`},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://"},
-		{args: []string{"scan", "--from", "unsupported://test"}, expected: "Unsupported IaC source 'unsupported': \nAccepted values are: tfstate"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfplan://"},
+		{args: []string{"scan", "--from", "://"}, expected: "Unable to parse from flag '://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfplan://"},
+		{args: []string{"scan", "--from", "://test"}, expected: "Unable to parse from flag '://test': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfplan://"},
+		{args: []string{"scan", "--from", "tosdgjhgsdhgkjs://"}, expected: "Unable to parse from flag 'tosdgjhgsdhgkjs://': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfplan://"},
+		{args: []string{"scan", "--from", "terraform+foo+bar://test"}, expected: "Unable to parse from scheme 'terraform+foo+bar': \nAccepted schemes are: tfstate://,tfstate+s3://,tfstate+http://,tfstate+https://,tfstate+tfcloud://,tfstate+gs://,tfstate+azurerm://,tfplan://"},
+		{args: []string{"scan", "--from", "unsupported://test"}, expected: "Unsupported IaC source 'unsupported': \nAccepted values are: tfstate,tfplan"},
 		{args: []string{"scan", "--from", "tfstate+foobar://test"}, expected: "Unsupported IaC backend 'foobar': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm"},
 		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https,tfcloud,gs,azurerm"},
 		{args: []string{"scan", "--filter", "Type='test'"}, expected: "unable to parse filter expression: SyntaxError: Expected tRbracket, received: tUnknown"},
@@ -90,7 +104,20 @@ func TestScanCmd_Invalid(t *testing.T) {
 		{args: []string{"scan", "--tf-provider-version", ".30.2"}, expected: "Invalid version argument .30.2, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--tf-provider-version", "foo"}, expected: "Invalid version argument foo, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--driftignore"}, expected: "flag needs an argument: --driftignore"},
+		{args: []string{"scan", "--cache-ttl", "aws_s3_bucket=1 hour"}, expected: "invalid cache TTL 'aws_s3_bucket=1 hour', expected a duration (e.g. 10m) or TYPE=DURATION (e.g. aws_s3_bucket=1h)"},
+		{args: []string{"scan", "--concurrency", "0"}, expected: "--concurrency must be at least 1"},
+		{args: []string{"scan", "--max-retries", "-1"}, expected: "--max-retries must not be negative"},
+		{args: []string{"scan", "--rate-limit", "ec2"}, expected: "invalid rate limit 'ec2', expected SERVICE=RATE with RATE a number of requests per second (e.g. ec2=20)"},
+		{args: []string{"scan", "--timeout", "-1m"}, expected: "--timeout must not be negative"},
+		{args: []string{"scan", "--enumerator-timeout", "aws_s3_bucket"}, expected: "invalid enumerator timeout 'aws_s3_bucket', expected a duration (e.g. 5m) or TYPE=DURATION (e.g. aws_s3_bucket=30s)"},
+		{args: []string{"scan", "-o", "webhook+https://example.com/hook", "--webhook-template", "testdata/missing.tmpl"}, expected: "unable to read webhook template: open testdata/missing.tmpl: no such file or directory"},
+		{args: []string{"scan", "--headers", "X Token=secret"}, expected: "invalid HTTP header name 'X Token' in --headers"},
+		{args: []string{"scan", "-o", "webhook+https://example.com/hook", "--webhook-headers", "X-Token=secret\n"}, expected: "invalid value of HTTP header 'X-Token' in --webhook-headers"},
 		{args: []string{"scan", "--tf-lockfile"}, expected: "flag needs an argument: --tf-lockfile"},
+		{args: []string{"scan", "--to", "gcp+tf", "--aws-regions", "us-east-1"}, expected: "--aws-regions can only be used with --to=aws+tf"},
+		{args: []string{"scan", "--aws-accounts", "111111111111"}, expected: "--aws-assume-role-name is required to scan multiple AWS accounts"},
+		{args: []string{"scan", "--aws-state-accounts", "terraform.tfstate=111111111111"}, expected: "--aws-state-accounts can only be used along with --aws-accounts or --aws-organizational-unit"},
+		{args: []string{"scan", "--to", "gcp+tf", "--aws-organizational-unit", "ou-abcd-12345678", "--aws-assume-role-name", "driftctl"}, expected: "--aws-accounts and --aws-organizational-unit can only be used with --to=aws+tf"},
 	}
 
 	for _, tt := range cases {
@@ -133,6 +160,52 @@ func Test_Options(t *testing.T) {
 				assert.Equal(t, "", opts.ProviderVersion)
 			},
 		},
+		{
+			name: "should set webhook options on webhook outputs",
+			args: []string{"scan", "-o", "json://result.json", "-o", "webhook+https://example.com/hook", "--webhook-headers", "X-Token=secret", "--webhook-always"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Nil(t, opts.Output[0].Webhook)
+				assert.Equal(t, "https://example.com/hook", opts.Output[1].Path)
+				assert.Equal(t, map[string]string{"X-Token": "secret"}, opts.Output[1].Webhook.Headers)
+				assert.True(t, opts.Output[1].Webhook.Always)
+				assert.NotNil(t, opts.Output[1].Webhook.Template)
+			},
+		},
+		{
+			name: "should parse cache TTL",
+			args: []string{"scan", "--cache-ttl", "10m", "--cache-ttl", "aws_s3_bucket=1h", "--no-cache"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, 10*time.Minute, opts.CacheTTL.For("aws_vpc"))
+				assert.Equal(t, time.Hour, opts.CacheTTL.For("aws_s3_bucket"))
+				assert.True(t, opts.NoCache)
+			},
+		},
+		{
+			name: "should parse throttling options",
+			args: []string{"scan", "--concurrency", "4", "--max-retries", "3", "--rate-limit", "ec2=20", "--rate-limit", "S3=0.5"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, 4, opts.Concurrency)
+				assert.Equal(t, 3, opts.MaxRetries)
+				assert.Equal(t, throttle.RateLimits{"ec2": 20, "s3": 0.5}, opts.RateLimits)
+				assert.False(t, opts.BestEffort)
+			},
+		},
+		{
+			name: "should enable best effort mode",
+			args: []string{"scan", "--best-effort"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.True(t, opts.BestEffort)
+			},
+		},
+		{
+			name: "should parse timeouts",
+			args: []string{"scan", "--timeout", "30m", "--enumerator-timeout", "5m", "--enumerator-timeout", "aws_s3_bucket=30s"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, 30*time.Minute, opts.Timeout)
+				assert.Equal(t, 5*time.Minute, opts.EnumeratorTimeouts.For("aws_vpc"))
+				assert.Equal(t, 30*time.Second, opts.EnumeratorTimeouts.For("aws_s3_bucket"))
+			},
+		},
 		{
 			name: "should fail to read lockfile with silent error",
 			args: []string{"scan", "--to", "gcp+tf", "--tf-lockfile", "testdata/terraform_invalid.lock.hcl"},
@@ -158,6 +231,83 @@ func Test_Options(t *testing.T) {
 	}
 }
 
+func Test_Profile(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("testdata/profile"))
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	cases := []struct {
+		name          string
+		args          []string
+		err           string
+		assertOptions func(*testing.T, *pkg.ScanOptions)
+	}{
+		{
+			name: "should apply profile settings",
+			args: []string{"scan", "--profile", "prod"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, []config.SupplierConfig{{Key: state.TerraformStateReaderSupplier, Path: "prod.tfstate"}}, opts.From)
+				assert.Equal(t, "aws+tf", opts.To)
+				assert.Equal(t, "3.41.0", opts.ProviderVersion)
+				assert.Equal(t, []string{"us-east-1", "eu-west-3"}, opts.AWSRegions)
+				assert.Len(t, opts.Output, 1)
+				assert.Equal(t, "json", opts.Output[0].Key)
+			},
+		},
+		{
+			name: "flags should take precedence over profile settings",
+			args: []string{"scan", "--profile", "prod", "--from", "tfstate://other.tfstate", "--aws-regions", "eu-west-1"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, []config.SupplierConfig{{Key: state.TerraformStateReaderSupplier, Path: "other.tfstate"}}, opts.From)
+				assert.Equal(t, "3.41.0", opts.ProviderVersion)
+				assert.Equal(t, []string{"eu-west-1"}, opts.AWSRegions)
+			},
+		},
+		{
+			name: "should fail on unknown profile",
+			args: []string{"scan", "--profile", "dev"},
+			err:  "unknown profile 'dev'\nAvailable profiles are: invalid,prod",
+		},
+		{
+			name: "should validate profile settings",
+			args: []string{"scan", "--profile", "invalid"},
+			err:  "unsupported cloud provider 'foo+tf'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &pkg.ScanOptions{}
+
+			rootCmd := &cobra.Command{Use: "root"}
+			scanCmd := NewScanCmd(opts)
+			scanCmd.RunE = func(_ *cobra.Command, args []string) error { return nil }
+			rootCmd.AddCommand(scanCmd)
+
+			_, err := test.Execute(rootCmd, tt.args...)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			tt.assertOptions(t, opts)
+		})
+	}
+}
+
+func Test_Profile_MissingConfigFile(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "root"}
+	scanCmd := NewScanCmd(&pkg.ScanOptions{})
+	scanCmd.RunE = func(_ *cobra.Command, args []string) error { return nil }
+	rootCmd.AddCommand(scanCmd)
+
+	_, err := test.Execute(rootCmd, "scan", "--profile", "prod")
+	assert.EqualError(t, err, "unable to use profile 'prod': .driftctl.yml not found in the working directory")
+}
+
 func Test_RetrieveBackendsFromHCL(t *testing.T) {
 	cases := []struct {
 		name     string
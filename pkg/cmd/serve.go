@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/fatih/color"
+	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/pkg/cmd/scan/profile"
+	"github.com/snyk/driftctl/pkg/memstore"
+	globaloutput "github.com/snyk/driftctl/pkg/output"
+	"github.com/snyk/driftctl/pkg/serve"
+	"github.com/spf13/cobra"
+)
+
+func NewServeCmd(opts *pkg.ServeOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run scans on schedule and serve their results",
+		Long: "Run the scan profiles of the " + profile.DefaultConfigFile + " file on the schedule set in each profile\n" +
+			"and keep the history of their results. Profiles without a schedule are only scanned on demand.\n" +
+			"Outputs of profiles are not written, results are available through a REST API:\n\n" +
+			"  GET  /api/scans          list scans, optionally filtered with ?profile=\n" +
+			"  POST /api/scans          trigger a scan with {\"profile\": \"prod\"}\n" +
+			"  GET  /api/scans/latest   summary of the latest succeeded scan, optionally filtered with ?profile=\n" +
+			"  GET  /api/scans/{id}     scan along with its full analysis\n" +
+			"  GET  /metrics            metrics of the latest succeeded scan of each profile in the Prometheus text format\n",
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Listen, _ = cmd.Flags().GetString("listen")
+			opts.Profiles, _ = cmd.Flags().GetStringSlice("profile")
+			opts.MaxScans, _ = cmd.Flags().GetInt("max-scans")
+			opts.ConfigDir, _ = cmd.Flags().GetString("config-dir")
+			opts.StoreDir, _ = cmd.Flags().GetString("store-dir")
+			if opts.StoreDir == "" {
+				opts.StoreDir = filepath.Join(opts.ConfigDir, ".driftctl", "scans")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serveRun(opts)
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.String(
+		"listen",
+		"127.0.0.1:8080",
+		"Address the HTTP API listens on\n",
+	)
+	fl.StringSlice(
+		"profile",
+		[]string{},
+		"Profiles to serve, by default every profile of the "+profile.DefaultConfigFile+" file is served\n",
+	)
+	fl.String(
+		"store-dir",
+		"",
+		"Directory where scan results are kept, defaults to .driftctl/scans in the config directory\n",
+	)
+	fl.Int(
+		"max-scans",
+		1000,
+		"Number of scans kept in history, older scans are removed. Use 0 to keep every scan.\n",
+	)
+
+	configDir, err := homedir.Dir()
+	if err != nil {
+		configDir = os.TempDir()
+	}
+	fl.String(
+		"config-dir",
+		configDir,
+		"Directory path that driftctl uses for configuration.\n",
+	)
+
+	return cmd
+}
+
+func serveRun(opts *pkg.ServeOptions) error {
+	projectConfig, err := profile.Read(profile.DefaultConfigFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.Errorf("%s not found in the working directory", profile.DefaultConfigFile)
+		}
+		return err
+	}
+
+	names := opts.Profiles
+	if len(names) == 0 {
+		names = projectConfig.Names()
+	}
+
+	schedules := make(map[string]serve.Schedule, len(names))
+	for _, name := range names {
+		p, err := projectConfig.Profile(name)
+		if err != nil {
+			return err
+		}
+		// Validate profiles on startup rather than on their first scan
+		if _, err := scanOptionsFromProfile(name); err != nil {
+			return errors.Wrapf(err, "invalid profile '%s'", name)
+		}
+		schedules[name] = nil
+		if p.Schedule != "" {
+			schedules[name], err = serve.ParseSchedule(p.Schedule)
+			if err != nil {
+				return errors.Wrapf(err, "invalid profile '%s'", name)
+			}
+		}
+	}
+
+	store, err := serve.NewStore(opts.StoreDir, opts.MaxScans)
+	if err != nil {
+		return err
+	}
+
+	runner := newProfileRunner(opts.ConfigDir)
+	defer runner.Cleanup()
+
+	service := serve.NewService(runner, store, schedules)
+	server := &http.Server{
+		Addr:    opts.Listen,
+		Handler: serve.NewHandler(service, store),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		service.Start(ctx)
+		close(stopped)
+	}()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		logrus.Warn("Detected interrupt, cleanup ...")
+		runner.Stop()
+		cancel()
+		_ = server.Shutdown(context.Background())
+	}()
+
+	globaloutput.NewConsolePrinter().Printf(color.WhiteString("Serving %d profile(s) on http://%s\n"), len(names), opts.Listen)
+
+	err = server.ListenAndServe()
+	runner.Stop()
+	cancel()
+	<-stopped
+	if err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// scanOptionsFromProfile builds the options of a scan the way the scan
+// command does with only the --profile flag set
+func scanOptionsFromProfile(name string) (*pkg.ScanOptions, error) {
+	opts := &pkg.ScanOptions{}
+	scanCmd := NewScanCmd(opts)
+	if err := scanCmd.Flags().Set("profile", name); err != nil {
+		return nil, err
+	}
+	if err := scanCmd.PreRunE(scanCmd, nil); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// profileRunner runs the scans of the serve command. Provider plugins are
+// started on the first scan that needs them and reused by the next ones.
+type profileRunner struct {
+	configDir string
+	libraries map[string]*terraform.ProviderLibrary
+	stop      chan os.Signal
+	stopOnce  sync.Once
+}
+
+func newProfileRunner(configDir string) *profileRunner {
+	return &profileRunner{
+		configDir: configDir,
+		libraries: make(map[string]*terraform.ProviderLibrary),
+		stop:      make(chan os.Signal),
+	}
+}
+
+func (r *profileRunner) Run(name string) (*analyser.Analysis, error) {
+	// The profile is read again so that changes apply without restarting the daemon
+	opts, err := scanOptionsFromProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	opts.ConfigDir = r.configDir
+	opts.Quiet = true
+
+	// A library holds a single version of each provider
+	key := opts.To + "@" + opts.ProviderVersion
+	library, exists := r.libraries[key]
+	if !exists {
+		library = terraform.NewProviderLibrary()
+		r.libraries[key] = library
+	}
+
+	return runScan(opts, library, memstore.New(), r.stop)
+}
+
+// Stop interrupts the running scan and any later one
+func (r *profileRunner) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+}
+
+func (r *profileRunner) Cleanup() {
+	for _, library := range r.libraries {
+		library.Cleanup()
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeCmd_Invalid(t *testing.T) {
+	cases := []struct {
+		name     string
+		dir      string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "missing configuration file",
+			dir:      ".",
+			args:     []string{"serve"},
+			expected: ".driftctl.yml not found in the working directory",
+		},
+		{
+			name:     "unknown profile",
+			dir:      "testdata/profile",
+			args:     []string{"serve", "--profile", "dev"},
+			expected: "unknown profile 'dev'\nAvailable profiles are: invalid,prod",
+		},
+		{
+			name:     "invalid profile",
+			dir:      "testdata/profile",
+			args:     []string{"serve", "--profile", "prod,invalid"},
+			expected: "invalid profile 'invalid': unsupported cloud provider 'foo+tf'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf",
+		},
+	}
+
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, os.Chdir(tt.dir))
+			defer func() {
+				_ = os.Chdir(wd)
+			}()
+
+			rootCmd := &cobra.Command{Use: "root"}
+			rootCmd.AddCommand(NewServeCmd(&pkg.ServeOptions{}))
+
+			_, err := test.Execute(rootCmd, tt.args...)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_ScanOptionsFromProfile(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("testdata/profile"))
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	opts, err := scanOptionsFromProfile("prod")
+	assert.NoError(t, err)
+	assert.Equal(t, "aws+tf", opts.To)
+	assert.Equal(t, "3.41.0", opts.ProviderVersion)
+	assert.Equal(t, []string{"us-east-1", "eu-west-3"}, opts.AWSRegions)
+}
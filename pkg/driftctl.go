@@ -7,6 +7,9 @@ import (
 	"github.com/jmespath/go-jmespath"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/remote/throttle"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/cmd/scan/output"
@@ -23,21 +26,56 @@ type FmtOptions struct {
 	Output output.OutputConfig
 }
 
+type DiffOptions struct {
+	PreviousPath string
+	CurrentPath  string
+	Output       []output.OutputConfig
+	Quiet        bool
+}
+
+type DriftIgnoreLintOptions struct {
+	DriftignorePath string
+	InputPath       string
+	StaleWithin     time.Duration
+}
+
+type ServeOptions struct {
+	Listen    string
+	Profiles  []string
+	StoreDir  string
+	MaxScans  int
+	ConfigDir string
+}
+
 type ScanOptions struct {
-	Coverage         bool
-	Detect           bool
-	From             []config.SupplierConfig
-	To               string
-	Output           []output.OutputConfig
-	Filter           *jmespath.JMESPath
-	Quiet            bool
-	BackendOptions   *backend.Options
-	StrictMode       bool
-	DisableTelemetry bool
-	ProviderVersion  string
-	ConfigDir        string
-	DriftignorePath  string
-	Driftignores     []string
+	Coverage                bool
+	Detect                  bool
+	From                    []config.SupplierConfig
+	To                      string
+	Output                  []output.OutputConfig
+	Filter                  *jmespath.JMESPath
+	Quiet                   bool
+	BackendOptions          *backend.Options
+	StrictMode              bool
+	DisableTelemetry        bool
+	ProviderVersion         string
+	ConfigDir               string
+	DriftignorePath         string
+	Driftignores            []string
+	AWSRegions              []string
+	AWSAccounts             []string
+	AWSOrganizationalUnit   string
+	AWSAssumeRoleName       string
+	AWSAssumeRoleExternalID string
+	AWSStateAccounts        map[string]string
+	CacheTTL                cache.TTL
+	NoCache                 bool
+	Concurrency             int
+	MaxRetries              int
+	RateLimits              throttle.RateLimits
+	BestEffort              bool
+	Timeout                 time.Duration
+	EnumeratorTimeouts      common.Timeouts
 }
 
 type DriftCTL struct {
@@ -97,6 +135,7 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		middlewares.AwsInstanceEIP{},
 		middlewares.NewAwsDefaultInternetGatewayRoute(),
 		middlewares.NewAwsDefaultInternetGateway(),
+		middlewares.NewAwsDefaultVpcDhcpOptions(),
 		middlewares.NewAwsDefaultVPC(),
 		middlewares.NewAwsDefaultSubnet(),
 		middlewares.NewAwsRouteTableExpander(d.alerter, d.resourceFactory),
@@ -121,6 +160,8 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		middlewares.NewAwsConsoleApiGatewayGatewayResponse(),
 		middlewares.NewAwsApiGatewayDomainNamesReconciler(),
 		middlewares.NewAwsApiGatewayBasePathMappingReconciler(),
+		// Must be before middlewares matching per-region resources which share the same id in every region
+		middlewares.NewAwsStateRegionTagger(),
 		middlewares.NewAwsEbsEncryptionByDefaultReconciler(d.resourceFactory),
 		middlewares.NewAwsALBTransformer(d.resourceFactory),
 		middlewares.NewAwsALBListenerTransformer(d.resourceFactory),
@@ -132,6 +173,9 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		middlewares.NewAzurermRouteExpander(d.resourceFactory),
 		middlewares.NewAzurermSubnetExpander(d.resourceFactory),
 		middlewares.NewAwsS3BucketPublicAccessBlockReconciler(),
+
+		// Must be last as it tags resources created by other middlewares too
+		middlewares.NewAwsStateAccountTagger(d.opts.AWSStateAccounts),
 	)
 
 	if !d.opts.StrictMode {
@@ -140,6 +184,9 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 			middlewares.NewGoogleLegacyBucketIAMMember(),
 			middlewares.NewGoogleDefaultIAMMember(),
 			middlewares.NewAwsDefaultApiGatewayAccount(),
+			middlewares.NewAwsEksManagedResources(),
+			middlewares.NewAwsLambdaLogGroup(),
+			middlewares.NewAwsServiceNetworkInterface(),
 		)
 	}
 
@@ -167,6 +214,12 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 	}
 
 	analysis.SetIaCSourceCount(d.iacSupplier.SourceCount())
+	if timedSupplier, ok := d.remoteSupplier.(resource.TimedSupplier); ok {
+		analysis.EnumerationTimings = timedSupplier.Timings()
+	}
+	if partialSupplier, ok := d.remoteSupplier.(resource.PartialSupplier); ok {
+		analysis.FailedResourceTypes = partialSupplier.FailedTypes()
+	}
 	analysis.Duration = time.Since(start)
 	analysis.Date = time.Now()
 
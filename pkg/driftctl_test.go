@@ -92,6 +92,7 @@ func runTest(t *testing.T, cases TestCases) {
 			testFilter := &filter.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 			testFilter.On("IsResourceIgnored", mock.Anything).Return(false)
+			testFilter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
 			analyzer := analyser.NewAnalyzer(testAlerter, testFilter)
 
 			store := memstore.New()
@@ -1324,6 +1325,7 @@ func TestDriftctlRun_TestResourcesNormalization(t *testing.T) {
 			testFilter.On("IsResourceIgnored", mock.MatchedBy(func(res *resource.Resource) bool {
 				return res.ResourceType() != c.Resource
 			})).Return(true)
+			testFilter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
 			analyzer := analyser.NewAnalyzer(testAlerter, testFilter)
 
 			stateSupplier := &dctlresource.MockIaCSupplier{}
@@ -0,0 +1,31 @@
+package filter
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+type ExpiredRuleAlert struct {
+	rule Rule
+}
+
+func NewExpiredRuleAlert(rule Rule) *ExpiredRuleAlert {
+	return &ExpiredRuleAlert{rule}
+}
+
+func (e *ExpiredRuleAlert) Message() string {
+	message := fmt.Sprintf("Driftignore rule '%s' expired on %s and is no longer applied", e.rule.Pattern, e.rule.Expires.Format(expiresLayout))
+	if annotations := e.rule.Annotations(); annotations != "" {
+		message = fmt.Sprintf("%s (%s)", message, annotations)
+	}
+	return message
+}
+
+func (e *ExpiredRuleAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
+func (e *ExpiredRuleAlert) Resource() *resource.Resource {
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
 	"github.com/sirupsen/logrus"
@@ -16,7 +17,9 @@ const separator = "_-_"
 type DriftIgnore struct {
 	driftignorePath string
 	ignorePatterns  []string
+	rules           []Rule
 	matcher         gitignore.Matcher
+	now             func() time.Time
 }
 
 func NewDriftIgnore(path string, ignorePatterns ...string) *DriftIgnore {
@@ -24,6 +27,7 @@ func NewDriftIgnore(path string, ignorePatterns ...string) *DriftIgnore {
 		driftignorePath: path,
 		ignorePatterns:  ignorePatterns,
 		matcher:         gitignore.NewMatcher(nil),
+		now:             time.Now,
 	}
 	var err error
 	if len(ignorePatterns) > 0 {
@@ -49,7 +53,7 @@ func (r *DriftIgnore) readIgnoreFile() error {
 	scanner := bufio.NewScanner(file)
 	for lineNumber := 1; scanner.Scan(); lineNumber++ {
 		line := scanner.Text()
-		r.parseIgnorePattern(line, &lines)
+		r.parseIgnorePattern(line, lineNumber, &lines)
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -64,27 +68,53 @@ func (r *DriftIgnore) readIgnoreFile() error {
 func (r *DriftIgnore) parseIgnorePatterns() error {
 	var lines []gitignore.Pattern
 	for _, p := range r.ignorePatterns {
-		r.parseIgnorePattern(p, &lines)
+		r.parseIgnorePattern(p, 0, &lines)
 	}
 	r.matcher = gitignore.NewMatcher(lines)
 	return nil
 }
 
-func (r *DriftIgnore) parseIgnorePattern(line string, patterns *[]gitignore.Pattern) {
-	if len(strings.ReplaceAll(line, " ", "")) <= 0 {
-		return // empty
+func (r *DriftIgnore) parseIgnorePattern(line string, lineNumber int, patterns *[]gitignore.Pattern) {
+	rule, isRule := ParseRule(line, lineNumber)
+	if !isRule {
+		return
 	}
+	if rule.Error != "" {
+		logrus.WithFields(logrus.Fields{
+			"line":    lineNumber,
+			"pattern": rule.Pattern,
+		}).Warnf("Ignoring driftignore annotation: %s", rule.Error)
+	}
+	r.rules = append(r.rules, rule)
 
-	if strings.HasPrefix(line, "#") {
-		return // this is a comment
+	// Expired rules no longer apply, they are reported through ExpiredRules
+	if rule.IsExpired(r.now()) {
+		return
 	}
-	line = strings.ReplaceAll(line, "/", separator)
+	*patterns = append(*patterns, rule.patterns()...)
+}
 
-	*patterns = append(*patterns, gitignore.ParsePattern(line, nil))
-	if !strings.HasSuffix(line, "*") {
-		line := fmt.Sprintf("%s.*", line)
-		*patterns = append(*patterns, gitignore.ParsePattern(line, nil))
+// Rules returns every rule read from the driftignore file or given on the command line,
+// including expired ones
+func (r *DriftIgnore) Rules() []Rule {
+	return r.rules
+}
+
+// ExpiredRules returns the rules past their expiry date, they are not applied anymore
+func (r *DriftIgnore) ExpiredRules() []Rule {
+	var expired []Rule
+	now := r.now()
+	for _, rule := range r.rules {
+		if rule.IsExpired(now) {
+			expired = append(expired, rule)
+		}
 	}
+	return expired
+}
+
+// AppliesRules tells whether some rules are applied, that is when not every rule is expired
+func (r *DriftIgnore) AppliesRules() bool {
+	return len(r.rules) > len(r.ExpiredRules())
 }
 
 func (r *DriftIgnore) isAnyOfChildrenTypesNotIgnored(ty resource.ResourceType) bool {
@@ -125,6 +155,13 @@ func (r *DriftIgnore) IsResourceIgnored(res *resource.Resource) bool {
 	return r.match(fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()))
 }
 
+// IsFieldIgnored returns true when the attribute at the given path of a managed resource is
+// ignored by a rule such as aws_instance.*.tags.LastPatched, changes on this attribute are then
+// not reported as drifts. Ignoring an attribute also ignores the attributes nested in it.
+func (r *DriftIgnore) IsFieldIgnored(res *resource.Resource, path []string) bool {
+	return r.match(fmt.Sprintf("%s.%s.%s", res.ResourceType(), res.ResourceId(), strings.Join(path, ".")))
+}
+
 func (r *DriftIgnore) match(strRes string) bool {
 	return r.matcher.Match([]string{strings.ReplaceAll(strRes, "/", separator)}, false)
 }
@@ -3,6 +3,7 @@ package filter
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -491,3 +492,173 @@ func TestDriftIgnore_IsTypeIgnored(t *testing.T) {
 		})
 	}
 }
+
+func TestDriftIgnore_IsFieldIgnored(t *testing.T) {
+	type field struct {
+		res  *resource.Resource
+		path []string
+	}
+	tests := []struct {
+		name    string
+		fields  []field
+		want    []bool
+		path    string
+		ignores []string
+	}{
+		{
+			name: "drift_ignore_fields",
+			fields: []field{
+				{res: &resource.Resource{Type: "res_type", Id: "full_drift_ignored"}, path: []string{"json"}},
+				{res: &resource.Resource{Type: "res_type", Id: "full_drift_ignored"}, path: []string{"foobar"}},
+				{res: &resource.Resource{Type: "res_type", Id: "full_drift_ignored"}, path: []string{"barfoo"}},
+				{res: &resource.Resource{Type: "res_type", Id: "partial_drift_ignored"}, path: []string{"foobar"}},
+				{res: &resource.Resource{Type: "res_type", Id: "partial_drift_ignored"}, path: []string{"json"}},
+				{res: &resource.Resource{Type: "res_type", Id: "wildcard_drift_ignored"}, path: []string{"struct", "baz"}},
+				{res: &resource.Resource{Type: "res_type", Id: "wildcard_drift_ignored"}, path: []string{"struct", "bar"}},
+				{res: &resource.Resource{Type: "res_type", Id: "endofpath_drift_ignored"}, path: []string{"struct", "baz"}},
+				{res: &resource.Resource{Type: "res_type", Id: "endofpath_drift_ignored"}, path: []string{"struct"}},
+				{res: &resource.Resource{Type: "res_type", Id: "endofpath_drift_ignored"}, path: []string{"other"}},
+				{res: &resource.Resource{Type: "resource_type", Id: "id.with.dots"}, path: []string{"json"}},
+				{res: &resource.Resource{Type: "resource_type", Id: "idwith\\"}, path: []string{"json"}},
+				{res: &resource.Resource{Type: "resource_type", Id: "idwith\\backslashes"}, path: []string{"foobar"}},
+				{res: &resource.Resource{Type: "resource_type", Id: "idwith\\backslashes"}, path: []string{"json"}},
+			},
+			want: []bool{
+				true,
+				true,
+				false,
+				true,
+				false,
+				true,
+				false,
+				true,
+				true,
+				false,
+				true,
+				true,
+				true,
+				false,
+			},
+			path: "testdata/drift_ignore_fields/.driftignore",
+		},
+		{
+			name: "drift_ignore_all_exclude_field",
+			fields: []field{
+				{res: &resource.Resource{Type: "res_type", Id: "id"}, path: []string{"foo"}},
+				{res: &resource.Resource{Type: "res_type", Id: "id"}, path: []string{"bar"}},
+			},
+			want: []bool{
+				true,
+				false,
+			},
+			path: "testdata/drift_ignore_all_exclude_field/.driftignore",
+		},
+		{
+			name: "ignore field of every resource of a type",
+			fields: []field{
+				{res: &resource.Resource{Type: "aws_instance", Id: "i-1"}, path: []string{"tags", "LastPatched"}},
+				{res: &resource.Resource{Type: "aws_instance", Id: "i-2"}, path: []string{"tags", "LastPatched"}},
+				{res: &resource.Resource{Type: "aws_instance", Id: "i-2"}, path: []string{"tags", "Name"}},
+				{res: &resource.Resource{Type: "aws_lambda_function", Id: "my-fn"}, path: []string{"source_code_hash"}},
+				{res: &resource.Resource{Type: "aws_lambda_function", Id: "other-fn"}, path: []string{"source_code_hash"}},
+			},
+			want: []bool{
+				true,
+				false,
+				false,
+				true,
+				false,
+			},
+			ignores: []string{"aws_instance.*.tags.LastPatched", "!aws_instance.i-2.tags.LastPatched", "aws_lambda_function.my-fn.source_code_hash"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewDriftIgnore(tt.path, tt.ignores...)
+			got := make([]bool, 0, len(tt.want))
+			for _, f := range tt.fields {
+				got = append(got, r.IsFieldIgnored(f.res, f.path))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDriftIgnore_FieldRulesDoNotIgnoreResources(t *testing.T) {
+	r := NewDriftIgnore("", "aws_instance.*.tags.LastPatched", "aws_lambda_function.my-fn.source_code_hash")
+
+	assert.False(t, r.IsTypeIgnored("aws_instance"))
+	assert.False(t, r.IsResourceIgnored(&resource.Resource{Type: "aws_instance", Id: "i-1"}))
+	assert.False(t, r.IsResourceIgnored(&resource.Resource{Type: "aws_lambda_function", Id: "my-fn"}))
+}
+
+func TestDriftIgnore_AnnotatedRules(t *testing.T) {
+	r := NewDriftIgnore("testdata/drift_ignore_annotated/.driftignore")
+
+	assert.False(t, r.IsResourceIgnored(&resource.Resource{Type: "aws_s3_bucket", Id: "legacy-logs"}))
+	assert.True(t, r.IsResourceIgnored(&resource.Resource{Type: "aws_s3_bucket", Id: "forever"}))
+	assert.True(t, r.IsResourceIgnored(&resource.Resource{Type: "aws_iam_user", Id: "bot"}))
+	assert.True(t, r.IsResourceIgnored(&resource.Resource{Type: "aws_iam_role", Id: "admin"}))
+	assert.True(t, r.IsResourceIgnored(&resource.Resource{Type: "aws_instance", Id: "i-1"}))
+
+	assert.Len(t, r.Rules(), 5)
+	expired := r.ExpiredRules()
+	assert.Equal(t, []Rule{
+		{
+			Pattern: "aws_s3_bucket.legacy-logs",
+			Line:    2,
+			Expires: time.Date(2000, 1, 31, 0, 0, 0, 0, time.UTC),
+			Owner:   "team-infra",
+			Reason:  "migration in progress",
+			Ticket:  "INFRA-123",
+		},
+	}, expired)
+	assert.True(t, r.AppliesRules())
+
+	r = NewDriftIgnore("/dev/null")
+	assert.False(t, r.AppliesRules())
+}
+
+func TestDriftIgnore_Lint(t *testing.T) {
+	r := NewDriftIgnore("testdata/drift_ignore_annotated/.driftignore")
+	r.now = func() time.Time {
+		return time.Date(2999, 12, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	result := r.Lint(time.Date(3000, 1, 1, 12, 0, 0, 0, time.UTC), []string{
+		"aws_s3_bucket.forever",
+		"aws_instance.i-1.tags.Name",
+	}, nil)
+
+	assert.Equal(t, []string{"aws_s3_bucket.legacy-logs"}, patterns(result.Expired))
+	assert.Equal(t, []string{"aws_iam_user.bot"}, patterns(result.Stale))
+	assert.Equal(t, []string{"aws_instance.*"}, patterns(result.Invalid))
+	assert.Equal(t, []string{"aws_iam_user.bot", "aws_iam_role.admin"}, patterns(result.Unused))
+	assert.False(t, result.IsEmpty())
+
+	result = r.Lint(r.now(), nil, nil)
+	assert.Empty(t, result.Stale)
+	assert.Empty(t, result.Unused)
+}
+
+func TestDriftIgnore_LintAttributeRules(t *testing.T) {
+	r := NewDriftIgnore("testdata/drift_ignore_attributes/.driftignore")
+
+	// Attributes of managed instances are not drifting, their rule is still used as they may drift again
+	result := r.Lint(r.now(), []string{
+		"aws_instance.i-1",
+		"aws_iam_user.bot",
+	}, []string{
+		"aws_instance.i-1",
+	})
+
+	assert.Equal(t, []string{"aws_iam_user.*.tags"}, patterns(result.Unused))
+}
+
+func patterns(rules []Rule) []string {
+	var patterns []string
+	for _, rule := range rules {
+		patterns = append(patterns, rule.Pattern)
+	}
+	return patterns
+}
@@ -0,0 +1,62 @@
+package filter
+
+import (
+	"time"
+)
+
+// LintResult holds the driftignore rules that need attention
+type LintResult struct {
+	// Expired rules are past their expiry date and no longer applied
+	Expired []Rule
+	// Stale rules expire before the deadline given to Lint
+	Stale []Rule
+	// Invalid rules have annotations that could not be parsed
+	Invalid []Rule
+	// Unused rules do not match any resource or attribute of the scan, attribute rules are not reported as long as
+	// they match a managed resource
+	Unused []Rule
+}
+
+func (r LintResult) IsEmpty() bool {
+	return len(r.Expired) == 0 && len(r.Stale) == 0 && len(r.Invalid) == 0 && len(r.Unused) == 0
+}
+
+// Lint checks every rule against the given deadline, rules expiring before it are reported as stale.
+// When keys of a scan are given (e.g. aws_s3_bucket.my-bucket or aws_instance.i-0a1b2c.tags.Name),
+// the rules matching none of them are reported as unused. Attribute rules such as aws_instance.*.tags.LastPatched
+// only match drifting attributes, so they are not reported as unused while they match one of the given keys
+// of managed resources: the attribute may drift again.
+func (r *DriftIgnore) Lint(staleBefore time.Time, keys, managedKeys []string) LintResult {
+	result := LintResult{}
+	now := r.now()
+	for _, rule := range r.rules {
+		if rule.Error != "" {
+			result.Invalid = append(result.Invalid, rule)
+		}
+		if rule.IsExpired(now) {
+			result.Expired = append(result.Expired, rule)
+			continue
+		}
+		if rule.IsExpired(staleBefore) {
+			result.Stale = append(result.Stale, rule)
+		}
+		if keys != nil && !matchesAny(rule, keys) && !matchesManagedResource(rule, managedKeys) {
+			result.Unused = append(result.Unused, rule)
+		}
+	}
+	return result
+}
+
+func matchesAny(rule Rule, keys []string) bool {
+	for _, key := range keys {
+		if rule.Matches(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesManagedResource(rule Rule, managedKeys []string) bool {
+	resourceRule, isAttributeRule := rule.resourceRule()
+	return isAttributeRule && matchesAny(resourceRule, managedKeys)
+}
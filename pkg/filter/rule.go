@@ -0,0 +1,147 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+const expiresLayout = "2006-01-02"
+
+var (
+	annotationsSeparator = regexp.MustCompile(`\s#`)
+	annotation           = regexp.MustCompile(`(\w+)=(?:"([^"]*)"|(\S+))`)
+)
+
+// Rule is a line of a driftignore file. A rule can be annotated with a trailing comment, e.g.
+//
+//	aws_s3_bucket.legacy-logs # expires=2026-12-31 owner=team-infra reason="migration in progress" ticket=INFRA-123
+//
+// A comment without any key=value annotation is kept as the reason of the rule.
+type Rule struct {
+	Pattern string
+	// Line of the rule in the driftignore file, zero for rules given on the command line
+	Line int
+	// Expires is the last day the rule applies, the rule never expires when it is zero
+	Expires time.Time
+	Owner   string
+	Reason  string
+	Ticket  string
+	// Error describes an annotation that could not be parsed, it is empty for valid rules
+	Error string
+}
+
+// ParseRule parses a driftignore line, it returns false for empty and comment lines
+func ParseRule(line string, lineNumber int) (Rule, bool) {
+	if len(strings.ReplaceAll(line, " ", "")) <= 0 {
+		return Rule{}, false // empty
+	}
+
+	if strings.HasPrefix(line, "#") {
+		return Rule{}, false // this is a comment
+	}
+
+	rule := Rule{Pattern: line, Line: lineNumber}
+	loc := annotationsSeparator.FindStringIndex(line)
+	if loc == nil {
+		return rule, true
+	}
+	rule.Pattern = strings.TrimRight(line[:loc[0]], " \t")
+	comment := strings.TrimSpace(line[loc[1]:])
+
+	annotations := annotation.FindAllStringSubmatch(comment, -1)
+	if len(annotations) == 0 {
+		rule.Reason = comment
+		return rule, true
+	}
+	for _, a := range annotations {
+		value := a[2] + a[3]
+		switch a[1] {
+		case "expires":
+			expires, err := time.Parse(expiresLayout, value)
+			if err != nil {
+				rule.Error = fmt.Sprintf("invalid expiry date '%s', expected YYYY-MM-DD (e.g. 2026-12-31)", value)
+				continue
+			}
+			rule.Expires = expires
+		case "owner":
+			rule.Owner = value
+		case "reason":
+			rule.Reason = value
+		case "ticket":
+			rule.Ticket = value
+		}
+	}
+
+	return rule, true
+}
+
+// IsExpired returns true once the last day of the rule is over
+func (r Rule) IsExpired(now time.Time) bool {
+	return !r.Expires.IsZero() && !now.Before(r.Expires.AddDate(0, 0, 1))
+}
+
+// Annotations returns a human readable summary of the rule metadata
+func (r Rule) Annotations() string {
+	var annotations []string
+	if !r.Expires.IsZero() {
+		annotations = append(annotations, fmt.Sprintf("expires: %s", r.Expires.Format(expiresLayout)))
+	}
+	if r.Owner != "" {
+		annotations = append(annotations, fmt.Sprintf("owner: %s", r.Owner))
+	}
+	if r.Reason != "" {
+		annotations = append(annotations, fmt.Sprintf("reason: %s", r.Reason))
+	}
+	if r.Ticket != "" {
+		annotations = append(annotations, fmt.Sprintf("ticket: %s", r.Ticket))
+	}
+	return strings.Join(annotations, ", ")
+}
+
+func (r Rule) patterns() []gitignore.Pattern {
+	line := strings.ReplaceAll(r.Pattern, "/", separator)
+
+	patterns := []gitignore.Pattern{gitignore.ParsePattern(line, nil)}
+	if !strings.HasSuffix(line, "*") {
+		line := fmt.Sprintf("%s.*", line)
+		patterns = append(patterns, gitignore.ParsePattern(line, nil))
+	}
+	return patterns
+}
+
+// Matches returns true when the rule applies to the given key, e.g. aws_s3_bucket.my-bucket
+// or aws_instance.i-0a1b2c.tags.Name. Negated rules match the keys they exclude.
+func (r Rule) Matches(key string) bool {
+	path := []string{strings.ReplaceAll(key, "/", separator)}
+	for _, p := range r.patterns() {
+		if p.Match(path, false) != gitignore.NoMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceRule returns the part of an attribute rule matching resources, e.g. aws_instance.* for
+// aws_instance.*.tags.LastPatched. False is returned for rules matching whole resources.
+func (r Rule) resourceRule() (Rule, bool) {
+	// Dots of resource ids are escaped in patterns, e.g. aws_s3_bucket.my\.bucket
+	segments := 0
+	for i := 0; i < len(r.Pattern); i++ {
+		switch r.Pattern[i] {
+		case '\\':
+			i++
+		case '.':
+			segments++
+			if segments == 2 {
+				resourceRule := r
+				resourceRule.Pattern = r.Pattern[:i]
+				return resourceRule, true
+			}
+		}
+	}
+	return Rule{}, false
+}
@@ -0,0 +1,103 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		want   Rule
+		isRule bool
+	}{
+		{
+			name:   "empty line",
+			line:   "   ",
+			isRule: false,
+		},
+		{
+			name:   "comment",
+			line:   "# aws_s3_bucket.foo",
+			isRule: false,
+		},
+		{
+			name:   "plain pattern",
+			line:   "aws_s3_bucket.foo",
+			want:   Rule{Pattern: "aws_s3_bucket.foo", Line: 1},
+			isRule: true,
+		},
+		{
+			name:   "pattern with a hash",
+			line:   "aws_s3_bucket.foo#bar",
+			want:   Rule{Pattern: "aws_s3_bucket.foo#bar", Line: 1},
+			isRule: true,
+		},
+		{
+			name: "annotated pattern",
+			line: `aws_s3_bucket.foo   # expires=2026-12-31 owner=team-infra reason="legacy bucket" ticket=INFRA-123`,
+			want: Rule{
+				Pattern: "aws_s3_bucket.foo",
+				Line:    1,
+				Expires: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+				Owner:   "team-infra",
+				Reason:  "legacy bucket",
+				Ticket:  "INFRA-123",
+			},
+			isRule: true,
+		},
+		{
+			name:   "free text comment",
+			line:   "aws_s3_bucket.foo # managed by another team",
+			want:   Rule{Pattern: "aws_s3_bucket.foo", Line: 1, Reason: "managed by another team"},
+			isRule: true,
+		},
+		{
+			name: "invalid expiry date",
+			line: "aws_s3_bucket.foo # expires=31/12/2026 owner=me",
+			want: Rule{
+				Pattern: "aws_s3_bucket.foo",
+				Line:    1,
+				Owner:   "me",
+				Error:   "invalid expiry date '31/12/2026', expected YYYY-MM-DD (e.g. 2026-12-31)",
+			},
+			isRule: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, isRule := ParseRule(tt.line, 1)
+			assert.Equal(t, tt.isRule, isRule)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRule_IsExpired(t *testing.T) {
+	rule := Rule{Pattern: "aws_s3_bucket.foo", Expires: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)}
+
+	assert.False(t, rule.IsExpired(time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, rule.IsExpired(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, Rule{Pattern: "aws_s3_bucket.foo"}.IsExpired(time.Now()))
+}
+
+func TestRule_resourceRule(t *testing.T) {
+	cases := map[string]string{
+		"aws_instance.*.tags.LastPatched":      "aws_instance.*",
+		"aws_s3_bucket.my\\.bucket.versioning": "aws_s3_bucket.my\\.bucket",
+		"!aws_iam_user.bot.tags":               "!aws_iam_user.bot",
+		"aws_s3_bucket.my\\.bucket":            "",
+		"aws_instance.*":                       "",
+		"aws_instance":                         "",
+	}
+	for pattern, expected := range cases {
+		t.Run(pattern, func(t *testing.T) {
+			resourceRule, isAttributeRule := Rule{Pattern: pattern}.resourceRule()
+			assert.Equal(t, expected != "", isAttributeRule)
+			assert.Equal(t, expected, resourceRule.Pattern)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/snyk/driftctl/pkg/output"
 
+	"github.com/snyk/driftctl/pkg/iac/terraform/plan"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
 
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -21,6 +22,7 @@ import (
 
 var supportedSuppliers = []string{
 	state.TerraformStateReaderSupplier,
+	plan.TerraformPlanReaderSupplier,
 }
 
 func IsSupplierSupported(supplierKey string) bool {
@@ -53,6 +55,8 @@ func GetIACSupplier(configs []config.SupplierConfig,
 		switch config.Key {
 		case state.TerraformStateReaderSupplier:
 			supplier, err = state.NewReader(config, library, backendOpts, progress, alerter, deserializer, filter)
+		case plan.TerraformPlanReaderSupplier:
+			supplier, err = plan.NewReader(config, library, progress, deserializer, filter)
 		default:
 			return nil, errors.Errorf("Unsupported supplier '%s'", config.Key)
 		}
@@ -80,10 +84,10 @@ func GetSupportedSchemes() []string {
 	schemes := []string{
 		"tfstate://",
 	}
-	for _, supplier := range supportedSuppliers {
-		for _, backend := range backend.GetSupportedBackends() {
-			schemes = append(schemes, fmt.Sprintf("%s+%s://", supplier, backend))
-		}
+	for _, backend := range backend.GetSupportedBackends() {
+		schemes = append(schemes, fmt.Sprintf("%s+%s://", state.TerraformStateReaderSupplier, backend))
 	}
+	// Plans can only be read from a local file
+	schemes = append(schemes, fmt.Sprintf("%s://", plan.TerraformPlanReaderSupplier))
 	return schemes
 }
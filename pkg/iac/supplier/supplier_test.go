@@ -85,6 +85,30 @@ func TestGetIACSupplier(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "test valid tfplan://plan.json",
+			args: args{
+				config: []config.SupplierConfig{
+					{Key: "tfplan", Backend: "", Path: "plan.json"},
+				},
+				options: &backend.Options{
+					Headers: map[string]string{},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "test tfplan from a remote backend",
+			args: args{
+				config: []config.SupplierConfig{
+					{Key: "tfplan", Backend: "s3", Path: "bucket/plan.json"},
+				},
+				options: &backend.Options{
+					Headers: map[string]string{},
+				},
+			},
+			wantErr: fmt.Errorf("Unsupported backend 's3' for tfplan, plans can only be read from a local file"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -117,6 +141,7 @@ func TestGetSupportedSchemes(t *testing.T) {
 		"tfstate+tfcloud://",
 		"tfstate+gs://",
 		"tfstate+azurerm://",
+		"tfplan://",
 	}
 
 	if got := GetSupportedSchemes(); !reflect.DeepEqual(got, want) {
@@ -0,0 +1,317 @@
+package plan
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
+	ctyconvert "github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/output"
+	resdriftctl "github.com/snyk/driftctl/pkg/resource"
+)
+
+const TerraformPlanReaderSupplier = "tfplan"
+
+const actionDelete = "delete"
+
+// TerraformPlanReader reads resources from the JSON representation of a
+// Terraform plan, as produced by `terraform show -json <planfile>`.
+// Resources are read from the prior state, and resources that the plan is
+// about to create are read from the planned values.
+type TerraformPlanReader struct {
+	library      *terraform.ProviderLibrary
+	config       config.SupplierConfig
+	deserializer *resource.Deserializer
+	progress     output.Progress
+	filter       filter.Filter
+}
+
+func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary, progress output.Progress, deserializer *resource.Deserializer, filter filter.Filter) (*TerraformPlanReader, error) {
+	if config.Backend != backend.BackendKeyFile {
+		return nil, errors.Errorf("Unsupported backend '%s' for %s, plans can only be read from a local file", config.Backend, TerraformPlanReaderSupplier)
+	}
+	return &TerraformPlanReader{
+		library:      library,
+		config:       config,
+		deserializer: deserializer,
+		progress:     progress,
+		filter:       filter,
+	}, nil
+}
+
+func (r *TerraformPlanReader) Resources() ([]*resource.Resource, error) {
+	logrus.WithFields(logrus.Fields{
+		"path": r.config.Path,
+	}).Debug("Reading resources from plan")
+	r.progress.Inc()
+
+	p, err := r.read()
+	if err != nil {
+		return nil, errors.Wrap(err, r.config.String())
+	}
+
+	results := make([]*resource.Resource, 0)
+	for _, planRes := range p.resources() {
+		res, err := r.decode(planRes)
+		if err != nil {
+			return nil, errors.Wrap(err, r.config.String())
+		}
+		if res != nil {
+			results = append(results, res)
+		}
+	}
+
+	return results, nil
+}
+
+func (r *TerraformPlanReader) SourceCount() uint {
+	return 1
+}
+
+func (r *TerraformPlanReader) read() (*planFile, error) {
+	reader, err := backend.NewFileReader(r.config.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var p planFile
+	if err := json.NewDecoder(reader).Decode(&p); err != nil {
+		return nil, errors.Wrap(err, "given file is not a valid Terraform plan in JSON format")
+	}
+	if p.FormatVersion == "" {
+		return nil, errors.New("given file is not a valid Terraform plan in JSON format")
+	}
+
+	return &p, nil
+}
+
+func (r *TerraformPlanReader) decode(planRes plannedResource) (*resource.Resource, error) {
+	fields := logrus.Fields{
+		"address": planRes.Address,
+		"type":    planRes.Type,
+	}
+
+	if planRes.Mode != "managed" {
+		logrus.WithFields(fields).Debug("Skipping plan entry as it is not a managed resource")
+		return nil, nil
+	}
+
+	if !resdriftctl.IsResourceTypeSupported(planRes.Type) {
+		logrus.WithFields(fields).Debug("Ignored unsupported resource from plan")
+		return nil, nil
+	}
+
+	if r.filter != nil && r.filter.IsTypeIgnored(resource.ResourceType(planRes.Type)) {
+		logrus.WithFields(fields).Debug("Ignored resource from plan since it is ignored in filter")
+		return nil, nil
+	}
+
+	provider := r.library.Provider(planRes.providerType())
+	if provider == nil {
+		logrus.WithFields(logrus.Fields{
+			"providerKey": planRes.providerType(),
+		}).Debug("Unsupported provider found in plan")
+		return nil, nil
+	}
+
+	schema, exists := provider.Schema()[planRes.Type]
+	if !exists {
+		logrus.WithFields(fields).Debug("Unable to find schema for resource in plan")
+		return nil, nil
+	}
+
+	val, err := decodeValues(planRes.Values, schema.Block.ImpliedType())
+	if err != nil {
+		logrus.WithFields(fields).Error("Unable to decode resource from plan")
+		return nil, err
+	}
+
+	// The id of a resource about to be created is usually not known until apply
+	if val.IsNull() || !val.Type().HasAttribute("id") || val.GetAttr("id").IsNull() {
+		logrus.WithFields(fields).Debug("Skipping plan entry as its id is not known until apply")
+		return nil, nil
+	}
+
+	res, err := r.deserializer.DeserializeOne(planRes.Type, val)
+	if err != nil {
+		logrus.WithFields(fields).Warnf("Could not read from plan: %+v", err)
+		return nil, nil
+	}
+	if res == nil {
+		return nil, nil
+	}
+	res.Source = resource.NewTerraformPlanSource(r.config.String(), planRes.module, planRes.Name, planRes.pending)
+
+	return res, nil
+}
+
+// decodeValues converts the values of a planned resource to the type implied
+// by the provider schema. Values unknown to the schema are dropped, which
+// allows reading plans generated with a newer provider version, and values
+// not known until apply are set to null.
+func decodeValues(values json.RawMessage, ty cty.Type) (cty.Value, error) {
+	inputType, err := ctyjson.ImpliedType(values)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	input, err := ctyjson.Unmarshal(values, inputType)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	return ctyconvert.Convert(normalize(input, ty), ty)
+}
+
+// normalize recursively aligns object values on the given type so that they
+// can be converted to it.
+func normalize(val cty.Value, ty cty.Type) cty.Value {
+	if val.IsNull() || !val.IsKnown() {
+		return val
+	}
+
+	switch {
+	case ty.IsObjectType() && val.Type().IsObjectType():
+		inputAttrs := val.AsValueMap()
+		attrs := make(map[string]cty.Value, len(ty.AttributeTypes()))
+		for name, attrType := range ty.AttributeTypes() {
+			attr, exists := inputAttrs[name]
+			if !exists {
+				attrs[name] = cty.NullVal(attrType)
+				continue
+			}
+			attrs[name] = normalize(attr, attrType)
+		}
+		return cty.ObjectVal(attrs)
+	case (ty.IsListType() || ty.IsSetType()) && (val.Type().IsTupleType() || val.Type().IsListType()):
+		elems := make([]cty.Value, 0, val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			_, elem := it.Element()
+			elems = append(elems, normalize(elem, ty.ElementType()))
+		}
+		return cty.TupleVal(elems)
+	case ty.IsMapType() && val.Type().IsObjectType():
+		elems := make(map[string]cty.Value)
+		for key, elem := range val.AsValueMap() {
+			elems[key] = normalize(elem, ty.ElementType())
+		}
+		return cty.ObjectVal(elems)
+	}
+
+	return val
+}
+
+type planFile struct {
+	FormatVersion   string               `json:"format_version"`
+	PlannedValues   planValues           `json:"planned_values"`
+	PriorState      *planPriorState      `json:"prior_state,omitempty"`
+	ResourceChanges []planResourceChange `json:"resource_changes"`
+}
+
+type planPriorState struct {
+	Values planValues `json:"values"`
+}
+
+type planValues struct {
+	RootModule planModule `json:"root_module"`
+}
+
+type planModule struct {
+	Address      string         `json:"address"`
+	Resources    []planResource `json:"resources"`
+	ChildModules []planModule   `json:"child_modules"`
+}
+
+type planResource struct {
+	Address      string          `json:"address"`
+	Mode         string          `json:"mode"`
+	Type         string          `json:"type"`
+	Name         string          `json:"name"`
+	ProviderName string          `json:"provider_name"`
+	Values       json.RawMessage `json:"values"`
+}
+
+type planResourceChange struct {
+	Address string `json:"address"`
+	Change  struct {
+		Actions []string `json:"actions"`
+	} `json:"change"`
+}
+
+type plannedResource struct {
+	planResource
+	module  string
+	pending bool
+}
+
+// providerType returns the provider type from either a fully qualified
+// provider address (registry.terraform.io/hashicorp/aws) or a legacy one (aws).
+func (r planResource) providerType() string {
+	name := r.ProviderName
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.TrimPrefix(name, "provider.")
+}
+
+// resources returns every resource known by the plan. Resources from the prior
+// state are returned first, followed by the resources the plan is about to
+// create. A resource is flagged as pending when it is about to be created or
+// destroyed, so that its absence from the remote is not reported as drift.
+func (p *planFile) resources() []plannedResource {
+	actions := make(map[string][]string, len(p.ResourceChanges))
+	for _, change := range p.ResourceChanges {
+		actions[change.Address] = change.Change.Actions
+	}
+
+	results := make([]plannedResource, 0)
+	known := make(map[string]struct{})
+
+	if p.PriorState != nil {
+		for _, res := range p.PriorState.Values.RootModule.flatten() {
+			known[res.Address] = struct{}{}
+			res.pending = isOnlyAction(actions[res.Address], actionDelete)
+			results = append(results, res)
+		}
+	}
+
+	for _, res := range p.PlannedValues.RootModule.flatten() {
+		if _, exists := known[res.Address]; exists {
+			continue
+		}
+		res.pending = true
+		results = append(results, res)
+	}
+
+	return results
+}
+
+func (m planModule) flatten() []plannedResource {
+	results := make([]plannedResource, 0, len(m.Resources))
+	for _, res := range m.Resources {
+		results = append(results, plannedResource{
+			planResource: res,
+			module:       m.Address,
+		})
+	}
+	for _, child := range m.ChildModules {
+		results = append(results, child.flatten()...)
+	}
+	return results
+}
+
+// isOnlyAction returns true when the only action planned is the given one.
+// Replacements are planned as a delete and a create, the resource exists
+// before and after the apply in that case.
+func isOnlyAction(actions []string, action string) bool {
+	return len(actions) == 1 && actions[0] == action
+}
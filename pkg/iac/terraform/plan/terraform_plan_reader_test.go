@@ -0,0 +1,90 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/output"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	resourceaws "github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/test/mocks"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewReader_UnsupportedBackend(t *testing.T) {
+	_, err := NewReader(config.SupplierConfig{Key: "tfplan", Backend: "s3", Path: "bucket/plan.json"}, nil, nil, nil, nil)
+	assert.EqualError(t, err, "Unsupported backend 's3' for tfplan, plans can only be read from a local file")
+}
+
+func TestTerraformPlanReader_Resources(t *testing.T) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Return().Times(1)
+
+	version := "3.62.0"
+
+	provider := mocks.NewMockedGoldenTFProvider("plan", terraform.AWS, version, nil, false)
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.AWS, provider)
+
+	repo := testresource.InitFakeSchemaRepository(terraform.AWS, version)
+	resourceaws.InitResourcesMetadata(repo)
+	factory := dctlresource.NewDriftctlResourceFactory(repo)
+
+	testFilter := &filter.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	r, err := NewReader(
+		config.SupplierConfig{Key: "tfplan", Path: "testdata/plan.json"},
+		library,
+		progress,
+		resource.NewDeserializer(factory),
+		testFilter,
+	)
+	assert.Nil(t, err)
+
+	got, err := r.Resources()
+	assert.Nil(t, err)
+	assert.Equal(t, uint(1), r.SourceCount())
+
+	sources := make(map[string]*resource.TerraformPlanSource, len(got))
+	for _, res := range got {
+		sources[res.ResourceId()] = res.Source.(*resource.TerraformPlanSource)
+	}
+
+	assert.Equal(t, map[string]*resource.TerraformPlanSource{
+		"driftctl-existing": resource.NewTerraformPlanSource("tfplan://testdata/plan.json", "", "existing", false),
+		"driftctl-replaced": resource.NewTerraformPlanSource("tfplan://testdata/plan.json", "", "replaced", false),
+		"driftctl-removed":  resource.NewTerraformPlanSource("tfplan://testdata/plan.json", "", "removed", true),
+		"driftctl-new":      resource.NewTerraformPlanSource("tfplan://testdata/plan.json", "module.users", "new", true),
+	}, sources)
+
+	for _, res := range got {
+		if res.ResourceId() == "driftctl-replaced" {
+			assert.Equal(t, "arn:aws:s3:::driftctl-replaced", *res.Attributes().GetString("arn"), "values should be read from the prior state")
+			_, exists := res.Attributes().Get("unknown_attribute")
+			assert.False(t, exists, "attributes unknown to the schema should be dropped")
+		}
+	}
+}
+
+func TestTerraformPlanReader_InvalidPlan(t *testing.T) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Return().Times(1)
+
+	r, err := NewReader(
+		config.SupplierConfig{Key: "tfplan", Path: "testdata/invalid.json"},
+		terraform.NewProviderLibrary(),
+		progress,
+		nil,
+		nil,
+	)
+	assert.Nil(t, err)
+
+	_, err = r.Resources()
+	assert.EqualError(t, err, "tfplan://testdata/invalid.json: given file is not a valid Terraform plan in JSON format")
+}
@@ -0,0 +1,222 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+)
+
+// LabeledAnalysis is an analysis whose metrics carry the given labels, e.g.
+// the profile it comes from
+type LabeledAnalysis struct {
+	Labels   map[string]string
+	Analysis *analyser.Analysis
+}
+
+type series struct {
+	labels map[string]string
+	value  float64
+}
+
+type family struct {
+	name    string
+	help    string
+	collect func(analysis *analyser.Analysis) []series
+}
+
+var families = []family{
+	{
+		name: "driftctl_resources",
+		help: "Number of resources found by the scan",
+		collect: func(a *analyser.Analysis) []series {
+			return single(float64(a.Summary().TotalResources))
+		},
+	},
+	{
+		name: "driftctl_managed_resources",
+		help: "Number of resources managed by IaC",
+		collect: func(a *analyser.Analysis) []series {
+			return single(float64(a.Summary().TotalManaged))
+		},
+	},
+	{
+		name: "driftctl_unmanaged_resources",
+		help: "Number of resources not managed by IaC",
+		collect: func(a *analyser.Analysis) []series {
+			return single(float64(a.Summary().TotalUnmanaged))
+		},
+	},
+	{
+		name: "driftctl_missing_resources",
+		help: "Number of resources managed by IaC but missing on the cloud provider",
+		collect: func(a *analyser.Analysis) []series {
+			return single(float64(a.Summary().TotalDeleted))
+		},
+	},
+	{
+		name: "driftctl_changed_resources",
+		help: "Number of managed resources that drifted from IaC",
+		collect: func(a *analyser.Analysis) []series {
+			return single(float64(a.Summary().TotalDrifted))
+		},
+	},
+	{
+		name: "driftctl_coverage_ratio",
+		help: "Ratio of resources managed by IaC",
+		collect: func(a *analyser.Analysis) []series {
+			return single(float64(a.Coverage()) / 100)
+		},
+	},
+	{
+		name: "driftctl_resources_by_type",
+		help: "Number of resources by type and state",
+		collect: func(a *analyser.Analysis) []series {
+			counts := make(map[[2]string]int)
+			count := func(state string, resources []*resource.Resource) {
+				for _, res := range resources {
+					counts[[2]string{res.ResourceType(), state}]++
+				}
+			}
+			count("managed", a.Managed())
+			count("unmanaged", a.Unmanaged())
+			count("missing", a.Deleted())
+			for _, diff := range a.Differences() {
+				counts[[2]string{diff.Res.ResourceType(), "changed"}]++
+			}
+
+			result := make([]series, 0, len(counts))
+			for key, value := range counts {
+				result = append(result, series{map[string]string{"type": key[0], "state": key[1]}, float64(value)})
+			}
+			return result
+		},
+	},
+	{
+		name: "driftctl_resources_by_source",
+		help: "Number of IaC resources by source and state",
+		collect: func(a *analyser.Analysis) []series {
+			counts := make(map[[2]string]int)
+			count := func(state string, res *resource.Resource) {
+				if res.Source == nil {
+					return
+				}
+				counts[[2]string{res.Source.Source(), state}]++
+			}
+			for _, res := range a.Managed() {
+				count("managed", res)
+			}
+			for _, res := range a.Deleted() {
+				count("missing", res)
+			}
+			for _, diff := range a.Differences() {
+				count("changed", diff.Res)
+			}
+
+			result := make([]series, 0, len(counts))
+			for key, value := range counts {
+				result = append(result, series{map[string]string{"source": key[0], "state": key[1]}, float64(value)})
+			}
+			return result
+		},
+	},
+	{
+		name: "driftctl_scan_duration_seconds",
+		help: "Duration of the scan",
+		collect: func(a *analyser.Analysis) []series {
+			return single(a.Duration.Seconds())
+		},
+	},
+	{
+		name: "driftctl_enumeration_duration_seconds",
+		help: "Time spent listing resources by type from the cloud provider",
+		collect: func(a *analyser.Analysis) []series {
+			result := make([]series, 0, len(a.EnumerationTimings))
+			for ty, duration := range a.EnumerationTimings {
+				result = append(result, series{map[string]string{"type": ty}, duration.Seconds()})
+			}
+			return result
+		},
+	},
+	{
+		name: "driftctl_scan_timestamp_seconds",
+		help: "Unix time the scan finished at",
+		collect: func(a *analyser.Analysis) []series {
+			if a.Date.IsZero() {
+				return nil
+			}
+			return single(float64(a.Date.UnixNano()) / 1e9)
+		},
+	},
+}
+
+func single(value float64) []series {
+	return []series{{value: value}}
+}
+
+// Write writes the metrics of the given analyses in the Prometheus text
+// exposition format. Every metric is a gauge, and series of a metric are
+// grouped under a single HELP and TYPE header as the format requires.
+func Write(w io.Writer, analyses ...LabeledAnalysis) error {
+	buf := bufio.NewWriter(w)
+
+	for _, f := range families {
+		lines := make([]string, 0)
+		for _, labeled := range analyses {
+			for _, s := range f.collect(labeled.Analysis) {
+				labels := make(map[string]string, len(labeled.Labels)+len(s.labels))
+				for k, v := range labeled.Labels {
+					labels[k] = v
+				}
+				for k, v := range s.labels {
+					labels[k] = v
+				}
+				lines = append(lines, fmt.Sprintf("%s%s %s", f.name, formatLabels(labels), formatValue(s.value)))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		sort.Strings(lines)
+
+		fmt.Fprintf(buf, "# HELP %s %s\n", f.name, f.help)
+		fmt.Fprintf(buf, "# TYPE %s gauge\n", f.name)
+		for _, line := range lines {
+			fmt.Fprintln(buf, line)
+		}
+	}
+
+	return buf.Flush()
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", name, escapeLabelValue(labels[name])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrite(t *testing.T) {
+	prod := analyser.NewAnalysis()
+	prod.AddManaged(&resource.Resource{Id: "bucket", Type: "aws_s3_bucket"})
+	prod.AddUnmanaged(&resource.Resource{Id: "user", Type: "aws_iam_user"})
+	prod.Duration = 90 * time.Second
+
+	staging := analyser.NewAnalysis()
+	staging.AddUnmanaged(&resource.Resource{Id: "user", Type: "aws_iam_user"})
+
+	var buf bytes.Buffer
+	err := Write(&buf,
+		LabeledAnalysis{Labels: map[string]string{"profile": "prod"}, Analysis: prod},
+		LabeledAnalysis{Labels: map[string]string{"profile": "staging \"eu\"\n"}, Analysis: staging},
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, `# HELP driftctl_resources Number of resources found by the scan
+# TYPE driftctl_resources gauge
+driftctl_resources{profile="prod"} 2
+driftctl_resources{profile="staging \"eu\"\n"} 1
+# HELP driftctl_managed_resources Number of resources managed by IaC
+# TYPE driftctl_managed_resources gauge
+driftctl_managed_resources{profile="prod"} 1
+driftctl_managed_resources{profile="staging \"eu\"\n"} 0
+# HELP driftctl_unmanaged_resources Number of resources not managed by IaC
+# TYPE driftctl_unmanaged_resources gauge
+driftctl_unmanaged_resources{profile="prod"} 1
+driftctl_unmanaged_resources{profile="staging \"eu\"\n"} 1
+# HELP driftctl_missing_resources Number of resources managed by IaC but missing on the cloud provider
+# TYPE driftctl_missing_resources gauge
+driftctl_missing_resources{profile="prod"} 0
+driftctl_missing_resources{profile="staging \"eu\"\n"} 0
+# HELP driftctl_changed_resources Number of managed resources that drifted from IaC
+# TYPE driftctl_changed_resources gauge
+driftctl_changed_resources{profile="prod"} 0
+driftctl_changed_resources{profile="staging \"eu\"\n"} 0
+# HELP driftctl_coverage_ratio Ratio of resources managed by IaC
+# TYPE driftctl_coverage_ratio gauge
+driftctl_coverage_ratio{profile="prod"} 0.5
+driftctl_coverage_ratio{profile="staging \"eu\"\n"} 0
+# HELP driftctl_resources_by_type Number of resources by type and state
+# TYPE driftctl_resources_by_type gauge
+driftctl_resources_by_type{profile="prod",state="managed",type="aws_s3_bucket"} 1
+driftctl_resources_by_type{profile="prod",state="unmanaged",type="aws_iam_user"} 1
+driftctl_resources_by_type{profile="staging \"eu\"\n",state="unmanaged",type="aws_iam_user"} 1
+# HELP driftctl_scan_duration_seconds Duration of the scan
+# TYPE driftctl_scan_duration_seconds gauge
+driftctl_scan_duration_seconds{profile="prod"} 90
+driftctl_scan_duration_seconds{profile="staging \"eu\"\n"} 0
+`, buf.String())
+}
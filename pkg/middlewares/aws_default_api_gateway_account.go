@@ -6,7 +6,8 @@ import (
 	"github.com/snyk/driftctl/pkg/resource/aws"
 )
 
-// AwsDefaultApiGatewayAccount is a middleware that ignores the default API Gateway account resource in the current region.
+// AwsDefaultApiGatewayAccount is a middleware that ignores the default API Gateway account resource of each region
+// unless it is managed by IaC in that region.
 type AwsDefaultApiGatewayAccount struct{}
 
 func NewAwsDefaultApiGatewayAccount() AwsDefaultApiGatewayAccount {
@@ -77,6 +77,39 @@ func TestAwsDefaultApiGatewayAccount_Execute(t *testing.T) {
 				},
 			},
 		},
+		{
+			"test that default account is only kept in the regions it is managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "us-east-1",
+				},
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "eu-west-3",
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "eu-west-3",
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "eu-west-3",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,69 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// Default vpc uses DHCP options created along with it that should not be seen as unmanaged if not managed by IaC
+// This middleware ignores default DHCP options from unmanaged resources if not managed by IaC
+type AwsDefaultVpcDhcpOptions struct{}
+
+func NewAwsDefaultVpcDhcpOptions() AwsDefaultVpcDhcpOptions {
+	return AwsDefaultVpcDhcpOptions{}
+}
+
+func (m AwsDefaultVpcDhcpOptions) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than DHCP options
+		if remoteResource.ResourceType() != aws.AwsVpcDhcpOptionsResourceType {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Ignore all DHCP options not used by the default vpc
+		if !isDefaultVpcDhcpOptions(remoteResource, remoteResources) {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Check if DHCP options are managed by IaC
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		// Include resource if it's managed in IaC
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Else, resource is not added to newRemoteResources slice so it will be ignored
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring default DHCP options as they are not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+// Return true if the DHCP options are used by the default vpc
+func isDefaultVpcDhcpOptions(dhcpOptions *resource.Resource, remoteResources *[]*resource.Resource) bool {
+	for _, remoteResource := range *remoteResources {
+		if remoteResource.ResourceType() == aws.AwsDefaultVpcResourceType {
+			dhcpOptionsId := remoteResource.Attrs.GetString("dhcp_options_id")
+			return dhcpOptionsId != nil && *dhcpOptionsId == dhcpOptions.ResourceId()
+		}
+	}
+	return false
+}
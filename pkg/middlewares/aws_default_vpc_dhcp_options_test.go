@@ -0,0 +1,116 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsDefaultVpcDhcpOptions_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			"default DHCP options are ignored when not managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:    "vpc-default",
+					Type:  aws.AwsDefaultVpcResourceType,
+					Attrs: &resource.Attributes{"dhcp_options_id": "dopt-default"},
+				},
+				{
+					Id:   "dopt-default",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+				{
+					Id:   "dopt-custom",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+			},
+			[]*resource.Resource{},
+			[]*resource.Resource{
+				{
+					Id:    "vpc-default",
+					Type:  aws.AwsDefaultVpcResourceType,
+					Attrs: &resource.Attributes{"dhcp_options_id": "dopt-default"},
+				},
+				{
+					Id:   "dopt-custom",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+			},
+		},
+		{
+			"default DHCP options are not ignored when managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:    "vpc-default",
+					Type:  aws.AwsDefaultVpcResourceType,
+					Attrs: &resource.Attributes{"dhcp_options_id": "dopt-default"},
+				},
+				{
+					Id:   "dopt-default",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:   "dopt-default",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:    "vpc-default",
+					Type:  aws.AwsDefaultVpcResourceType,
+					Attrs: &resource.Attributes{"dhcp_options_id": "dopt-default"},
+				},
+				{
+					Id:   "dopt-default",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+			},
+		},
+		{
+			"DHCP options are not ignored without default vpc",
+			[]*resource.Resource{
+				{
+					Id:   "dopt-default",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+			},
+			[]*resource.Resource{},
+			[]*resource.Resource{
+				{
+					Id:   "dopt-default",
+					Type: aws.AwsVpcDhcpOptionsResourceType,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsDefaultVpcDhcpOptions()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -19,11 +19,10 @@ func NewAwsEbsEncryptionByDefaultReconciler(resourceFactory resource.ResourceFac
 }
 
 func (m AwsEbsEncryptionByDefaultReconciler) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
-	newStateResources := make([]*resource.Resource, 0)
 	newRemoteResources := make([]*resource.Resource, 0)
 
-	var found bool
-	var defaultEbsEncryption *resource.Resource
+	// There is one default EBS encryption setting per region and account
+	defaultEbsEncryptions := make([]*resource.Resource, 0)
 
 	for _, res := range *remoteResources {
 		// Ignore all resources other than aws_ebs_encryption_by_default
@@ -31,40 +30,60 @@ func (m AwsEbsEncryptionByDefaultReconciler) Execute(remoteResources, resourcesF
 			newRemoteResources = append(newRemoteResources, res)
 			continue
 		}
-		defaultEbsEncryption = res
+		defaultEbsEncryptions = append(defaultEbsEncryptions, res)
 	}
 
 	// We can encounter this case when we don't have permission to get this setting from AWS.
-	if defaultEbsEncryption == nil {
+	if len(defaultEbsEncryptions) == 0 {
 		return nil
 	}
 
-	for _, res := range *resourcesFromState {
-		newStateResources = append(newStateResources, res)
+	found := make(map[*resource.Resource]bool, len(defaultEbsEncryptions))
 
+	for _, res := range *resourcesFromState {
 		// Ignore all resources other than aws_ebs_encryption_by_default
 		if res.ResourceType() != aws.AwsEbsEncryptionByDefaultResourceType {
 			continue
 		}
 
+		defaultEbsEncryption := m.findInSameLocation(defaultEbsEncryptions, found, res)
+		if defaultEbsEncryption == nil {
+			continue
+		}
+
 		// Create a new remote resource that will be similar to the state resource but with the 'enabled' attribute of the remote one.
 		// The reason why is that the id is a random string created by Terraform that we need to compare two resources.
-		newRemoteResources = append(newRemoteResources, m.resourceFactory.CreateAbstractResource(
+		// The account and region of the remote resource are kept so that it is only matched with the state resource.
+		newRemoteResource := m.resourceFactory.CreateAbstractResource(
 			res.ResourceType(),
 			res.ResourceId(),
 			map[string]interface{}{
 				"id":      res.ResourceId(),
 				"enabled": *defaultEbsEncryption.Attributes().GetBool("enabled"),
 			},
-		))
-		found = true
+		)
+		newRemoteResource.Account = defaultEbsEncryption.Account
+		newRemoteResource.Region = defaultEbsEncryption.Region
+		newRemoteResources = append(newRemoteResources, newRemoteResource)
+		found[defaultEbsEncryption] = true
 	}
 
-	if defaultEbsEncryption != nil && !found && *defaultEbsEncryption.Attributes().GetBool("enabled") {
-		newRemoteResources = append(newRemoteResources, defaultEbsEncryption)
+	for _, defaultEbsEncryption := range defaultEbsEncryptions {
+		if !found[defaultEbsEncryption] && *defaultEbsEncryption.Attributes().GetBool("enabled") {
+			newRemoteResources = append(newRemoteResources, defaultEbsEncryption)
+		}
 	}
 
-	*resourcesFromState = newStateResources
 	*remoteResources = newRemoteResources
 	return nil
 }
+
+// findInSameLocation returns the first remote setting not yet matched that is in the same account and region as the state resource
+func (m AwsEbsEncryptionByDefaultReconciler) findInSameLocation(defaultEbsEncryptions []*resource.Resource, found map[*resource.Resource]bool, stateResource *resource.Resource) *resource.Resource {
+	for _, defaultEbsEncryption := range defaultEbsEncryptions {
+		if !found[defaultEbsEncryption] && defaultEbsEncryption.SameLocation(stateResource) {
+			return defaultEbsEncryption
+		}
+	}
+	return nil
+}
@@ -239,6 +239,85 @@ func TestAwsEbsEncryptionByDefaultReconciler_Execute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test encryption by default is matched in its region on multi-region scans",
+			mocks: func(factory *dctlresource.MockResourceFactory) {
+				factory.On("CreateAbstractResource",
+					aws.AwsEbsEncryptionByDefaultResourceType,
+					"terraform-20220328091515068500000001",
+					map[string]interface{}{
+						"id":      "terraform-20220328091515068500000001",
+						"enabled": false,
+					}).Return(&resource.Resource{
+					Id:   "terraform-20220328091515068500000001",
+					Type: aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs: &resource.Attributes{
+						"id":      "terraform-20220328091515068500000001",
+						"enabled": false,
+					},
+					Region: "eu-west-3",
+				}).Once()
+			},
+			remoteResources: []*resource.Resource{
+				{
+					Id:   "ebs_encryption_default",
+					Type: aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs: &resource.Attributes{
+						"enabled": true,
+					},
+					Region: "us-east-1",
+				},
+				{
+					Id:   "ebs_encryption_default",
+					Type: aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs: &resource.Attributes{
+						"enabled": false,
+					},
+					Region: "eu-west-3",
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "terraform-20220328091515068500000001",
+					Type: aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs: &resource.Attributes{
+						"id":      "terraform-20220328091515068500000001",
+						"enabled": true,
+					},
+					Region: "eu-west-3",
+				},
+			},
+			expectedRemoteResources: []*resource.Resource{
+				{
+					Id:   "terraform-20220328091515068500000001",
+					Type: aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs: &resource.Attributes{
+						"id":      "terraform-20220328091515068500000001",
+						"enabled": false,
+					},
+					Region: "eu-west-3",
+				},
+				{
+					Id:   "ebs_encryption_default",
+					Type: aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs: &resource.Attributes{
+						"enabled": true,
+					},
+					Region: "us-east-1",
+				},
+			},
+			expectedStateResources: []*resource.Resource{
+				{
+					Id:   "terraform-20220328091515068500000001",
+					Type: aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs: &resource.Attributes{
+						"id":      "terraform-20220328091515068500000001",
+						"enabled": true,
+					},
+					Region: "eu-west-3",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
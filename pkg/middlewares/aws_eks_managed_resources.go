@@ -0,0 +1,99 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+	remoteaws "github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// EKS creates a security group for each cluster, and a launch template and autoscaling groups for each
+// managed node group. This middleware ignores those resources, and the rules of the cluster security group,
+// from unmanaged resources if not managed by IaC
+type AwsEksManagedResources struct{}
+
+func NewAwsEksManagedResources() AwsEksManagedResources {
+	return AwsEksManagedResources{}
+}
+
+func (m AwsEksManagedResources) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	securityGroups := map[string]struct{}{}
+	launchTemplates := map[string]struct{}{}
+	autoscalingGroups := map[string]struct{}{}
+	for _, remoteResource := range *remoteResources {
+		switch remoteResource.ResourceType() {
+		case aws.AwsEksClusterResourceType:
+			if id := remoteResource.Attrs.GetString(remoteaws.EksClusterSecurityGroupAttribute); id != nil {
+				securityGroups[*id] = struct{}{}
+			}
+		case aws.AwsEksNodeGroupResourceType:
+			if name := remoteResource.Attrs.GetString(remoteaws.EksNodeGroupLaunchTemplateAttribute); name != nil {
+				launchTemplates[*name] = struct{}{}
+			}
+			for _, name := range remoteResource.Attrs.GetSlice(remoteaws.EksNodeGroupAutoscalingGroupsAttribute) {
+				autoscalingGroups[name.(string)] = struct{}{}
+			}
+		}
+	}
+
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+
+	for _, remoteResource := range *remoteResources {
+		if !isEksManagedResource(remoteResource, securityGroups, launchTemplates, autoscalingGroups) {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Check if resource is managed by IaC
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		// Include resource if it's managed in IaC
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Else, resource is not added to newRemoteResources slice so it will be ignored
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring EKS managed resource as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+// Return true if the resource has been created by EKS for a cluster or a node group
+func isEksManagedResource(res *resource.Resource, securityGroups, launchTemplates, autoscalingGroups map[string]struct{}) bool {
+	switch res.ResourceType() {
+	case aws.AwsSecurityGroupResourceType:
+		_, exist := securityGroups[res.ResourceId()]
+		return exist
+	case aws.AwsSecurityGroupRuleResourceType:
+		id := res.Attrs.GetString("security_group_id")
+		if id == nil {
+			return false
+		}
+		_, exist := securityGroups[*id]
+		return exist
+	case aws.AwsLaunchTemplateResourceType:
+		name := res.Attrs.GetString("name")
+		if name == nil {
+			return false
+		}
+		_, exist := launchTemplates[*name]
+		return exist
+	case aws.AwsAutoscalingGroupResourceType:
+		_, exist := autoscalingGroups[res.ResourceId()]
+		return exist
+	}
+	return false
+}
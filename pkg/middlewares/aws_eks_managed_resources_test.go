@@ -0,0 +1,163 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	remoteaws "github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsEksManagedResources_Execute(t *testing.T) {
+	cluster := &resource.Resource{
+		Id:   "foo",
+		Type: aws.AwsEksClusterResourceType,
+		Attrs: &resource.Attributes{
+			"name": "foo",
+			remoteaws.EksClusterSecurityGroupAttribute: "sg-eks",
+		},
+	}
+	nodeGroup := &resource.Resource{
+		Id:   "foo:workers",
+		Type: aws.AwsEksNodeGroupResourceType,
+		Attrs: &resource.Attributes{
+			"cluster_name":    "foo",
+			"node_group_name": "workers",
+			remoteaws.EksNodeGroupLaunchTemplateAttribute:    "eks-56c0a3c4",
+			remoteaws.EksNodeGroupAutoscalingGroupsAttribute: []interface{}{"eks-workers-56c0a3c4"},
+		},
+	}
+	clusterSecurityGroup := &resource.Resource{
+		Id:    "sg-eks",
+		Type:  aws.AwsSecurityGroupResourceType,
+		Attrs: &resource.Attributes{},
+	}
+	clusterSecurityGroupRule := &resource.Resource{
+		Id:   "sgrule-eks",
+		Type: aws.AwsSecurityGroupRuleResourceType,
+		Attrs: &resource.Attributes{
+			"security_group_id": "sg-eks",
+		},
+	}
+	nodeGroupLaunchTemplate := &resource.Resource{
+		Id:   "lt-eks",
+		Type: aws.AwsLaunchTemplateResourceType,
+		Attrs: &resource.Attributes{
+			"name": "eks-56c0a3c4",
+		},
+	}
+	nodeGroupAutoscalingGroup := &resource.Resource{
+		Id:    "eks-workers-56c0a3c4",
+		Type:  aws.AwsAutoscalingGroupResourceType,
+		Attrs: &resource.Attributes{},
+	}
+	securityGroup := &resource.Resource{
+		Id:    "sg-web",
+		Type:  aws.AwsSecurityGroupResourceType,
+		Attrs: &resource.Attributes{},
+	}
+	securityGroupRule := &resource.Resource{
+		Id:   "sgrule-web",
+		Type: aws.AwsSecurityGroupRuleResourceType,
+		Attrs: &resource.Attributes{
+			"security_group_id": "sg-web",
+		},
+	}
+	launchTemplate := &resource.Resource{
+		Id:   "lt-web",
+		Type: aws.AwsLaunchTemplateResourceType,
+		Attrs: &resource.Attributes{
+			"name": "web",
+		},
+	}
+	autoscalingGroup := &resource.Resource{
+		Id:    "web",
+		Type:  aws.AwsAutoscalingGroupResourceType,
+		Attrs: &resource.Attributes{},
+	}
+
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			"eks managed resources are ignored when not managed by IaC",
+			[]*resource.Resource{
+				cluster,
+				nodeGroup,
+				clusterSecurityGroup,
+				clusterSecurityGroupRule,
+				nodeGroupLaunchTemplate,
+				nodeGroupAutoscalingGroup,
+				securityGroup,
+				securityGroupRule,
+				launchTemplate,
+				autoscalingGroup,
+			},
+			[]*resource.Resource{},
+			[]*resource.Resource{
+				cluster,
+				nodeGroup,
+				securityGroup,
+				securityGroupRule,
+				launchTemplate,
+				autoscalingGroup,
+			},
+		},
+		{
+			"eks managed resources are not ignored when managed by IaC",
+			[]*resource.Resource{
+				cluster,
+				nodeGroup,
+				clusterSecurityGroup,
+				clusterSecurityGroupRule,
+				nodeGroupLaunchTemplate,
+				nodeGroupAutoscalingGroup,
+			},
+			[]*resource.Resource{
+				{
+					Id:   "sg-eks",
+					Type: aws.AwsSecurityGroupResourceType,
+				},
+				{
+					Id:   "lt-eks",
+					Type: aws.AwsLaunchTemplateResourceType,
+				},
+				{
+					Id:   "eks-workers-56c0a3c4",
+					Type: aws.AwsAutoscalingGroupResourceType,
+				},
+			},
+			[]*resource.Resource{
+				cluster,
+				nodeGroup,
+				clusterSecurityGroup,
+				nodeGroupLaunchTemplate,
+				nodeGroupAutoscalingGroup,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsEksManagedResources()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
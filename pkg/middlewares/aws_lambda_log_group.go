@@ -0,0 +1,86 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+const lambdaLogGroupPrefix = "/aws/lambda/"
+
+// Lambda creates a log group named /aws/lambda/<function> the first time a function runs
+// This middleware ignores those log groups from unmanaged resources when the function is managed by IaC
+type AwsLambdaLogGroup struct{}
+
+func NewAwsLambdaLogGroup() AwsLambdaLogGroup {
+	return AwsLambdaLogGroup{}
+}
+
+func (m AwsLambdaLogGroup) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	functions := map[string]struct{}{}
+	for _, stateResource := range *resourcesFromState {
+		if stateResource.ResourceType() == aws.AwsLambdaFunctionResourceType {
+			functions[stateResource.ResourceId()] = struct{}{}
+		}
+	}
+
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than log groups
+		if remoteResource.ResourceType() != aws.AwsCloudwatchLogGroupResourceType {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Ignore all log groups not created for a managed function
+		if !isLambdaLogGroup(remoteResource.ResourceId(), functions) {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Check if log group is managed by IaC
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		// Include resource if it's managed in IaC
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Else, resource is not added to newRemoteResources slice so it will be ignored
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring lambda log group as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
+
+// Return true if the log group has been created by Lambda for one of the given functions.
+// Log groups of Lambda@Edge replicas are prefixed with the region of the function, e.g. /aws/lambda/us-east-1.<function>
+func isLambdaLogGroup(name string, functions map[string]struct{}) bool {
+	if !strings.HasPrefix(name, lambdaLogGroupPrefix) {
+		return false
+	}
+	function := strings.TrimPrefix(name, lambdaLogGroupPrefix)
+	if _, exist := functions[function]; exist {
+		return true
+	}
+	if i := strings.Index(function, "."); i >= 0 {
+		_, exist := functions[function[i+1:]]
+		return exist
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsLambdaLogGroup_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			"lambda log groups are ignored when the function is managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:   "foo",
+					Type: aws.AwsLambdaFunctionResourceType,
+				},
+				{
+					Id:   "/aws/lambda/foo",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+				{
+					Id:   "/aws/lambda/us-east-1.foo",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+				{
+					Id:   "/aws/lambda/bar",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+				{
+					Id:   "/ecs/foo",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:   "foo",
+					Type: aws.AwsLambdaFunctionResourceType,
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:   "foo",
+					Type: aws.AwsLambdaFunctionResourceType,
+				},
+				{
+					Id:   "/aws/lambda/bar",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+				{
+					Id:   "/ecs/foo",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+			},
+		},
+		{
+			"lambda log groups are not ignored when managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:   "foo",
+					Type: aws.AwsLambdaFunctionResourceType,
+				},
+				{
+					Id:   "/aws/lambda/foo",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:   "foo",
+					Type: aws.AwsLambdaFunctionResourceType,
+				},
+				{
+					Id:   "/aws/lambda/foo",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:   "foo",
+					Type: aws.AwsLambdaFunctionResourceType,
+				},
+				{
+					Id:   "/aws/lambda/foo",
+					Type: aws.AwsCloudwatchLogGroupResourceType,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsLambdaLogGroup()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
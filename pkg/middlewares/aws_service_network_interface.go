@@ -0,0 +1,61 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+	remoteaws "github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// Load balancers, Lambda functions, NAT gateways and instances create network interfaces on their own
+// This middleware ignores those network interfaces from unmanaged resources if not managed by IaC
+type AwsServiceNetworkInterface struct{}
+
+func NewAwsServiceNetworkInterface() AwsServiceNetworkInterface {
+	return AwsServiceNetworkInterface{}
+}
+
+func (m AwsServiceNetworkInterface) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than network interfaces
+		if remoteResource.ResourceType() != aws.AwsNetworkInterfaceResourceType {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Ignore all standalone network interfaces
+		managedBy := remoteResource.Attrs.GetString(remoteaws.NetworkInterfaceManagedByAttribute)
+		if managedBy == nil {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Check if network interface is managed by IaC
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		// Include resource if it's managed in IaC
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Else, resource is not added to newRemoteResources slice so it will be ignored
+		logrus.WithFields(logrus.Fields{
+			"id":         remoteResource.ResourceId(),
+			"type":       remoteResource.ResourceType(),
+			"managed_by": *managedBy,
+		}).Debug("Ignoring service network interface as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsServiceNetworkInterface_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			"service network interfaces are ignored when not managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:    "eni-standalone",
+					Type:  aws.AwsNetworkInterfaceResourceType,
+					Attrs: &resource.Attributes{"subnet_id": "subnet-1"},
+				},
+				{
+					Id:    "eni-elb",
+					Type:  aws.AwsNetworkInterfaceResourceType,
+					Attrs: &resource.Attributes{"subnet_id": "subnet-1", "eni_managed_by": "amazon-elb"},
+				},
+				{
+					Id:    "eni-nat",
+					Type:  aws.AwsNetworkInterfaceResourceType,
+					Attrs: &resource.Attributes{"subnet_id": "subnet-1", "eni_managed_by": "natGateway"},
+				},
+				{
+					Id:   "subnet-1",
+					Type: aws.AwsSubnetResourceType,
+				},
+			},
+			[]*resource.Resource{},
+			[]*resource.Resource{
+				{
+					Id:    "eni-standalone",
+					Type:  aws.AwsNetworkInterfaceResourceType,
+					Attrs: &resource.Attributes{"subnet_id": "subnet-1"},
+				},
+				{
+					Id:   "subnet-1",
+					Type: aws.AwsSubnetResourceType,
+				},
+			},
+		},
+		{
+			"service network interfaces are not ignored when managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:    "eni-instance",
+					Type:  aws.AwsNetworkInterfaceResourceType,
+					Attrs: &resource.Attributes{"subnet_id": "subnet-1", "eni_managed_by": "instance"},
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:    "eni-instance",
+					Type:  aws.AwsNetworkInterfaceResourceType,
+					Attrs: &resource.Attributes{"subnet_id": "subnet-1"},
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:    "eni-instance",
+					Type:  aws.AwsNetworkInterfaceResourceType,
+					Attrs: &resource.Attributes{"subnet_id": "subnet-1", "eni_managed_by": "instance"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsServiceNetworkInterface()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package middlewares
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// AwsStateAccountTagger tags resources from IaC with the AWS account they belong to when several accounts are scanned,
+// so that they are only compared with remote resources of the same account.
+// Terraform states do not record the account they have been applied to, so the account of a state is either
+// given by the user, or found from the ARNs of its resources. The scan fails when ARNs of a state belong to
+// several accounts, or when a state holding AWS resources has no ARN at all.
+type AwsStateAccountTagger struct {
+	// stateAccounts maps states, or glob patterns matching states, to their AWS account
+	stateAccounts map[string]string
+}
+
+func NewAwsStateAccountTagger(stateAccounts map[string]string) AwsStateAccountTagger {
+	return AwsStateAccountTagger{stateAccounts: stateAccounts}
+}
+
+func (m AwsStateAccountTagger) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	scannedAccounts := map[string]struct{}{}
+	for _, remoteResource := range *remoteResources {
+		if accountId := remoteResource.Account; accountId != "" {
+			scannedAccounts[accountId] = struct{}{}
+		}
+	}
+
+	// Remote resources are only tagged with their account on multi-account scans
+	if len(scannedAccounts) == 0 {
+		return nil
+	}
+
+	// Collect accounts found in ARNs of each state holding AWS resources
+	arnAccounts := map[string]map[string]struct{}{}
+	for _, stateResource := range *resourcesFromState {
+		if stateResource.Src() == nil || !strings.HasPrefix(stateResource.ResourceType(), "aws_") {
+			continue
+		}
+		state := stateResource.Src().Source()
+		if arnAccounts[state] == nil {
+			arnAccounts[state] = map[string]struct{}{}
+		}
+		if stateResource.Attrs == nil {
+			continue
+		}
+		resourceArn := stateResource.Attrs.GetString("arn")
+		if resourceArn == nil {
+			continue
+		}
+		parsedArn, err := arn.Parse(*resourceArn)
+		// ARNs of some resources, e.g. S3 buckets, do not hold any account
+		if err != nil || parsedArn.AccountID == "" {
+			continue
+		}
+		arnAccounts[state][parsedArn.AccountID] = struct{}{}
+	}
+
+	stateAccounts := make(map[string]string, len(arnAccounts))
+	for state, accounts := range arnAccounts {
+		accountId, err := m.stateAccount(state, accounts)
+		if err != nil {
+			return err
+		}
+		if _, scanned := scannedAccounts[accountId]; !scanned {
+			return errors.Errorf("state %s belongs to AWS account %s which is not scanned", state, accountId)
+		}
+		stateAccounts[state] = accountId
+		logrus.WithFields(logrus.Fields{
+			"state":   state,
+			"account": accountId,
+		}).Debug("Found AWS account of state")
+	}
+
+	for _, stateResource := range *resourcesFromState {
+		if stateResource.Src() == nil || stateResource.Account != "" {
+			continue
+		}
+		accountId, found := stateAccounts[stateResource.Src().Source()]
+		if !found {
+			continue
+		}
+		stateResource.Account = accountId
+	}
+
+	return nil
+}
+
+// stateAccount returns the account given by the user for the state, or the single account found in its ARNs
+func (m AwsStateAccountTagger) stateAccount(state string, arnAccounts map[string]struct{}) (string, error) {
+	if accountId, found := m.stateAccounts[state]; found {
+		return accountId, nil
+	}
+	patterns := make([]string, 0, len(m.stateAccounts))
+	for pattern := range m.stateAccounts {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, state); matched {
+			return m.stateAccounts[pattern], nil
+		}
+	}
+
+	switch len(arnAccounts) {
+	case 0:
+		return "", errors.Errorf("unable to find the AWS account of state %s as none of its resources has an ARN, "+
+			"use --aws-state-accounts to set it", state)
+	case 1:
+		for accountId := range arnAccounts {
+			return accountId, nil
+		}
+	}
+
+	accounts := make([]string, 0, len(arnAccounts))
+	for accountId := range arnAccounts {
+		accounts = append(accounts, accountId)
+	}
+	sort.Strings(accounts)
+	return "", errors.Errorf("unable to find the AWS account of state %s as its resources belong to accounts %s, "+
+		"use --aws-state-accounts to set it", state, strings.Join(accounts, ", "))
+}
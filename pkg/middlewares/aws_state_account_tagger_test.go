@@ -0,0 +1,273 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwsStateAccountTagger_Execute(t *testing.T) {
+	stateA := resource.NewTerraformStateSource("a.tfstate", "", "bucket")
+	stateB := resource.NewTerraformStateSource("b.tfstate", "", "role")
+	stateC := resource.NewTerraformStateSource("tfstate+s3://states/c.tfstate", "", "dns")
+
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		stateAccounts      map[string]string
+		expected           []*resource.Resource
+		wantErr            string
+	}{
+		{
+			name: "test state resources are not tagged on single account scans",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "bucket",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "bucket",
+					Type: aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:s3:::bucket",
+					},
+					Source: stateA,
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:   "bucket",
+					Type: aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:s3:::bucket",
+					},
+					Source: stateA,
+				},
+			},
+		},
+		{
+			name: "test state resources are tagged with the account of their ARNs or the one given for their state",
+			remoteResources: []*resource.Resource{
+				{
+					Id:      "role",
+					Type:    aws.AwsIamRoleResourceType,
+					Attrs:   &resource.Attributes{},
+					Account: "111111111111",
+				},
+				{
+					Id:      "role",
+					Type:    aws.AwsIamRoleResourceType,
+					Attrs:   &resource.Attributes{},
+					Account: "222222222222",
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:     "bucket",
+					Type:   aws.AwsS3BucketResourceType,
+					Attrs:  &resource.Attributes{},
+					Source: stateA,
+				},
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::111111111111:role/role",
+					},
+					Source: stateA,
+				},
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::222222222222:role/role",
+					},
+					Source: stateB,
+				},
+				{
+					Id:   "policy",
+					Type: aws.AwsIamPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::222222222222:policy/policy",
+					},
+					Source: stateB,
+				},
+				{
+					Id:   "record",
+					Type: aws.AwsRoute53RecordResourceType,
+					Attrs: &resource.Attributes{
+						"name": "foo.example.com",
+					},
+					Source: stateC,
+				},
+				{
+					Id:   "unknown-source",
+					Type: aws.AwsIamPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::111111111111:policy/unknown-source",
+					},
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:      "bucket",
+					Type:    aws.AwsS3BucketResourceType,
+					Attrs:   &resource.Attributes{},
+					Account: "111111111111",
+					Source:  stateA,
+				},
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::111111111111:role/role",
+					},
+					Account: "111111111111",
+					Source:  stateA,
+				},
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::222222222222:role/role",
+					},
+					Account: "222222222222",
+					Source:  stateB,
+				},
+				{
+					Id:   "policy",
+					Type: aws.AwsIamPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::222222222222:policy/policy",
+					},
+					Account: "222222222222",
+					Source:  stateB,
+				},
+				{
+					Id:   "record",
+					Type: aws.AwsRoute53RecordResourceType,
+					Attrs: &resource.Attributes{
+						"name": "foo.example.com",
+					},
+					Account: "222222222222",
+					Source:  stateC,
+				},
+				{
+					Id:   "unknown-source",
+					Type: aws.AwsIamPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::111111111111:policy/unknown-source",
+					},
+				},
+			},
+			stateAccounts: map[string]string{
+				"tfstate+s3://states/*": "222222222222",
+			},
+		},
+		{
+			name: "test state spanning several accounts",
+			remoteResources: []*resource.Resource{
+				{
+					Id:      "role",
+					Type:    aws.AwsIamRoleResourceType,
+					Attrs:   &resource.Attributes{},
+					Account: "111111111111",
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::222222222222:role/role",
+					},
+					Source: stateB,
+				},
+				{
+					Id:   "policy",
+					Type: aws.AwsIamPolicyResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::111111111111:policy/policy",
+					},
+					Source: stateB,
+				},
+			},
+			wantErr: "unable to find the AWS account of state b.tfstate as its resources belong to accounts 111111111111, 222222222222, use --aws-state-accounts to set it",
+		},
+		{
+			name: "test state without ARN",
+			remoteResources: []*resource.Resource{
+				{
+					Id:      "role",
+					Type:    aws.AwsIamRoleResourceType,
+					Attrs:   &resource.Attributes{},
+					Account: "111111111111",
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "record",
+					Type: aws.AwsRoute53RecordResourceType,
+					Attrs: &resource.Attributes{
+						"name": "foo.example.com",
+					},
+					Source: stateC,
+				},
+			},
+			wantErr: "unable to find the AWS account of state tfstate+s3://states/c.tfstate as none of its resources has an ARN, use --aws-state-accounts to set it",
+		},
+		{
+			name: "test state of an account that is not scanned",
+			remoteResources: []*resource.Resource{
+				{
+					Id:      "role",
+					Type:    aws.AwsIamRoleResourceType,
+					Attrs:   &resource.Attributes{},
+					Account: "111111111111",
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::333333333333:role/role",
+					},
+					Source: stateB,
+				},
+			},
+			wantErr: "state b.tfstate belongs to AWS account 333333333333 which is not scanned",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middleware := NewAwsStateAccountTagger(tt.stateAccounts)
+			err := middleware.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// AwsStateRegionTagger tags resources from IaC with the AWS region they belong to when several regions are scanned,
+// so that per-region resources sharing the same id, e.g. 'aws_ebs_encryption_by_default' or 'aws_api_gateway_account',
+// are only compared with remote resources of the same region.
+// The region of a resource is taken from its ARN. Resources without ARN get the region of their state when all
+// regional ARNs of that state belong to the same region. Resources with a global ARN are left untagged.
+type AwsStateRegionTagger struct{}
+
+func NewAwsStateRegionTagger() AwsStateRegionTagger {
+	return AwsStateRegionTagger{}
+}
+
+func (m AwsStateRegionTagger) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	scannedRegions := map[string]struct{}{}
+	for _, remoteResource := range *remoteResources {
+		if region := remoteResource.Region; region != "" {
+			scannedRegions[region] = struct{}{}
+		}
+	}
+
+	// Resources sharing the same id in different regions can only exist on multi-region scans
+	if len(scannedRegions) < 2 {
+		return nil
+	}
+
+	// Collect regions found in ARNs of each state holding AWS resources
+	arnRegions := map[string]map[string]struct{}{}
+	for _, stateResource := range *resourcesFromState {
+		if !m.isAwsStateResource(stateResource) {
+			continue
+		}
+		state := stateResource.Src().Source()
+		if arnRegions[state] == nil {
+			arnRegions[state] = map[string]struct{}{}
+		}
+		if parsedArn, ok := m.arnOf(stateResource); ok && parsedArn.Region != "" {
+			arnRegions[state][parsedArn.Region] = struct{}{}
+		}
+	}
+
+	for _, stateResource := range *resourcesFromState {
+		if !m.isAwsStateResource(stateResource) || stateResource.Region != "" {
+			continue
+		}
+
+		region := ""
+		if parsedArn, ok := m.arnOf(stateResource); ok {
+			// Resources with a global ARN, e.g. IAM ones, do not belong to any region
+			region = parsedArn.Region
+		} else if regions := arnRegions[stateResource.Src().Source()]; len(regions) == 1 {
+			for r := range regions {
+				region = r
+			}
+		}
+		if region == "" {
+			continue
+		}
+
+		stateResource.Region = region
+		logrus.WithFields(logrus.Fields{
+			"id":     stateResource.ResourceId(),
+			"type":   stateResource.ResourceType(),
+			"region": region,
+		}).Debug("Found AWS region of state resource")
+	}
+
+	return nil
+}
+
+func (m AwsStateRegionTagger) isAwsStateResource(res *resource.Resource) bool {
+	return res.Src() != nil && strings.HasPrefix(res.ResourceType(), "aws_")
+}
+
+func (m AwsStateRegionTagger) arnOf(res *resource.Resource) (arn.ARN, bool) {
+	if res.Attrs == nil {
+		return arn.ARN{}, false
+	}
+	resourceArn := res.Attrs.GetString("arn")
+	if resourceArn == nil {
+		return arn.ARN{}, false
+	}
+	parsedArn, err := arn.Parse(*resourceArn)
+	if err != nil {
+		return arn.ARN{}, false
+	}
+	return parsedArn, true
+}
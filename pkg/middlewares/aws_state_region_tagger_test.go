@@ -0,0 +1,196 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsStateRegionTagger_Execute(t *testing.T) {
+	stateA := resource.NewTerraformStateSource("a.tfstate", "", "queue")
+	stateB := resource.NewTerraformStateSource("b.tfstate", "", "queue")
+
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			name: "test state resources are not tagged on single region scans",
+			remoteResources: []*resource.Resource{
+				{
+					Id:     "ebs_encryption_default",
+					Type:   aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "us-east-1",
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:     "terraform-20220101",
+					Type:   aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs:  &resource.Attributes{},
+					Source: stateA,
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:     "terraform-20220101",
+					Type:   aws.AwsEbsEncryptionByDefaultResourceType,
+					Attrs:  &resource.Attributes{},
+					Source: stateA,
+				},
+			},
+		},
+		{
+			name: "test state resources are tagged with the region of their ARN or the one of their state",
+			remoteResources: []*resource.Resource{
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "us-east-1",
+				},
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "eu-west-3",
+				},
+			},
+			resourcesFromState: []*resource.Resource{
+				{
+					Id:   "queue",
+					Type: aws.AwsSqsQueueResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:sqs:eu-west-3:123456789012:queue",
+					},
+					Source: stateA,
+				},
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Source: stateA,
+				},
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::123456789012:role/role",
+					},
+					Source: stateA,
+				},
+				{
+					Id:   "queue",
+					Type: aws.AwsSqsQueueResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:sqs:us-east-1:123456789012:queue",
+					},
+					Source: stateB,
+				},
+				{
+					Id:   "queue2",
+					Type: aws.AwsSqsQueueResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:sqs:eu-west-3:123456789012:queue2",
+					},
+					Source: stateB,
+				},
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Source: stateB,
+				},
+				{
+					Id:     "bucket",
+					Type:   aws.AwsS3BucketResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "eu-west-1",
+					Source: stateB,
+				},
+			},
+			expected: []*resource.Resource{
+				{
+					Id:   "queue",
+					Type: aws.AwsSqsQueueResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:sqs:eu-west-3:123456789012:queue",
+					},
+					Region: "eu-west-3",
+					Source: stateA,
+				},
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "eu-west-3",
+					Source: stateA,
+				},
+				{
+					Id:   "role",
+					Type: aws.AwsIamRoleResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:iam::123456789012:role/role",
+					},
+					Source: stateA,
+				},
+				{
+					Id:   "queue",
+					Type: aws.AwsSqsQueueResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:sqs:us-east-1:123456789012:queue",
+					},
+					Region: "us-east-1",
+					Source: stateB,
+				},
+				{
+					Id:   "queue2",
+					Type: aws.AwsSqsQueueResourceType,
+					Attrs: &resource.Attributes{
+						"arn": "arn:aws:sqs:eu-west-3:123456789012:queue2",
+					},
+					Region: "eu-west-3",
+					Source: stateB,
+				},
+				{
+					Id:     "api-gateway-account",
+					Type:   aws.AwsApiGatewayAccountResourceType,
+					Attrs:  &resource.Attributes{},
+					Source: stateB,
+				},
+				{
+					Id:     "bucket",
+					Type:   aws.AwsS3BucketResourceType,
+					Attrs:  &resource.Attributes{},
+					Region: "eu-west-1",
+					Source: stateB,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middleware := NewAwsStateRegionTagger()
+			err := middleware.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
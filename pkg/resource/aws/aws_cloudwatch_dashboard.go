@@ -0,0 +1,3 @@
+package aws
+
+const AwsCloudwatchDashboardResourceType = "aws_cloudwatch_dashboard"
@@ -0,0 +1,16 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchEventRuleResourceType = "aws_cloudwatch_event_rule"
+
+func initAwsCloudwatchEventRuleMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.UpdateSchema(AwsCloudwatchEventRuleResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"event_pattern": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+}
@@ -0,0 +1,3 @@
+package aws
+
+const AwsCloudwatchEventTargetResourceType = "aws_cloudwatch_event_target"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsEc2TransitGatewayResourceType = "aws_ec2_transit_gateway"
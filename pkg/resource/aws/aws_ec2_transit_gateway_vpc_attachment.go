@@ -0,0 +1,3 @@
+package aws
+
+const AwsEc2TransitGatewayVpcAttachmentResourceType = "aws_ec2_transit_gateway_vpc_attachment"
@@ -0,0 +1,21 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEcsClusterResourceType = "aws_ecs_cluster"
+
+func initAwsEcsClusterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	// Providers older than 3.74.0 only know the capacity providers of a cluster through its attributes
+	_, hasCapacityProvidersResource := resourceSchemaRepository.GetSchema(AwsEcsClusterCapacityProvidersResourceType)
+	resourceSchemaRepository.SetNormalizeFunc(AwsEcsClusterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		if hasCapacityProvidersResource {
+			// Capacity providers are compared through aws_ecs_cluster_capacity_providers
+			val.SafeDelete([]string{"capacity_providers"})
+			val.SafeDelete([]string{"default_capacity_provider_strategy"})
+		}
+	})
+}
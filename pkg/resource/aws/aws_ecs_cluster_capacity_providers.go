@@ -0,0 +1,3 @@
+package aws
+
+const AwsEcsClusterCapacityProvidersResourceType = "aws_ecs_cluster_capacity_providers"
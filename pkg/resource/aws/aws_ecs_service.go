@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEcsServiceResourceType = "aws_ecs_service"
+
+func initAwsEcsServiceMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEcsServiceResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"force_new_deployment"})
+		val.SafeDelete([]string{"wait_for_steady_state"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+}
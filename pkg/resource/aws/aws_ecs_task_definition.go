@@ -0,0 +1,15 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEcsTaskDefinitionResourceType = "aws_ecs_task_definition"
+
+func initAwsEcsTaskDefinitionMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEcsTaskDefinitionResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"skip_destroy"})
+	})
+}
@@ -0,0 +1,16 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEksAddonResourceType = "aws_eks_addon"
+
+func initAwsEksAddonMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksAddonResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"resolve_conflicts"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+}
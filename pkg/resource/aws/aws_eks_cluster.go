@@ -0,0 +1,15 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEksClusterResourceType = "aws_eks_cluster"
+
+func initAwsEksClusterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksClusterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+	})
+}
@@ -0,0 +1,15 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEksFargateProfileResourceType = "aws_eks_fargate_profile"
+
+func initAwsEksFargateProfileMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksFargateProfileResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+	})
+}
@@ -0,0 +1,23 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSecretsManagerSecretResourceType = "aws_secretsmanager_secret"
+
+func initAwsSecretsManagerSecretMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSecretsManagerSecretResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"recovery_window_in_days"})
+		val.SafeDelete([]string{"force_overwrite_replica_secret"})
+	})
+	resourceSchemaRepository.SetHumanReadableAttributesFunc(AwsSecretsManagerSecretResourceType, func(res *resource.Resource) map[string]string {
+		attrs := make(map[string]string)
+		if v := res.Attributes().GetString("name"); v != nil && *v != "" {
+			attrs["Name"] = *v
+		}
+		return attrs
+	})
+}
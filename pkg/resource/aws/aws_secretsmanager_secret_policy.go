@@ -0,0 +1,15 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSecretsManagerSecretPolicyResourceType = "aws_secretsmanager_secret_policy"
+
+func initAwsSecretsManagerSecretPolicyMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSecretsManagerSecretPolicyResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"block_public_policy"})
+	})
+}
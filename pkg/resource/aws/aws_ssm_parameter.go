@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSsmParameterResourceType = "aws_ssm_parameter"
+
+func initAwsSsmParameterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSsmParameterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Parameter values are never read from AWS, do not keep the one of the state either
+		val.SafeDelete([]string{"value"})
+		val.SafeDelete([]string{"insecure_value"})
+		val.SafeDelete([]string{"overwrite"})
+	})
+}
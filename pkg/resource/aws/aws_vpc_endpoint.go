@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsVpcEndpointResourceType = "aws_vpc_endpoint"
+
+func initAwsVpcEndpointMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsVpcEndpointResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// auto_accept is only used when creating the resource, it is never read back from AWS
+		val.SafeDelete([]string{"auto_accept"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+}
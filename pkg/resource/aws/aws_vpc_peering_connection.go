@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsVpcPeeringConnectionResourceType = "aws_vpc_peering_connection"
+
+func initAwsVpcPeeringConnectionMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsVpcPeeringConnectionResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// auto_accept is only used when creating the resource, it is never read back from AWS
+		val.SafeDelete([]string{"auto_accept"})
+		val.SafeDelete([]string{"timeouts"})
+	})
+}
@@ -46,6 +46,7 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		aws.AwsAppAutoscalingPolicyResourceType:            {},
 		aws.AwsAppAutoscalingScheduledActionResourceType:   {},
 		aws.AwsAppAutoscalingTargetResourceType:            {},
+		aws.AwsAutoscalingGroupResourceType:                {},
 		aws.AwsCloudformationStackResourceType:             {},
 		aws.AwsCloudfrontDistributionResourceType:          {},
 		aws.AwsDbInstanceResourceType:                      {},
@@ -60,6 +61,30 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		aws.AwsEbsSnapshotResourceType:                     {},
 		aws.AwsEbsVolumeResourceType:                       {},
 		aws.AwsEcrRepositoryResourceType:                   {},
+		aws.AwsEcsClusterResourceType:                      {},
+		aws.AwsEcsServiceResourceType:                      {},
+		aws.AwsEcsTaskDefinitionResourceType:               {},
+		aws.AwsEksClusterResourceType:                      {},
+		aws.AwsEksNodeGroupResourceType:                    {},
+		aws.AwsEksFargateProfileResourceType:               {},
+		aws.AwsEksAddonResourceType:                        {},
+		aws.AwsSecretsManagerSecretResourceType:            {},
+		aws.AwsSecretsManagerSecretRotationResourceType:    {},
+		aws.AwsSecretsManagerSecretPolicyResourceType:      {},
+		aws.AwsSsmParameterResourceType:                    {},
+		aws.AwsCloudwatchLogGroupResourceType:              {},
+		aws.AwsCloudwatchMetricAlarmResourceType:           {},
+		aws.AwsCloudwatchDashboardResourceType:             {},
+		aws.AwsCloudwatchEventBusResourceType:              {},
+		aws.AwsCloudwatchEventRuleResourceType:             {},
+		aws.AwsCloudwatchEventTargetResourceType:           {},
+		aws.AwsVpcEndpointResourceType:                     {},
+		aws.AwsVpcPeeringConnectionResourceType:            {},
+		aws.AwsEc2TransitGatewayResourceType:               {},
+		aws.AwsEc2TransitGatewayVpcAttachmentResourceType:  {},
+		aws.AwsFlowLogResourceType:                         {},
+		aws.AwsNetworkInterfaceResourceType:                {},
+		aws.AwsVpcDhcpOptionsResourceType:                  {},
 		aws.AwsEipResourceType:                             {},
 		aws.AwsEipAssociationResourceType:                  {},
 		aws.AwsElastiCacheClusterResourceType:              {},
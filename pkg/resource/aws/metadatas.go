@@ -32,6 +32,19 @@ func InitResourcesMetadata(resourceSchemaRepository resource.SchemaRepositoryInt
 	initAwsS3BucketMetaData(resourceSchemaRepository)
 	initAwsS3BucketPolicyMetaData(resourceSchemaRepository)
 	initAwsEcrRepositoryMetaData(resourceSchemaRepository)
+	initAwsEcsClusterMetaData(resourceSchemaRepository)
+	initAwsEcsServiceMetaData(resourceSchemaRepository)
+	initAwsEcsTaskDefinitionMetaData(resourceSchemaRepository)
+	initAwsEksClusterMetaData(resourceSchemaRepository)
+	initAwsEksNodeGroupMetaData(resourceSchemaRepository)
+	initAwsEksFargateProfileMetaData(resourceSchemaRepository)
+	initAwsEksAddonMetaData(resourceSchemaRepository)
+	initAwsSecretsManagerSecretMetaData(resourceSchemaRepository)
+	initAwsSecretsManagerSecretPolicyMetaData(resourceSchemaRepository)
+	initAwsSsmParameterMetaData(resourceSchemaRepository)
+	initAwsCloudwatchEventRuleMetaData(resourceSchemaRepository)
+	initAwsVpcEndpointMetaData(resourceSchemaRepository)
+	initAwsVpcPeeringConnectionMetaData(resourceSchemaRepository)
 	initAwsRouteMetaData(resourceSchemaRepository)
 	initAwsRoute53RecordMetaData(resourceSchemaRepository)
 	initAwsRoute53ZoneMetaData(resourceSchemaRepository)
@@ -0,0 +1,44 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.19.0"
+  }
+}
+
+data "aws_ami" "ubuntu" {
+    most_recent = true
+
+    filter {
+        name   = "name"
+        values = ["ubuntu/images/hvm-ssd/ubuntu-focal-20.04-amd64-server-*"]
+    }
+
+    filter {
+        name   = "virtualization-type"
+        values = ["hvm"]
+    }
+
+    owners = ["099720109477"] # Canonical
+}
+
+data "aws_availability_zones" "available" {
+    state = "available"
+}
+
+resource "aws_launch_configuration" "as_conf" {
+    name          = "web_config"
+    image_id      = data.aws_ami.ubuntu.id
+    instance_type = "t3.micro"
+}
+
+resource "aws_autoscaling_group" "web" {
+    name                 = "web"
+    availability_zones   = [data.aws_availability_zones.available.names[0]]
+    launch_configuration = aws_launch_configuration.as_conf.name
+    min_size             = 0
+    max_size             = 1
+    desired_capacity     = 0
+}
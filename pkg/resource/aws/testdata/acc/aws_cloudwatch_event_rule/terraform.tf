@@ -0,0 +1,33 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.74.0"
+  }
+}
+
+resource "aws_cloudwatch_event_bus" "orders" {
+  name = "acc-test-orders"
+}
+
+resource "aws_cloudwatch_event_rule" "created" {
+  name           = "acc-test-order-created"
+  event_bus_name = aws_cloudwatch_event_bus.orders.name
+
+  event_pattern = jsonencode({
+    detail-type = ["OrderCreated"]
+  })
+}
+
+resource "aws_sns_topic" "orders" {
+  name = "acc-test-orders"
+}
+
+resource "aws_cloudwatch_event_target" "created" {
+  rule           = aws_cloudwatch_event_rule.created.name
+  event_bus_name = aws_cloudwatch_event_bus.orders.name
+  target_id      = "sns"
+  arn            = aws_sns_topic.orders.arn
+}
@@ -0,0 +1,24 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.74.0"
+  }
+}
+
+resource "aws_ecs_cluster" "foo" {
+  name = "acc-test-cluster-capacity-providers"
+}
+
+resource "aws_ecs_cluster_capacity_providers" "foo" {
+  cluster_name       = aws_ecs_cluster.foo.name
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 100
+    capacity_provider = "FARGATE"
+  }
+}
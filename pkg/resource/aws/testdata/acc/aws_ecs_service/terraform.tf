@@ -0,0 +1,34 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.74.0"
+  }
+}
+
+resource "aws_ecs_cluster" "foo" {
+  name = "acc-test-cluster-service"
+}
+
+resource "aws_ecs_task_definition" "foo" {
+  family = "acc-test-task-definition"
+  container_definitions = jsonencode([
+    {
+      name      = "web"
+      image     = "nginx:latest"
+      cpu       = 10
+      memory    = 128
+      essential = true
+    }
+  ])
+}
+
+resource "aws_ecs_service" "foo" {
+  name            = "acc-test-service"
+  cluster         = aws_ecs_cluster.foo.id
+  task_definition = aws_ecs_task_definition.foo.arn
+  launch_type     = "EC2"
+  desired_count   = 0
+}
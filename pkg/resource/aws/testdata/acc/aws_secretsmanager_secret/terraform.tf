@@ -0,0 +1,35 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.74.0"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_secretsmanager_secret" "foo" {
+  name                    = "acc-test-secret-foo"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret" "bar" {
+  name                    = "acc-test-secret-bar"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_policy" "foo" {
+  secret_arn = aws_secretsmanager_secret.foo.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { AWS = "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root" }
+      Action    = "secretsmanager:GetSecretValue"
+      Resource  = "*"
+    }]
+  })
+}
@@ -0,0 +1,21 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.74.0"
+  }
+}
+
+resource "aws_ssm_parameter" "url" {
+  name  = "/acc-test/url"
+  type  = "String"
+  value = "https://example.com"
+}
+
+resource "aws_ssm_parameter" "password" {
+  name  = "/acc-test/password"
+  type  = "SecureString"
+  value = "acc-test-password"
+}
@@ -0,0 +1,56 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.74.0"
+  }
+}
+
+resource "aws_vpc" "requester" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpc_endpoint" "s3" {
+  vpc_id       = aws_vpc.requester.id
+  service_name = "com.amazonaws.us-east-1.s3"
+}
+
+resource "aws_vpc_peering_connection" "peering" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+  auto_accept = true
+}
+
+resource "aws_vpc_dhcp_options" "options" {
+  domain_name         = "acc-test.internal"
+  domain_name_servers = ["AmazonProvidedDNS"]
+}
+
+resource "aws_vpc_dhcp_options_association" "options" {
+  vpc_id          = aws_vpc.requester.id
+  dhcp_options_id = aws_vpc_dhcp_options.options.id
+}
+
+resource "random_string" "suffix" {
+  length  = 8
+  upper   = false
+  special = false
+}
+
+resource "aws_s3_bucket" "flow_logs" {
+  bucket        = "acc-test-flow-logs-${random_string.suffix.result}"
+  force_destroy = true
+}
+
+resource "aws_flow_log" "requester" {
+  vpc_id               = aws_vpc.requester.id
+  traffic_type         = "REJECT"
+  log_destination_type = "s3"
+  log_destination      = aws_s3_bucket.flow_logs.arn
+}
@@ -0,0 +1,111 @@
+package serve
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/metrics"
+)
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+type triggerRequest struct {
+	Profile string `json:"profile"`
+}
+
+// NewHandler exposes the scans of the service through a REST API:
+//
+//	GET  /api/scans          list scans, from the most recent, optionally filtered with ?profile=
+//	POST /api/scans          trigger a scan of the profile given in the body {"profile": "prod"}
+//	GET  /api/scans/latest   summary of the latest succeeded scan, optionally filtered with ?profile=
+//	GET  /api/scans/{id}     scan along with its full analysis
+//	GET  /metrics            metrics of the latest succeeded scan of each profile in the Prometheus text format
+func NewHandler(service *Service, store *Store) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/scans", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, store.List(r.URL.Query().Get("profile")))
+	})
+
+	mux.HandleFunc("POST /api/scans", func(w http.ResponseWriter, r *http.Request) {
+		var req triggerRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, errors.Wrap(err, "invalid request body"))
+			return
+		}
+		scan, err := service.Trigger(req.Profile, ScanTriggerAPI)
+		switch {
+		case errors.Is(err, ErrUnknownProfile):
+			writeError(w, http.StatusNotFound, errors.Errorf("unknown profile '%s'", req.Profile))
+		case errors.Is(err, ErrTooManyPendingScans):
+			writeError(w, http.StatusServiceUnavailable, err)
+		case err != nil:
+			writeError(w, http.StatusInternalServerError, err)
+		default:
+			writeJSON(w, http.StatusAccepted, scan)
+		}
+	})
+
+	mux.HandleFunc("GET /api/scans/latest", func(w http.ResponseWriter, r *http.Request) {
+		scan, err := store.Latest(r.URL.Query().Get("profile"))
+		if err != nil {
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, scan)
+	})
+
+	mux.HandleFunc("GET /api/scans/{id}", func(w http.ResponseWriter, r *http.Request) {
+		scan, err := store.Get(r.PathValue("id"))
+		switch {
+		case errors.Is(err, ErrScanNotFound):
+			writeError(w, http.StatusNotFound, err)
+		case err != nil:
+			writeError(w, http.StatusInternalServerError, err)
+		default:
+			writeJSON(w, http.StatusOK, scan)
+		}
+	})
+
+	mux.HandleFunc("GET /metrics", func(w http.ResponseWriter, r *http.Request) {
+		analyses := make([]metrics.LabeledAnalysis, 0)
+		for _, profile := range service.Profiles() {
+			latest, err := store.Latest(profile)
+			if err != nil {
+				continue
+			}
+			scan, err := store.Get(latest.Id)
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, err)
+				return
+			}
+			analyses = append(analyses, metrics.LabeledAnalysis{
+				Labels:   map[string]string{"profile": profile},
+				Analysis: scan.Analysis,
+			})
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := metrics.Write(w, analyses...); err != nil {
+			logrus.WithField("error", err).Debug("Unable to write metrics")
+		}
+	})
+
+	return mux
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, apiError{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logrus.WithField("error", err).Debug("Unable to write API response")
+	}
+}
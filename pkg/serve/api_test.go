@@ -0,0 +1,159 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRunner struct {
+	runs []string
+}
+
+func (r *fakeRunner) Run(profile string) (*analyser.Analysis, error) {
+	r.runs = append(r.runs, profile)
+	if profile == "broken" {
+		return nil, errors.New("unable to read state")
+	}
+	analysis := analyser.NewAnalysis()
+	analysis.AddManaged(&resource.Resource{Id: "bucket", Type: "aws_s3_bucket"})
+	analysis.AddUnmanaged(&resource.Resource{Id: "user", Type: "aws_iam_user"})
+	return analysis, nil
+}
+
+func waitForScan(t *testing.T, store *Store, id string) *Scan {
+	for i := 0; i < 100; i++ {
+		scan, err := store.Get(id)
+		assert.NoError(t, err)
+		if scan.Status == ScanStatusSucceeded || scan.Status == ScanStatusFailed {
+			return scan
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("scan %s did not finish", id)
+	return nil
+}
+
+func doRequest(handler http.Handler, method, url, body string) (int, map[string]interface{}) {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	var res map[string]interface{}
+	_ = json.Unmarshal(rec.Body.Bytes(), &res)
+	return rec.Code, res
+}
+
+func TestHandler(t *testing.T) {
+	store, err := NewStore(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	runner := &fakeRunner{}
+	service := NewService(runner, store, map[string]Schedule{"prod": nil, "broken": nil})
+	handler := NewHandler(service, store)
+
+	status, res := doRequest(handler, http.MethodGet, "/api/scans/latest", "")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "scan not found", res["error"])
+
+	status, res = doRequest(handler, http.MethodPost, "/api/scans", `{"profile": "dev"}`)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "unknown profile 'dev'", res["error"])
+
+	status, res = doRequest(handler, http.MethodPost, "/api/scans", `not json`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, res = doRequest(handler, http.MethodPost, "/api/scans", `{"profile": "prod"}`)
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, "pending", res["status"])
+	assert.Equal(t, "api", res["trigger"])
+	prodId := res["id"].(string)
+
+	// A scan already pending for the profile is returned
+	status, res = doRequest(handler, http.MethodPost, "/api/scans", `{"profile": "prod"}`)
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, prodId, res["id"])
+
+	status, res = doRequest(handler, http.MethodPost, "/api/scans", `{"profile": "broken"}`)
+	assert.Equal(t, http.StatusAccepted, status)
+	brokenId := res["id"].(string)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go service.Start(ctx)
+
+	assert.Equal(t, ScanStatusSucceeded, waitForScan(t, store, prodId).Status)
+	assert.Equal(t, "unable to read state", waitForScan(t, store, brokenId).Error)
+	assert.Equal(t, []string{"prod", "broken"}, runner.runs)
+
+	status, res = doRequest(handler, http.MethodGet, "/api/scans/"+prodId, "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, float64(50), res["coverage"])
+	analysis := res["analysis"].(map[string]interface{})
+	assert.Len(t, analysis["unmanaged"], 1)
+
+	status, res = doRequest(handler, http.MethodGet, "/api/scans/latest", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, prodId, res["id"])
+	assert.Nil(t, res["analysis"])
+	assert.Equal(t, float64(1), res["summary"].(map[string]interface{})["total_unmanaged"])
+
+	status, _ = doRequest(handler, http.MethodGet, "/api/scans/unknown", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/scans?profile=broken", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var scans []*Scan
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &scans))
+	assert.Len(t, scans, 1)
+	assert.Equal(t, ScanStatusFailed, scans[0].Status)
+
+	req = httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; version=0.0.4", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "driftctl_unmanaged_resources{profile=\"prod\"} 1\n")
+	assert.Contains(t, rec.Body.String(), "driftctl_resources_by_type{profile=\"prod\",state=\"managed\",type=\"aws_s3_bucket\"} 1\n")
+	assert.NotContains(t, rec.Body.String(), "profile=\"broken\"")
+}
+
+type fixedSchedule struct{}
+
+func (fixedSchedule) Next(t time.Time) time.Time {
+	return t.Add(10 * time.Millisecond)
+}
+
+func TestService_Schedule(t *testing.T) {
+	store, err := NewStore(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	service := NewService(&fakeRunner{}, store, map[string]Schedule{"prod": fixedSchedule{}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		service.Start(ctx)
+		close(stopped)
+	}()
+
+	for i := 0; i < 100 && len(store.List("prod")) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-stopped
+
+	scans := store.List("prod")
+	assert.NotEmpty(t, scans)
+	assert.Equal(t, ScanTriggerSchedule, scans[0].Trigger)
+}
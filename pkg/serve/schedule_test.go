@@ -0,0 +1,62 @@
+package serve
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchedule(t *testing.T) {
+	from := time.Date(2022, time.March, 15, 10, 42, 30, 0, time.UTC) // a Tuesday
+
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{expr: "* * * * *", want: time.Date(2022, time.March, 15, 10, 43, 0, 0, time.UTC)},
+		{expr: "*/15 * * * *", want: time.Date(2022, time.March, 15, 10, 45, 0, 0, time.UTC)},
+		{expr: "0 * * * *", want: time.Date(2022, time.March, 15, 11, 0, 0, 0, time.UTC)},
+		{expr: "@hourly", want: time.Date(2022, time.March, 15, 11, 0, 0, 0, time.UTC)},
+		{expr: "30 2 * * *", want: time.Date(2022, time.March, 16, 2, 30, 0, 0, time.UTC)},
+		{expr: "@daily", want: time.Date(2022, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 9-17/4 * * 1-5", want: time.Date(2022, time.March, 15, 13, 0, 0, 0, time.UTC)},
+		{expr: "0 8 * * 6,7", want: time.Date(2022, time.March, 19, 8, 0, 0, 0, time.UTC)},
+		{expr: "@weekly", want: time.Date(2022, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 1 * *", want: time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 1 * 3", want: time.Date(2022, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 30 2 *", want: time.Time{}},
+		{expr: "@every 90m", want: time.Date(2022, time.March, 15, 12, 12, 30, 0, time.UTC)},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.expr, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	cases := []struct {
+		expr string
+		err  string
+	}{
+		{expr: "* * * *", err: "invalid schedule '* * * *': expected 5 fields (minute hour day-of-month month day-of-week), got 4"},
+		{expr: "60 * * * *", err: "invalid schedule '60 * * * *': invalid minute: '60' is out of range 0-59"},
+		{expr: "* * * 0 *", err: "invalid schedule '* * * 0 *': invalid month: '0' is out of range 1-12"},
+		{expr: "*/0 * * * *", err: "invalid schedule '*/0 * * * *': invalid minute: invalid step in '*/0'"},
+		{expr: "a * * * *", err: "invalid schedule 'a * * * *': invalid minute: invalid value 'a'"},
+		{expr: "@every 10s", err: "invalid schedule '@every 10s': interval must be at least one minute"},
+		{expr: "@every tomorrow", err: "invalid schedule '@every tomorrow': time: invalid duration \"tomorrow\""},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseSchedule(tt.expr)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package serve
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/analyser"
+)
+
+// maxPendingScans bounds the scans waiting for the running one to finish
+const maxPendingScans = 100
+
+var ErrUnknownProfile = errors.New("unknown profile")
+var ErrTooManyPendingScans = errors.New("too many pending scans")
+
+// Runner runs the scan of a profile
+type Runner interface {
+	Run(profile string) (*analyser.Analysis, error)
+}
+
+// Service runs scans of profiles on schedule or on demand and records their
+// results in a store. Scans run one at a time so that they can share the
+// same provider plugins.
+type Service struct {
+	runner     Runner
+	store      *Store
+	profiles   map[string]Schedule
+	queue      chan *Scan
+	mu         sync.Mutex
+	pending    map[string]*Scan
+	now        func() time.Time
+	lastScanId int64
+}
+
+// NewService creates a service for the given profiles, profiles with a nil
+// schedule are only scanned on demand
+func NewService(runner Runner, store *Store, profiles map[string]Schedule) *Service {
+	return &Service{
+		runner:   runner,
+		store:    store,
+		profiles: profiles,
+		queue:    make(chan *Scan, maxPendingScans),
+		pending:  make(map[string]*Scan),
+		now:      time.Now,
+	}
+}
+
+// HasProfile returns whether a profile is served
+func (s *Service) HasProfile(profile string) bool {
+	_, exists := s.profiles[profile]
+	return exists
+}
+
+// Profiles returns the names of the served profiles
+func (s *Service) Profiles() []string {
+	profiles := make([]string, 0, len(s.profiles))
+	for profile := range s.profiles {
+		profiles = append(profiles, profile)
+	}
+	sort.Strings(profiles)
+	return profiles
+}
+
+// Trigger queues a scan of the given profile. When a scan of this profile is
+// already pending, it is returned instead of queuing a new one.
+func (s *Service) Trigger(profile string, trigger ScanTrigger) (*Scan, error) {
+	if !s.HasProfile(profile) {
+		return nil, ErrUnknownProfile
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if scan, exists := s.pending[profile]; exists {
+		return scan.withoutAnalysis(), nil
+	}
+
+	// Only Trigger sends to the queue and it holds the lock, the send below never blocks
+	if len(s.queue) == cap(s.queue) {
+		return nil, ErrTooManyPendingScans
+	}
+
+	scan := &Scan{
+		Id:        s.nextScanId(),
+		Profile:   profile,
+		Trigger:   trigger,
+		Status:    ScanStatusPending,
+		CreatedAt: s.now().UTC(),
+	}
+	if err := s.store.Save(scan); err != nil {
+		return nil, err
+	}
+	s.pending[profile] = scan
+	s.queue <- scan
+
+	logrus.WithFields(logrus.Fields{
+		"id":      scan.Id,
+		"profile": profile,
+		"trigger": trigger,
+	}).Debug("Queued scan")
+
+	return scan.withoutAnalysis(), nil
+}
+
+// Start runs scheduled and queued scans until the context is cancelled
+func (s *Service) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for profile, schedule := range s.profiles {
+		if schedule == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(profile string, schedule Schedule) {
+			defer wg.Done()
+			s.schedule(ctx, profile, schedule)
+		}(profile, schedule)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case scan := <-s.queue:
+			s.run(scan)
+		}
+	}
+}
+
+func (s *Service) schedule(ctx context.Context, profile string, schedule Schedule) {
+	for {
+		next := schedule.Next(s.now())
+		if next.IsZero() {
+			logrus.WithField("profile", profile).Warn("Schedule never matches, profile will only be scanned on demand")
+			return
+		}
+		logrus.WithFields(logrus.Fields{
+			"profile": profile,
+			"next":    next,
+		}).Debug("Scheduled next scan")
+
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			if _, err := s.Trigger(profile, ScanTriggerSchedule); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"profile": profile,
+					"error":   err,
+				}).Error("Unable to trigger scheduled scan")
+			}
+		}
+	}
+}
+
+func (s *Service) run(scan *Scan) {
+	s.mu.Lock()
+	delete(s.pending, scan.Profile)
+	s.mu.Unlock()
+
+	startedAt := s.now().UTC()
+	scan.StartedAt = &startedAt
+	scan.Status = ScanStatusRunning
+	s.save(scan)
+
+	logrus.WithFields(logrus.Fields{
+		"id":      scan.Id,
+		"profile": scan.Profile,
+	}).Info("Starting scan")
+
+	analysis, err := s.runner.Run(scan.Profile)
+
+	finishedAt := s.now().UTC()
+	scan.FinishedAt = &finishedAt
+	if err != nil {
+		scan.Status = ScanStatusFailed
+		scan.Error = err.Error()
+		logrus.WithFields(logrus.Fields{
+			"id":      scan.Id,
+			"profile": scan.Profile,
+			"error":   err,
+		}).Error("Scan failed")
+	} else {
+		summary := analysis.Summary()
+		coverage := analysis.Coverage()
+		scan.Status = ScanStatusSucceeded
+		scan.Summary = &summary
+		scan.Coverage = &coverage
+		scan.Analysis = analysis
+		logrus.WithFields(logrus.Fields{
+			"id":       scan.Id,
+			"profile":  scan.Profile,
+			"coverage": coverage,
+		}).Info("Scan succeeded")
+	}
+	s.save(scan)
+}
+
+func (s *Service) save(scan *Scan) {
+	if err := s.store.Save(scan); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"id":    scan.Id,
+			"error": err,
+		}).Error("Unable to save scan")
+	}
+}
+
+// nextScanId returns a unique id ordered by creation time
+func (s *Service) nextScanId() string {
+	id := s.now().UnixNano()
+	if id <= s.lastScanId {
+		id = s.lastScanId + 1
+	}
+	s.lastScanId = id
+	return strconv.FormatInt(id, 10)
+}
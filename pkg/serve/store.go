@@ -0,0 +1,243 @@
+package serve
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/pkg/analyser"
+)
+
+type ScanStatus string
+
+const (
+	ScanStatusPending   ScanStatus = "pending"
+	ScanStatusRunning   ScanStatus = "running"
+	ScanStatusSucceeded ScanStatus = "succeeded"
+	ScanStatusFailed    ScanStatus = "failed"
+)
+
+type ScanTrigger string
+
+const (
+	ScanTriggerSchedule ScanTrigger = "schedule"
+	ScanTriggerAPI      ScanTrigger = "api"
+)
+
+var ErrScanNotFound = errors.New("scan not found")
+
+// Scan is a scan of a profile run by the daemon. The analysis is only
+// loaded when a single scan is retrieved from the store.
+type Scan struct {
+	Id         string             `json:"id"`
+	Profile    string             `json:"profile"`
+	Trigger    ScanTrigger        `json:"trigger"`
+	Status     ScanStatus         `json:"status"`
+	CreatedAt  time.Time          `json:"created_at"`
+	StartedAt  *time.Time         `json:"started_at,omitempty"`
+	FinishedAt *time.Time         `json:"finished_at,omitempty"`
+	Error      string             `json:"error,omitempty"`
+	Summary    *analyser.Summary  `json:"summary,omitempty"`
+	Coverage   *int               `json:"coverage,omitempty"`
+	Analysis   *analyser.Analysis `json:"analysis,omitempty"`
+}
+
+// withoutAnalysis returns a copy of the scan metadata
+func (s *Scan) withoutAnalysis() *Scan {
+	scan := *s
+	scan.Analysis = nil
+	return &scan
+}
+
+// Store keeps the history of scans as one JSON file per scan in a directory,
+// scan metadata is kept in memory
+type Store struct {
+	dir      string
+	maxScans int
+	mu       sync.RWMutex
+	scans    []*Scan
+}
+
+// NewStore loads the scans of the given directory. When maxScans is positive,
+// only the most recent scans are kept.
+func NewStore(dir string, maxScans int) (*Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		dir:      dir,
+		maxScans: maxScans,
+		scans:    make([]*Scan, 0),
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range matches {
+		scan, err := s.read(match)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"file":  match,
+				"error": err,
+			}).Warn("Ignoring unreadable scan from store")
+			continue
+		}
+		// Scans still pending or running were interrupted when the daemon stopped
+		if scan.Status == ScanStatusPending || scan.Status == ScanStatusRunning {
+			scan.Status = ScanStatusFailed
+			scan.Error = "interrupted"
+			if err := s.write(scan); err != nil {
+				return nil, err
+			}
+		}
+		s.scans = append(s.scans, scan.withoutAnalysis())
+	}
+	s.sort()
+
+	return s, nil
+}
+
+// Save creates or updates a scan
+func (s *Store) Save(scan *Scan) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.write(scan); err != nil {
+		return err
+	}
+
+	metadata := scan.withoutAnalysis()
+	replaced := false
+	for i, existing := range s.scans {
+		if existing.Id == scan.Id {
+			s.scans[i] = metadata
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		s.scans = append(s.scans, metadata)
+		s.sort()
+	}
+
+	s.prune()
+
+	return nil
+}
+
+// List returns the scans from the most recent one, optionally restricted to a profile
+func (s *Store) List(profile string) []*Scan {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	scans := make([]*Scan, 0, len(s.scans))
+	for _, scan := range s.scans {
+		if profile == "" || scan.Profile == profile {
+			scans = append(scans, scan)
+		}
+	}
+	return scans
+}
+
+// Get returns a scan along with its analysis
+func (s *Store) Get(id string) (*Scan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, scan := range s.scans {
+		if scan.Id == id {
+			return s.read(s.path(id))
+		}
+	}
+	return nil, ErrScanNotFound
+}
+
+// Latest returns the most recent succeeded scan, optionally restricted to a profile
+func (s *Store) Latest(profile string) (*Scan, error) {
+	for _, scan := range s.List(profile) {
+		if scan.Status == ScanStatusSucceeded {
+			return scan, nil
+		}
+	}
+	return nil, ErrScanNotFound
+}
+
+// sort orders scans from the most recent one
+func (s *Store) sort() {
+	sort.SliceStable(s.scans, func(i, j int) bool {
+		return s.scans[i].CreatedAt.After(s.scans[j].CreatedAt)
+	})
+}
+
+// prune removes the oldest finished scans beyond the retention limit
+func (s *Store) prune() {
+	if s.maxScans <= 0 || len(s.scans) <= s.maxScans {
+		return
+	}
+
+	kept := make([]*Scan, 0, s.maxScans)
+	for i, scan := range s.scans {
+		finished := scan.Status == ScanStatusSucceeded || scan.Status == ScanStatusFailed
+		if i < s.maxScans || !finished {
+			kept = append(kept, scan)
+			continue
+		}
+		if err := os.Remove(s.path(scan.Id)); err != nil && !os.IsNotExist(err) {
+			logrus.WithFields(logrus.Fields{
+				"id":    scan.Id,
+				"error": err,
+			}).Warn("Unable to remove scan from store")
+			kept = append(kept, scan)
+		}
+	}
+	s.scans = kept
+}
+
+func (s *Store) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+func (s *Store) read(path string) (*Scan, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var scan Scan
+	if err := json.Unmarshal(content, &scan); err != nil {
+		return nil, err
+	}
+	if scan.Id != strings.TrimSuffix(filepath.Base(path), ".json") {
+		return nil, errors.Errorf("scan id '%s' does not match its file name", scan.Id)
+	}
+	return &scan, nil
+}
+
+// write replaces the scan file atomically so that a crash never leaves a
+// truncated scan in the store
+func (s *Store) write(scan *Scan) error {
+	content, err := json.Marshal(scan)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, ".scan-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(scan.Id))
+}
@@ -0,0 +1,93 @@
+package serve
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestScan(id, profile string, status ScanStatus, createdAt time.Time) *Scan {
+	return &Scan{
+		Id:        id,
+		Profile:   profile,
+		Trigger:   ScanTriggerSchedule,
+		Status:    status,
+		CreatedAt: createdAt,
+	}
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(dir, 0)
+	assert.NoError(t, err)
+
+	now := time.Date(2022, time.March, 15, 10, 0, 0, 0, time.UTC)
+
+	analysis := analyser.NewAnalysis()
+	analysis.AddManaged(&resource.Resource{Id: "bucket", Type: "aws_s3_bucket"})
+	analysis.AddUnmanaged(&resource.Resource{Id: "user", Type: "aws_iam_user"})
+	summary := analysis.Summary()
+
+	first := newTestScan("1", "prod", ScanStatusSucceeded, now)
+	first.Summary = &summary
+	first.Analysis = analysis
+	assert.NoError(t, store.Save(first))
+	assert.NoError(t, store.Save(newTestScan("2", "staging", ScanStatusFailed, now.Add(time.Minute))))
+	assert.NoError(t, store.Save(newTestScan("3", "prod", ScanStatusRunning, now.Add(2*time.Minute))))
+
+	scans := store.List("")
+	assert.Len(t, scans, 3)
+	assert.Equal(t, []string{"3", "2", "1"}, []string{scans[0].Id, scans[1].Id, scans[2].Id})
+	for _, scan := range scans {
+		assert.Nil(t, scan.Analysis, "listed scans should not hold their analysis")
+	}
+
+	scans = store.List("prod")
+	assert.Len(t, scans, 2)
+
+	latest, err := store.Latest("prod")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", latest.Id)
+	assert.Equal(t, 1, latest.Summary.TotalUnmanaged)
+
+	_, err = store.Latest("staging")
+	assert.Equal(t, ErrScanNotFound, err)
+
+	scan, err := store.Get("1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, scan.Analysis.Summary().TotalResources)
+
+	_, err = store.Get("unknown")
+	assert.Equal(t, ErrScanNotFound, err)
+
+	// Scans are loaded back and the running one is marked as interrupted
+	store, err = NewStore(dir, 0)
+	assert.NoError(t, err)
+	scans = store.List("")
+	assert.Len(t, scans, 3)
+	assert.Equal(t, ScanStatusFailed, scans[0].Status)
+	assert.Equal(t, "interrupted", scans[0].Error)
+}
+
+func TestStore_Prune(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(dir, 2)
+	assert.NoError(t, err)
+
+	now := time.Date(2022, time.March, 15, 10, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.Save(newTestScan("1", "prod", ScanStatusSucceeded, now)))
+	assert.NoError(t, store.Save(newTestScan("2", "prod", ScanStatusSucceeded, now.Add(time.Minute))))
+	assert.NoError(t, store.Save(newTestScan("3", "prod", ScanStatusPending, now.Add(2*time.Minute))))
+
+	scans := store.List("")
+	assert.Len(t, scans, 2)
+	assert.Equal(t, []string{"3", "2"}, []string{scans[0].Id, scans[1].Id})
+
+	_, err = os.Stat(filepath.Join(dir, "1.json"))
+	assert.True(t, os.IsNotExist(err))
+}
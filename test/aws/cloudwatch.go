@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+)
+
+type FakeCloudWatch interface {
+	cloudwatchiface.CloudWatchAPI
+}
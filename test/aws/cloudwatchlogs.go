@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+)
+
+type FakeCloudWatchLogs interface {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+}
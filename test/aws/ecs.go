@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+type FakeECS interface {
+	ecsiface.ECSAPI
+}
@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+)
+
+type FakeEventBridge interface {
+	eventbridgeiface.EventBridgeAPI
+}
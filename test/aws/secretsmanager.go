@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+type FakeSecretsManager interface {
+	secretsmanageriface.SecretsManagerAPI
+}
@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+type FakeSSM interface {
+	ssmiface.SSMAPI
+}
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	gojson "encoding/json"
 	"errors"
 	"fmt"
@@ -41,9 +42,9 @@ func (m *MockedGoldenTFProvider) Schema() map[string]providers.Schema {
 	return m.readSchema()
 }
 
-func (m *MockedGoldenTFProvider) ReadResource(args terraform2.ReadResourceArgs) (*cty.Value, error) {
+func (m *MockedGoldenTFProvider) ReadResource(ctx context.Context, args terraform2.ReadResourceArgs) (*cty.Value, error) {
 	if m.update {
-		readResource, err := m.realProvider.ReadResource(args)
+		readResource, err := m.realProvider.ReadResource(ctx, args)
 		m.writeReadResource(args, readResource, err)
 		return readResource, err
 	}
@@ -56,6 +56,10 @@ func (r *ScanResult) AssertUnmanagedCount(count int) {
 	r.Equal(count, len(r.Unmanaged()))
 }
 
+func (r *ScanResult) AssertDriftCountTotal(count int) {
+	r.Equal(count, r.Summary().TotalDrifted)
+}
+
 func (r ScanResult) AssertInfrastructureIsInSync() {
 	r.Equal(
 		true,